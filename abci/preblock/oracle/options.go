@@ -0,0 +1,28 @@
+package oracle
+
+import (
+	connectabcitypes "github.com/skip-mev/connect/v2/abci/types"
+)
+
+// Option is a function that enables optional configuration of the PreBlockHandler.
+type Option func(*PreBlockHandler)
+
+// WithPerformanceKeeper returns an Option that configures the PreBlockHandler to record a report
+// of each validator's vote extension in every block with the given keeper, i.e. whether the
+// validator missed its vote extension, how many prices it did not report, and how far its prices
+// deviate from the aggregate prices. Reports are not recorded by default.
+func WithPerformanceKeeper(keeper connectabcitypes.OraclePerformanceKeeper) Option {
+	return func(h *PreBlockHandler) {
+		h.performanceKeeper = keeper
+	}
+}
+
+// WithSlashingHandler returns an Option that configures the PreBlockHandler to pass each validator
+// in the decided commit to the given handler after its report is recorded, so that validators that
+// persistently miss their vote extensions can be jailed or slashed. This requires the
+// WithPerformanceKeeper option. Validators are not penalised by default.
+func WithSlashingHandler(handler connectabcitypes.OracleSlashingHandler) Option {
+	return func(h *PreBlockHandler) {
+		h.slashingHandler = handler
+	}
+}
@@ -37,6 +37,14 @@ type PreBlockHandler struct { //golint:ignore
 
 	// pa is the price applier that is used to decode vote-extensions, aggregate price reports, and write prices to state.
 	pa abciaggregator.PriceApplier
+
+	// performanceKeeper is used to record the oracle performance of each validator. If this is
+	// nil, the performance of validators is not recorded.
+	performanceKeeper connectabcitypes.OraclePerformanceKeeper
+
+	// slashingHandler is used to penalise validators that persistently miss their vote
+	// extensions. If this is nil, validators are not penalised.
+	slashingHandler connectabcitypes.OracleSlashingHandler
 }
 
 // NewOraclePreBlockHandler returns a new PreBlockHandler. The handler
@@ -49,6 +57,7 @@ func NewOraclePreBlockHandler(
 	strategy currencypair.CurrencyPairStrategy,
 	veCodec codec.VoteExtensionCodec,
 	ecCodec codec.ExtendedCommitCodec,
+	opts ...Option,
 ) *PreBlockHandler {
 	va := abciaggregator.NewDefaultVoteAggregator(
 		logger,
@@ -63,12 +72,18 @@ func NewOraclePreBlockHandler(
 		logger,
 	)
 
-	return &PreBlockHandler{
+	h := &PreBlockHandler{
 		logger:  logger,
 		keeper:  oracleKeeper,
 		metrics: metrics,
 		pa:      pa,
 	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
 }
 
 // WrappedPreBlocker is called by the base app before the block is finalized. It
@@ -143,6 +158,9 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 			return response, err
 		}
 
+		// record the oracle performance of each validator
+		h.recordValidatorPerformance(ctx, req.DecidedLastCommit, prices)
+
 		return response, nil
 	}
 }
@@ -215,6 +233,9 @@ func (h *PreBlockHandler) PreBlocker() sdk.PreBlocker {
 			return &sdk.ResponsePreBlock{}, err
 		}
 
+		// record the oracle performance of each validator
+		h.recordValidatorPerformance(ctx, req.DecidedLastCommit, prices)
+
 		return &sdk.ResponsePreBlock{}, nil
 	}
 }
@@ -2,6 +2,7 @@ package oracle_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -749,3 +750,224 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 		s.Require().NoError(err)
 	})
 }
+
+func (s *PreBlockTestSuite) TestValidatorPerformance() {
+	val1 := sdk.ConsAddress("val1")
+	val2 := sdk.ConsAddress("val2")
+	val3 := sdk.ConsAddress("val3")
+	val4 := sdk.ConsAddress("val4")
+	val5 := sdk.ConsAddress("val5")
+
+	btcUsd := connecttypes.NewCurrencyPair("BTC", "USD")
+	ethUsd := connecttypes.NewCurrencyPair("ETH", "USD")
+	solUsd := connecttypes.NewCurrencyPair("SOL", "USD")
+
+	// slashEvent is emitted by the slashing handler for each validator it penalises, so that
+	// penalties that are not written to the block's context can be detected.
+	slashEvent := "test_slash"
+	slashed := func(validator sdk.ConsAddress) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			args.Get(0).(sdk.Context).EventManager().EmitEvent(sdk.NewEvent(slashEvent, sdk.NewAttribute("validator", validator.String())))
+		}
+	}
+
+	testCases := []struct {
+		name                 string
+		enabledCurrencyPairs []connecttypes.CurrencyPair
+		// recordErr is returned when recording the report of val2
+		recordErr error
+		// slashingErr is returned when handling the performance of val1
+		slashingErr error
+		// expectedSlashed are the validators whose penalties are written to the block's context
+		expectedSlashed []sdk.ConsAddress
+	}{
+		{
+			name:                 "records a report of each validator in the commit and passes it to the slashing handler",
+			enabledCurrencyPairs: []connecttypes.CurrencyPair{btcUsd, ethUsd},
+			expectedSlashed:      []sdk.ConsAddress{val1, val2, val4, val5},
+		},
+		{
+			name:                 "failures are logged and do not fail the block",
+			enabledCurrencyPairs: []connecttypes.CurrencyPair{btcUsd, ethUsd},
+			recordErr:            fmt.Errorf("failed to record report"),
+			slashingErr:          fmt.Errorf("failed to slash"),
+			expectedSlashed:      []sdk.ConsAddress{val4, val5},
+		},
+		{
+			name:                 "nothing is recorded without enabled markets",
+			enabledCurrencyPairs: []connecttypes.CurrencyPair{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			metrics := metricmock.NewMetrics(s.T())
+			mockOracleKeeper := connectabcimocks.NewOracleKeeper(s.T())
+			mockPerformanceKeeper := connectabcimocks.NewOraclePerformanceKeeper(s.T())
+			mockSlashingHandler := connectabcimocks.NewOracleSlashingHandler(s.T())
+			currencyPairStrategyMock := currencypairmock.NewCurrencyPairStrategy(s.T())
+
+			handler := preblock.NewOraclePreBlockHandler(
+				log.NewTestLogger(s.T()),
+				func(_ sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+					return func(_ aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
+						return map[connecttypes.CurrencyPair]*big.Int{
+							btcUsd: big.NewInt(100),
+						}
+					}
+				},
+				mockOracleKeeper,
+				metrics,
+				currencyPairStrategyMock,
+				compression.NewDefaultVoteExtensionCodec(),
+				compression.NewDefaultExtendedCommitCodec(),
+				preblock.WithPerformanceKeeper(mockPerformanceKeeper),
+				preblock.WithSlashingHandler(mockSlashingHandler),
+			)
+
+			// enable ves + set exec mode
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
+			s.ctx = s.ctx.WithBlockHeight(4)
+			s.ctx = s.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+			// mock currency-pair strategy calls
+			currencyPairStrategyMock.On("FromID", s.ctx, uint64(0)).Return(btcUsd, nil)
+			currencyPairStrategyMock.On("FromID", s.ctx, uint64(1)).Return(ethUsd, nil)
+			currencyPairStrategyMock.On("GetDecodedPrice", s.ctx, btcUsd, big.NewInt(100).Bytes()).Return(big.NewInt(100), nil)
+			currencyPairStrategyMock.On("GetDecodedPrice", s.ctx, btcUsd, big.NewInt(101).Bytes()).Return(big.NewInt(101), nil)
+			currencyPairStrategyMock.On("GetDecodedPrice", s.ctx, ethUsd, big.NewInt(10).Bytes()).Return(big.NewInt(10), nil)
+
+			// mock oracle keeper calls
+			mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return([]connecttypes.CurrencyPair{btcUsd, ethUsd, solUsd})
+			mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, btcUsd, mock.Anything).Return(nil)
+
+			// SOL/USD is disabled in x/marketmap, so it is not counted as missing
+			mockPerformanceKeeper.On("GetAllEnabledCurrencyPairs", s.ctx).Return(tc.enabledCurrencyPairs, nil)
+
+			// val1 reports both prices, val2 only reports BTC/USD because it dropped ETH/USD to fit its
+			// size budget, val3 is absent, val4 only reports BTC/USD, and val5 committed the block without a
+			// vote extension
+			val1Vote, err := testutils.CreateExtendedVoteInfo(val1, map[uint64][]byte{
+				0: big.NewInt(100).Bytes(),
+				1: big.NewInt(10).Bytes(),
+			}, compression.NewDefaultVoteExtensionCodec())
+			s.Require().NoError(err)
+
+			val2Vote, err := testutils.CreateExtendedVoteInfo(val2, nil, compression.NewDefaultVoteExtensionCodec())
+			s.Require().NoError(err)
+			val2Vote.VoteExtension, err = compression.NewDefaultVoteExtensionCodec().Encode(vetypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{
+					0: big.NewInt(101).Bytes(),
+				},
+				DroppedPrices: 1,
+			})
+			s.Require().NoError(err)
+
+			val4Vote, err := testutils.CreateExtendedVoteInfo(val4, map[uint64][]byte{
+				0: big.NewInt(100).Bytes(),
+			}, compression.NewDefaultVoteExtensionCodec())
+			s.Require().NoError(err)
+
+			_, extCommitBz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{val1Vote, val2Vote, val4Vote}, compression.NewDefaultExtendedCommitCodec())
+			s.Require().NoError(err)
+
+			// expect metrics calls
+			metrics.On("ObserveABCIMethodLatency", servicemetrics.PreBlock, mock.Anything).Return()
+			metrics.On("AddABCIRequest", servicemetrics.PreBlock, servicemetrics.Success{}).Return()
+			metrics.On("ObservePriceForTicker", mock.Anything, mock.Anything).Return()
+			metrics.On("AddValidatorReportForTicker", mock.Anything, mock.Anything, mock.Anything).Return()
+			metrics.On("AddValidatorPriceForTicker", mock.Anything, mock.Anything, mock.Anything).Return()
+
+			// expect performance reports, where val3 is absent and is left to the x/slashing module
+			if len(tc.enabledCurrencyPairs) > 0 {
+				mockPerformanceKeeper.On("RecordValidatorReport", s.ctx, val1, oracletypes.ValidatorReport{
+					ReportedPrices: 1,
+				}).Return(nil).Once()
+				mockPerformanceKeeper.On("RecordValidatorReport", s.ctx, val2, oracletypes.ValidatorReport{
+					MissingPrices:  1,
+					ReportedPrices: 1,
+					Deviation:      100,
+					DroppedPrices:  1,
+				}).Return(tc.recordErr).Once()
+				mockPerformanceKeeper.On("RecordValidatorReport", s.ctx, val3, oracletypes.ValidatorReport{}).Return(nil).Once()
+				mockPerformanceKeeper.On("RecordValidatorReport", s.ctx, val4, oracletypes.ValidatorReport{
+					MissingPrices:  1,
+					ReportedPrices: 1,
+				}).Return(nil).Once()
+				mockPerformanceKeeper.On("RecordValidatorReport", s.ctx, val5, oracletypes.ValidatorReport{
+					MissedVoteExtension: true,
+				}).Return(nil).Once()
+
+				// expect each validator that committed the block, and whose report was recorded, to be
+				// passed to the slashing handler with its power
+				mockSlashingHandler.On("HandleValidatorPerformance", mock.Anything, val1, int64(10)).
+					Run(slashed(val1)).Return(tc.slashingErr).Once()
+				if tc.recordErr == nil {
+					mockSlashingHandler.On("HandleValidatorPerformance", mock.Anything, val2, int64(20)).
+						Run(slashed(val2)).Return(nil).Once()
+				}
+				mockSlashingHandler.On("HandleValidatorPerformance", mock.Anything, val4, int64(40)).
+					Run(slashed(val4)).Return(nil).Once()
+				mockSlashingHandler.On("HandleValidatorPerformance", mock.Anything, val5, int64(50)).
+					Run(slashed(val5)).Return(nil).Once()
+			}
+
+			// run preblocker
+			_, err = handler.WrappedPreBlocker(s.mm)(s.ctx, &cometabci.RequestFinalizeBlock{
+				Txs: [][]byte{extCommitBz},
+				DecidedLastCommit: cometabci.CommitInfo{
+					Votes: []cometabci.VoteInfo{
+						{
+							Validator: cometabci.Validator{
+								Address: val1,
+								Power:   10,
+							},
+							BlockIdFlag: cometproto.BlockIDFlagCommit,
+						},
+						{
+							Validator: cometabci.Validator{
+								Address: val2,
+								Power:   20,
+							},
+							BlockIdFlag: cometproto.BlockIDFlagCommit,
+						},
+						{
+							Validator: cometabci.Validator{
+								Address: val3,
+								Power:   30,
+							},
+							BlockIdFlag: cometproto.BlockIDFlagAbsent,
+						},
+						{
+							Validator: cometabci.Validator{
+								Address: val4,
+								Power:   40,
+							},
+							BlockIdFlag: cometproto.BlockIDFlagCommit,
+						},
+						{
+							Validator: cometabci.Validator{
+								Address: val5,
+								Power:   50,
+							},
+							BlockIdFlag: cometproto.BlockIDFlagCommit,
+						},
+					},
+				},
+			})
+			s.Require().NoError(err)
+
+			var slashedValidators []sdk.ConsAddress
+			for _, event := range s.ctx.EventManager().Events() {
+				if event.Type != slashEvent {
+					continue
+				}
+				validator, err := sdk.ConsAddressFromBech32(event.Attributes[0].Value)
+				s.Require().NoError(err)
+				slashedValidators = append(slashedValidators, validator)
+			}
+			s.Require().Equal(tc.expectedSlashed, slashedValidators)
+		})
+	}
+}
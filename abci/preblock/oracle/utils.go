@@ -9,6 +9,7 @@ import (
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 // recordPrice records all the given prices per ticker, and reports them as a float64.
@@ -56,3 +57,109 @@ func (h *PreBlockHandler) recordValidatorReports(ctx sdk.Context, decidedCommit
 		}
 	}
 }
+
+// recordValidatorPerformance takes the commit decided for this block and the prices aggregated from it, and for each
+// validator in the commit, records a report of whether the validator missed its vote extension, how many currency-pairs
+// of enabled markets it did not report a price for, and how far the prices it reported deviate from the aggregated
+// prices. Prices that the validator dropped to fit its vote extension size budget are reported separately from the
+// missing prices. If a slashing handler is configured, each validator is then passed to it to be penalised for
+// persistently missing vote extensions.
+//
+// Only validators that committed the block can miss their vote extension. Absent validators are penalised by the
+// x/slashing module's downtime handling instead, so their reports are recorded without counting as missed. Nothing is
+// recorded if there are no enabled markets, since the vote extensions of honest validators are then empty.
+//
+// Failing to record the performance of a validator, or to penalise it, is logged rather than returned, so that it
+// cannot halt the chain. Each validator is penalised in a cached context that is only written if it succeeds.
+func (h *PreBlockHandler) recordValidatorPerformance(
+	ctx sdk.Context,
+	decidedCommit cometabci.CommitInfo,
+	prices map[connecttypes.CurrencyPair]*big.Int,
+) {
+	if h.performanceKeeper == nil {
+		return
+	}
+
+	currencyPairs, err := h.performanceKeeper.GetAllEnabledCurrencyPairs(ctx)
+	if err != nil {
+		h.logger.Error(
+			"failed to get enabled currency pairs; skipping validator performance",
+			"height", ctx.BlockHeight(),
+			"error", err,
+		)
+
+		return
+	}
+
+	if len(currencyPairs) == 0 {
+		h.logger.Debug(
+			"no enabled currency pairs; skipping validator performance",
+			"height", ctx.BlockHeight(),
+		)
+
+		return
+	}
+
+	for _, vote := range decidedCommit.Votes {
+		validator := sdk.ConsAddress(vote.Validator.Address)
+		committed := vote.BlockIdFlag == cometproto.BlockIDFlagCommit
+		validatorPrices := h.pa.GetPricesForValidator(validator)
+
+		var report oracletypes.ValidatorReport
+		switch {
+		case !committed:
+			// absent validators are left to the x/slashing module
+		case len(validatorPrices) == 0:
+			report.MissedVoteExtension = true
+		default:
+			for _, cp := range currencyPairs {
+				price, ok := validatorPrices[cp]
+				if !ok || price == nil {
+					report.MissingPrices++
+					continue
+				}
+
+				// only prices with an aggregate price can be compared
+				aggregate, ok := prices[cp]
+				if !ok || aggregate == nil {
+					continue
+				}
+
+				report.ReportedPrices++
+				report.Deviation += oracletypes.PriceDeviation(price, aggregate)
+			}
+
+			// the number of dropped prices is self-reported and the size budget is local config,
+			// so it cannot be verified: dropped prices still count as missing and are only recorded
+			report.DroppedPrices = min(uint64(h.pa.GetDroppedPricesForValidator(validator)), report.MissingPrices)
+		}
+
+		if err := h.performanceKeeper.RecordValidatorReport(ctx, validator, report); err != nil {
+			h.logger.Error(
+				"failed to record validator report",
+				"height", ctx.BlockHeight(),
+				"validator", validator.String(),
+				"error", err,
+			)
+
+			continue
+		}
+
+		if h.slashingHandler == nil || !committed {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := h.slashingHandler.HandleValidatorPerformance(cacheCtx, validator, vote.Validator.Power); err != nil {
+			h.logger.Error(
+				"failed to handle validator performance",
+				"height", ctx.BlockHeight(),
+				"validator", validator.String(),
+				"error", err,
+			)
+
+			continue
+		}
+		write()
+	}
+}
@@ -86,6 +86,52 @@ func (_c *PriceApplier_ApplyPricesFromVoteExtensions_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetDroppedPricesForValidator provides a mock function with given fields: validator
+func (_m *PriceApplier) GetDroppedPricesForValidator(validator types.ConsAddress) uint32 {
+	ret := _m.Called(validator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDroppedPricesForValidator")
+	}
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(types.ConsAddress) uint32); ok {
+		r0 = rf(validator)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// PriceApplier_GetDroppedPricesForValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDroppedPricesForValidator'
+type PriceApplier_GetDroppedPricesForValidator_Call struct {
+	*mock.Call
+}
+
+// GetDroppedPricesForValidator is a helper method to define mock.On call
+//   - validator types.ConsAddress
+func (_e *PriceApplier_Expecter) GetDroppedPricesForValidator(validator interface{}) *PriceApplier_GetDroppedPricesForValidator_Call {
+	return &PriceApplier_GetDroppedPricesForValidator_Call{Call: _e.mock.On("GetDroppedPricesForValidator", validator)}
+}
+
+func (_c *PriceApplier_GetDroppedPricesForValidator_Call) Run(run func(validator types.ConsAddress)) *PriceApplier_GetDroppedPricesForValidator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.ConsAddress))
+	})
+	return _c
+}
+
+func (_c *PriceApplier_GetDroppedPricesForValidator_Call) Return(_a0 uint32) *PriceApplier_GetDroppedPricesForValidator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceApplier_GetDroppedPricesForValidator_Call) RunAndReturn(run func(types.ConsAddress) uint32) *PriceApplier_GetDroppedPricesForValidator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPricesForValidator provides a mock function with given fields: validator
 func (_m *PriceApplier) GetPricesForValidator(validator types.ConsAddress) map[pkgtypes.CurrencyPair]*big.Int {
 	ret := _m.Called(validator)
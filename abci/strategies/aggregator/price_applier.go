@@ -29,6 +29,11 @@ type PriceApplier interface {
 	// GetPriceForValidator gets the prices reported by a given validator. This method depends
 	// on the prices from the latest set of aggregated votes.
 	GetPricesForValidator(validator sdk.ConsAddress) map[connecttypes.CurrencyPair]*big.Int
+
+	// GetDroppedPricesForValidator gets the number of prices a given validator dropped from its
+	// vote extension to fit its size budget. This method depends on the latest set of aggregated
+	// votes.
+	GetDroppedPricesForValidator(validator sdk.ConsAddress) uint32
 }
 
 // oraclePriceApplier is an implementation of PriceApplier that applies prices to the oracle module.
@@ -39,6 +44,10 @@ type oraclePriceApplier struct {
 	// ok is the oracle keeper that is used to write prices to state.
 	ok connectabcitypes.OracleKeeper
 
+	// droppedPrices is the number of prices each validator dropped from its vote extension in the
+	// latest set of aggregated votes, keyed by the validator's consensus address.
+	droppedPrices map[string]uint32
+
 	// logger
 	logger log.Logger
 
@@ -85,6 +94,13 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 		"num_votes", len(votes),
 	)
 
+	opa.droppedPrices = make(map[string]uint32, len(votes))
+	for _, vote := range votes {
+		if vote.OracleVoteExtension.DroppedPrices > 0 {
+			opa.droppedPrices[vote.ConsAddress.String()] = vote.OracleVoteExtension.DroppedPrices
+		}
+	}
+
 	// Aggregate all oracle vote extensions into a single set of prices.
 	prices, err := opa.va.AggregateOracleVotes(ctx, votes)
 	if err != nil {
@@ -153,3 +169,7 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 func (opa *oraclePriceApplier) GetPricesForValidator(validator sdk.ConsAddress) map[connecttypes.CurrencyPair]*big.Int {
 	return opa.va.GetPriceForValidator(validator)
 }
+
+func (opa *oraclePriceApplier) GetDroppedPricesForValidator(validator sdk.ConsAddress) uint32 {
+	return opa.droppedPrices[validator.String()]
+}
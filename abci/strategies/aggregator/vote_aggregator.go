@@ -12,6 +12,7 @@ import (
 	connectabci "github.com/skip-mev/connect/v2/abci/types"
 	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	"github.com/skip-mev/connect/v2/aggregator"
+	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 )
 
@@ -110,12 +111,16 @@ type DefaultVoteAggregator struct {
 	// decoding prices / currency-pair ids
 	currencyPairStrategy currencypair.CurrencyPairStrategy
 
+	// validator address -> currency-pair -> price metadata of extended vote extensions
+	metadata voteweighted.PriceMetadata
+
 	logger log.Logger
 }
 
 func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []Vote) (map[connecttypes.CurrencyPair]*big.Int, error) {
 	// Reset the price aggregator and set the aggregationFn to use the latest application-state.
 	dva.priceAggregator.ResetProviderData()
+	dva.metadata = make(voteweighted.PriceMetadata)
 
 	// Iterate through all vote extensions and consolidate all price info before
 	// aggregating.
@@ -133,7 +138,11 @@ func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []
 		}
 	}
 
-	// Compute the final prices for each currency pair.
+	// Compute the final prices for each currency pair. The metadata of extended vote extensions
+	// is passed to the aggregation function through the context.
+	if len(dva.metadata) > 0 {
+		ctx = voteweighted.ContextWithPriceMetadata(ctx, dva.metadata)
+	}
 	dva.priceAggregator.AggregateDataFromContext(ctx)
 	prices := dva.priceAggregator.GetAggregatedData()
 
@@ -156,6 +165,7 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 
 	// Format all prices into a map of currency pair -> price.
 	prices := make(map[connecttypes.CurrencyPair]*big.Int, len(oracleData.Prices))
+	metadata := make(map[connecttypes.CurrencyPair]vetypes.PriceMetadata, len(oracleData.Metadata))
 	for cpID, priceBz := range oracleData.Prices {
 		if len(priceBz) > connectabci.MaximumPriceSize {
 			dva.logger.Debug(
@@ -192,6 +202,9 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 		}
 
 		prices[cp] = price
+		if md, ok := oracleData.Metadata[cpID]; ok {
+			metadata[cp] = md
+		}
 	}
 
 	dva.logger.Debug(
@@ -201,6 +214,9 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 	)
 
 	dva.priceAggregator.SetProviderData(address, prices)
+	if len(metadata) > 0 {
+		dva.metadata[address] = metadata
+	}
 
 	return nil
 }
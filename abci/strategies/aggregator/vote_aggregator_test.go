@@ -16,6 +16,8 @@ import (
 	"github.com/skip-mev/connect/v2/abci/strategies/codec"
 	currencypairmocks "github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
 	"github.com/skip-mev/connect/v2/abci/testutils"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+	connectaggregator "github.com/skip-mev/connect/v2/aggregator"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted/mocks"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -462,3 +464,63 @@ func (s *VoteAggregatorTestSuite) TestAggregateOracleVotes() {
 		s.Require().Len(prices, 0)
 	})
 }
+
+func (s *VoteAggregatorTestSuite) TestAggregateOracleVotesWithMetadata() {
+	ctx := testutils.CreateBaseSDKContext(s.T())
+
+	// Capture the metadata that is passed to the aggregate function.
+	var metadata voteweighted.PriceMetadata
+	aggregationFn := func(ctx sdk.Context) connectaggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+		metadata = voteweighted.PriceMetadataFromContext(ctx)
+		return func(connectaggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
+			return nil
+		}
+	}
+
+	cpID := currencypairmocks.NewCurrencyPairStrategy(s.T())
+	handler := aggregator.NewDefaultVoteAggregator(
+		log.NewTestLogger(s.T()),
+		aggregationFn,
+		cpID,
+	)
+
+	cpID.On("FromID", mock.Anything, uint64(0)).Return(btcUSD, nil)
+	cpID.On("GetDecodedPrice", mock.Anything, btcUSD, oneHundred.Bytes()).Return(oneHundred, nil)
+	cpID.On("FromID", mock.Anything, uint64(1)).Return(ethUSD, nil)
+	cpID.On("GetDecodedPrice", mock.Anything, ethUSD, twoHundred.Bytes()).Return(twoHundred, nil)
+
+	btcMetadata := vetypes.PriceMetadata{Timestamp: 1700000000000, ProviderCount: 3, Dispersion: 25}
+	votes := []aggregator.Vote{
+		{
+			ConsAddress: val1,
+			OracleVoteExtension: vetypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{
+					0: oneHundred.Bytes(),
+					1: twoHundred.Bytes(),
+				},
+				Metadata: map[uint64]vetypes.PriceMetadata{
+					0: btcMetadata,
+				},
+			},
+		},
+		{
+			ConsAddress: val2,
+			OracleVoteExtension: vetypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{
+					0: oneHundred.Bytes(),
+				},
+			},
+		},
+	}
+
+	_, err := handler.AggregateOracleVotes(ctx, votes)
+	s.Require().NoError(err)
+	s.Require().Equal(voteweighted.PriceMetadata{
+		val1.String(): {btcUSD: btcMetadata},
+	}, metadata)
+
+	// The metadata is reset on every aggregation.
+	_, err = handler.AggregateOracleVotes(ctx, votes[1:])
+	s.Require().NoError(err)
+	s.Require().Empty(metadata)
+}
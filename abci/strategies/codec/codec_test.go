@@ -33,6 +33,34 @@ func TestDefaultVoteExtensionCodec(t *testing.T) {
 		require.Equal(t, ve.Prices, decodedVe.Prices)
 	})
 
+	t.Run("test encoding / decoding an extended vote extension", func(t *testing.T) {
+		ve := vetypes.OracleVoteExtension{
+			Prices: map[uint64][]byte{
+				1: []byte("1"),
+				2: []byte("2"),
+			},
+			Metadata: map[uint64]vetypes.PriceMetadata{
+				1: {
+					Timestamp:     1700000000000,
+					ProviderCount: 3,
+					Dispersion:    25,
+				},
+			},
+		}
+		codec := compression.NewDefaultVoteExtensionCodec()
+		bz, err := codec.Encode(ve)
+		require.NoError(t, err)
+
+		decodedVe, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, ve, decodedVe)
+
+		// vote extensions without metadata are encoded as before
+		legacyBz, err := codec.Encode(vetypes.OracleVoteExtension{Prices: ve.Prices})
+		require.NoError(t, err)
+		require.Less(t, len(legacyBz), len(bz))
+	})
+
 	t.Run("test decoding empty byte array", func(t *testing.T) {
 		codec := compression.NewDefaultVoteExtensionCodec()
 		_, err := codec.Decode([]byte{})
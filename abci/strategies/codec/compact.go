@@ -0,0 +1,417 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"sort"
+
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+)
+
+const (
+	// CompactVoteExtensionVersion is the version of the compact vote extension encoding.
+	CompactVoteExtensionVersion = 1
+
+	// MaxCompactCurrencyPairID is the largest currency pair ID that can be encoded by the
+	// CompactVoteExtensionCodec. This bounds the size of the bitmap that is allocated when
+	// decoding a vote extension.
+	MaxCompactCurrencyPairID = 1<<20 - 1
+
+	// MaxPackedIntSize is the maximum size of a packed integer in bytes.
+	MaxPackedIntSize = 64
+
+	// compactHeaderSize is the size of the version and value width header.
+	compactHeaderSize = 2
+)
+
+// CompactCodecOption is a function that enables optional configuration of the
+// CompactVoteExtensionCodec.
+type CompactCodecOption func(*CompactVoteExtensionCodec)
+
+// WithFixedWidthValues configures the CompactVoteExtensionCodec to encode each price as a fixed
+// width integer of the given number of bytes instead of a varint. Encoding fails if a price does
+// not fit in the given width. A width of zero encodes prices as varints.
+func WithFixedWidthValues(width uint8) CompactCodecOption {
+	return func(codec *CompactVoteExtensionCodec) {
+		codec.width = width
+	}
+}
+
+// CompactVoteExtensionCodec is a VoteExtensionCodec that encodes vote extensions as a bitmap of
+// the currency pair IDs that are present, followed by the packed price of each present currency
+// pair in ascending ID order. It must be used with a currency pair strategy that encodes prices
+// as packed integers, i.e. the CompactCurrencyPairStrategy. The encoding is
+//
+//	version (1 byte) | value width (1 byte, 0 for varints)
+//	uvarint bitmap length in bits | bitmap (LSB first)
+//	packed prices
+//	uvarint number of metadata entries | (uvarint id, varint timestamp, uvarint provider count, uvarint dispersion)...
+//	uvarint number of dropped prices (omitted if zero)
+//
+// Prices encoded with either value width can be decoded by any CompactVoteExtensionCodec.
+type CompactVoteExtensionCodec struct {
+	width uint8
+}
+
+// NewCompactVoteExtensionCodec returns a new CompactVoteExtensionCodec. By default, prices are
+// encoded as varints.
+func NewCompactVoteExtensionCodec(opts ...CompactCodecOption) *CompactVoteExtensionCodec {
+	codec := &CompactVoteExtensionCodec{}
+	for _, opt := range opts {
+		opt(codec)
+	}
+
+	return codec
+}
+
+// Encode encodes the vote extension into the compact encoding. Empty vote extensions are encoded
+// as an empty byte array.
+func (codec *CompactVoteExtensionCodec) Encode(ve vetypes.OracleVoteExtension) ([]byte, error) {
+	if len(ve.Prices) == 0 && len(ve.Metadata) == 0 && ve.DroppedPrices == 0 {
+		return nil, nil
+	}
+
+	ids := sortedIDs(ve.Prices)
+
+	var numBits uint64
+	if len(ids) > 0 {
+		maxID := ids[len(ids)-1]
+		if maxID > MaxCompactCurrencyPairID {
+			return nil, fmt.Errorf("currency pair id %d exceeds the maximum id of %d", maxID, MaxCompactCurrencyPairID)
+		}
+		numBits = maxID + 1
+	}
+
+	bz := make([]byte, 0, compactHeaderSize+binary.MaxVarintLen64+int(numBits+7)/8+len(ids)*binary.MaxVarintLen64)
+	bz = append(bz, CompactVoteExtensionVersion, codec.width)
+
+	// write the bitmap of the present currency pair ids
+	bz = binary.AppendUvarint(bz, numBits)
+	bitmap := make([]byte, (numBits+7)/8)
+	for _, id := range ids {
+		bitmap[id/8] |= 1 << (id % 8)
+	}
+	bz = append(bz, bitmap...)
+
+	// write the packed prices
+	for _, id := range ids {
+		price, err := DecodePackedInt(ve.Prices[id])
+		if err != nil {
+			return nil, fmt.Errorf("price for currency pair %d is not a packed integer: %w", id, err)
+		}
+
+		if codec.width == 0 {
+			bz = append(bz, ve.Prices[id]...)
+			continue
+		}
+
+		z := zigzag(price)
+		if z.BitLen() > 8*int(codec.width) {
+			return nil, fmt.Errorf("price for currency pair %d does not fit in %d bytes", id, codec.width)
+		}
+		bz = append(bz, z.FillBytes(make([]byte, codec.width))...)
+	}
+
+	// write the metadata of extended vote extensions
+	bz = binary.AppendUvarint(bz, uint64(len(ve.Metadata)))
+	for _, id := range sortedIDs(ve.Metadata) {
+		if _, ok := ve.Prices[id]; !ok {
+			return nil, fmt.Errorf("metadata for currency pair %d has no price", id)
+		}
+
+		md := ve.Metadata[id]
+		bz = binary.AppendUvarint(bz, id)
+		bz = binary.AppendVarint(bz, md.Timestamp)
+		bz = binary.AppendUvarint(bz, uint64(md.ProviderCount))
+		bz = binary.AppendUvarint(bz, uint64(md.Dispersion))
+	}
+
+	// write the number of prices dropped to fit the size budget
+	if ve.DroppedPrices > 0 {
+		bz = binary.AppendUvarint(bz, uint64(ve.DroppedPrices))
+	}
+
+	return bz, nil
+}
+
+// Decode decodes a vote extension from the compact encoding. The prices of the decoded vote
+// extension are packed integers, regardless of the value width they were encoded with.
+func (codec *CompactVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVoteExtension, error) {
+	var ve vetypes.OracleVoteExtension
+	if len(bz) == 0 {
+		return ve, nil
+	}
+
+	if len(bz) < compactHeaderSize {
+		return ve, fmt.Errorf("compact vote extension is too short: %d bytes", len(bz))
+	}
+
+	if bz[0] != CompactVoteExtensionVersion {
+		return ve, fmt.Errorf("unsupported compact vote extension version %d", bz[0])
+	}
+	width := bz[1]
+	r := compactReader{bz: bz[compactHeaderSize:]}
+
+	// read the bitmap of the present currency pair ids
+	numBits, err := r.uvarint()
+	if err != nil {
+		return ve, fmt.Errorf("failed to read bitmap length: %w", err)
+	}
+	if numBits > MaxCompactCurrencyPairID+1 {
+		return ve, fmt.Errorf("bitmap length %d exceeds the maximum of %d", numBits, MaxCompactCurrencyPairID+1)
+	}
+	bitmap, err := r.bytes(int(numBits+7) / 8)
+	if err != nil {
+		return ve, fmt.Errorf("failed to read bitmap: %w", err)
+	}
+
+	// read the packed prices
+	ve.Prices = make(map[uint64][]byte)
+	for i, b := range bitmap {
+		for b != 0 {
+			id := uint64(i)*8 + uint64(bits.TrailingZeros8(b))
+			b &= b - 1
+
+			if id >= numBits {
+				return ve, fmt.Errorf("bitmap sets currency pair id %d beyond its length", id)
+			}
+
+			price, err := r.packedInt(width)
+			if err != nil {
+				return ve, fmt.Errorf("failed to read price for currency pair %d: %w", id, err)
+			}
+			ve.Prices[id] = price
+		}
+	}
+
+	// read the metadata of extended vote extensions
+	numMetadata, err := r.uvarint()
+	if err != nil {
+		return ve, fmt.Errorf("failed to read number of metadata entries: %w", err)
+	}
+	if numMetadata > uint64(len(ve.Prices)) {
+		return ve, fmt.Errorf("number of metadata entries %d exceeds number of prices %d", numMetadata, len(ve.Prices))
+	}
+	if numMetadata > 0 {
+		ve.Metadata = make(map[uint64]vetypes.PriceMetadata, numMetadata)
+	}
+	for i := uint64(0); i < numMetadata; i++ {
+		md, id, err := r.metadata()
+		if err != nil {
+			return ve, fmt.Errorf("failed to read metadata: %w", err)
+		}
+		if _, ok := ve.Prices[id]; !ok {
+			return ve, fmt.Errorf("metadata for currency pair %d has no price", id)
+		}
+		if _, ok := ve.Metadata[id]; ok {
+			return ve, fmt.Errorf("duplicate metadata for currency pair %d", id)
+		}
+		ve.Metadata[id] = md
+	}
+
+	// read the number of prices dropped to fit the size budget, if any
+	if len(r.bz) > 0 {
+		dropped, err := r.uvarint()
+		if err != nil {
+			return ve, fmt.Errorf("failed to read number of dropped prices: %w", err)
+		}
+		if dropped == 0 || dropped > uint64(^uint32(0)) {
+			return ve, fmt.Errorf("invalid number of dropped prices %d", dropped)
+		}
+		ve.DroppedPrices = uint32(dropped)
+	}
+
+	if len(r.bz) > 0 {
+		return ve, fmt.Errorf("compact vote extension has %d trailing bytes", len(r.bz))
+	}
+
+	return ve, nil
+}
+
+// EncodePackedInt encodes an integer as a packed integer, i.e. a zigzag encoded, little-endian
+// base 128 varint. Small integers of either sign are encoded in few bytes, and integers of any
+// size can be encoded.
+func EncodePackedInt(x *big.Int) []byte {
+	z := zigzag(x)
+	if z.IsUint64() {
+		return binary.AppendUvarint(nil, z.Uint64())
+	}
+
+	var (
+		bz    []byte
+		group = new(big.Int)
+		mask  = big.NewInt(0x7f)
+	)
+	for z.BitLen() > 7 {
+		bz = append(bz, byte(group.And(z, mask).Uint64())|0x80)
+		z.Rsh(z, 7)
+	}
+
+	return append(bz, byte(z.Uint64()))
+}
+
+// DecodePackedInt decodes a packed integer. It returns an error if the bytes are not exactly one
+// minimally encoded packed integer.
+func DecodePackedInt(bz []byte) (*big.Int, error) {
+	n, err := packedIntLen(bz)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(bz) {
+		return nil, fmt.Errorf("packed integer has %d trailing bytes", len(bz)-n)
+	}
+
+	if v, read := binary.Uvarint(bz); read > 0 {
+		return unzigzag(new(big.Int).SetUint64(v)), nil
+	}
+
+	z := new(big.Int)
+	for i := len(bz) - 1; i >= 0; i-- {
+		z.Lsh(z, 7)
+		z.Or(z, big.NewInt(int64(bz[i]&0x7f)))
+	}
+
+	return unzigzag(z), nil
+}
+
+// packedIntLen returns the length of the minimally encoded packed integer at the start of the
+// given bytes.
+func packedIntLen(bz []byte) (int, error) {
+	for i, b := range bz {
+		if i >= MaxPackedIntSize {
+			return 0, fmt.Errorf("packed integer exceeds %d bytes", MaxPackedIntSize)
+		}
+
+		if b&0x80 == 0 {
+			if b == 0 && i > 0 {
+				return 0, fmt.Errorf("packed integer is not minimally encoded")
+			}
+
+			return i + 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("packed integer is truncated")
+}
+
+// zigzag maps signed integers to unsigned integers, so that integers with a small absolute value
+// have a small encoding.
+func zigzag(x *big.Int) *big.Int {
+	z := new(big.Int).Lsh(x, 1)
+	if x.Sign() < 0 {
+		z.Neg(z)
+		z.Sub(z, big.NewInt(1))
+	}
+
+	return z
+}
+
+// unzigzag is the inverse of zigzag.
+func unzigzag(z *big.Int) *big.Int {
+	x := new(big.Int).Rsh(z, 1)
+	if z.Bit(0) == 1 {
+		x.Add(x, big.NewInt(1))
+		x.Neg(x)
+	}
+
+	return x
+}
+
+// sortedIDs returns the keys of the given map in ascending order.
+func sortedIDs[V any](m map[uint64]V) []uint64 {
+	ids := make([]uint64, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
+
+// compactReader reads the fields of a compact vote extension.
+type compactReader struct {
+	bz []byte
+}
+
+func (r *compactReader) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.bz)
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid uvarint")
+	}
+	r.bz = r.bz[n:]
+
+	return v, nil
+}
+
+func (r *compactReader) varint() (int64, error) {
+	v, n := binary.Varint(r.bz)
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid varint")
+	}
+	r.bz = r.bz[n:]
+
+	return v, nil
+}
+
+func (r *compactReader) bytes(n int) ([]byte, error) {
+	if len(r.bz) < n {
+		return nil, fmt.Errorf("expected %d bytes, got %d", n, len(r.bz))
+	}
+	bz := r.bz[:n]
+	r.bz = r.bz[n:]
+
+	return bz, nil
+}
+
+// packedInt reads a price of the given width, and returns it as a packed integer.
+func (r *compactReader) packedInt(width uint8) ([]byte, error) {
+	if width == 0 {
+		n, err := packedIntLen(r.bz)
+		if err != nil {
+			return nil, err
+		}
+
+		return r.bytes(n)
+	}
+
+	bz, err := r.bytes(int(width))
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodePackedInt(unzigzag(new(big.Int).SetBytes(bz))), nil
+}
+
+func (r *compactReader) metadata() (vetypes.PriceMetadata, uint64, error) {
+	id, err := r.uvarint()
+	if err != nil {
+		return vetypes.PriceMetadata{}, 0, err
+	}
+
+	timestamp, err := r.varint()
+	if err != nil {
+		return vetypes.PriceMetadata{}, 0, err
+	}
+
+	providerCount, err := r.uvarint()
+	if err != nil {
+		return vetypes.PriceMetadata{}, 0, err
+	}
+
+	dispersion, err := r.uvarint()
+	if err != nil {
+		return vetypes.PriceMetadata{}, 0, err
+	}
+
+	if providerCount > uint64(^uint32(0)) || dispersion > uint64(^uint32(0)) {
+		return vetypes.PriceMetadata{}, 0, fmt.Errorf("metadata for currency pair %d overflows", id)
+	}
+
+	return vetypes.PriceMetadata{
+		Timestamp:     timestamp,
+		ProviderCount: uint32(providerCount),
+		Dispersion:    uint32(dispersion),
+	}, id, nil
+}
@@ -0,0 +1,309 @@
+package codec_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+)
+
+func TestPackedInt(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    *big.Int
+		expected []byte
+	}{
+		{
+			name:     "zero",
+			value:    big.NewInt(0),
+			expected: []byte{0x00},
+		},
+		{
+			name:     "small positive",
+			value:    big.NewInt(1),
+			expected: []byte{0x02},
+		},
+		{
+			name:     "small negative",
+			value:    big.NewInt(-1),
+			expected: []byte{0x01},
+		},
+		{
+			name:     "multi-byte positive",
+			value:    big.NewInt(100),
+			expected: []byte{0xc8, 0x01},
+		},
+		{
+			name:  "larger than 64 bits",
+			value: new(big.Int).Lsh(big.NewInt(1), 100),
+		},
+		{
+			name:  "negative larger than 64 bits",
+			value: new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(3), 90)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz := codec.EncodePackedInt(tc.value)
+			if tc.expected != nil {
+				require.Equal(t, tc.expected, bz)
+			}
+
+			decoded, err := codec.DecodePackedInt(bz)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.value.Cmp(decoded))
+		})
+	}
+
+	t.Run("rejects invalid packed integers", func(t *testing.T) {
+		for _, bz := range [][]byte{
+			nil,
+			{0x80},
+			{0x80, 0x00},
+			{0x02, 0x02},
+			append(bytes.Repeat([]byte{0x80}, codec.MaxPackedIntSize), 0x01),
+		} {
+			_, err := codec.DecodePackedInt(bz)
+			require.Error(t, err, "%x", bz)
+		}
+	})
+}
+
+func TestCompactVoteExtensionCodec(t *testing.T) {
+	ve := vetypes.OracleVoteExtension{
+		Prices: map[uint64][]byte{
+			0:  codec.EncodePackedInt(big.NewInt(100)),
+			3:  codec.EncodePackedInt(big.NewInt(-5)),
+			17: codec.EncodePackedInt(new(big.Int).Lsh(big.NewInt(1), 70)),
+		},
+	}
+	extended := vetypes.OracleVoteExtension{
+		Prices: ve.Prices,
+		Metadata: map[uint64]vetypes.PriceMetadata{
+			3: {
+				Timestamp:     1700000000000,
+				ProviderCount: 3,
+				Dispersion:    25,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		codec  *codec.CompactVoteExtensionCodec
+		ve     vetypes.OracleVoteExtension
+		expErr bool
+	}{
+		{
+			name:  "empty vote extension",
+			codec: codec.NewCompactVoteExtensionCodec(),
+			ve:    vetypes.OracleVoteExtension{},
+		},
+		{
+			name:  "varint values",
+			codec: codec.NewCompactVoteExtensionCodec(),
+			ve:    ve,
+		},
+		{
+			name:  "fixed width values",
+			codec: codec.NewCompactVoteExtensionCodec(codec.WithFixedWidthValues(9)),
+			ve:    ve,
+		},
+		{
+			name:  "extended vote extension",
+			codec: codec.NewCompactVoteExtensionCodec(),
+			ve:    extended,
+		},
+		{
+			name:  "vote extension with dropped prices",
+			codec: codec.NewCompactVoteExtensionCodec(),
+			ve: vetypes.OracleVoteExtension{
+				Prices:        ve.Prices,
+				DroppedPrices: 300,
+			},
+		},
+		{
+			name:  "vote extension with only dropped prices",
+			codec: codec.NewCompactVoteExtensionCodec(),
+			ve: vetypes.OracleVoteExtension{
+				DroppedPrices: 2,
+			},
+		},
+		{
+			name:   "fixed width values that are too small",
+			codec:  codec.NewCompactVoteExtensionCodec(codec.WithFixedWidthValues(8)),
+			ve:     ve,
+			expErr: true,
+		},
+		{
+			name:  "prices that are not packed integers",
+			codec: codec.NewCompactVoteExtensionCodec(),
+			ve: vetypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{
+					0: gobEncode(t, big.NewInt(100)),
+				},
+			},
+			expErr: true,
+		},
+		{
+			name:  "metadata without a price",
+			codec: codec.NewCompactVoteExtensionCodec(),
+			ve: vetypes.OracleVoteExtension{
+				Prices: ve.Prices,
+				Metadata: map[uint64]vetypes.PriceMetadata{
+					4: {ProviderCount: 1},
+				},
+			},
+			expErr: true,
+		},
+		{
+			name:  "currency pair id that is too large",
+			codec: codec.NewCompactVoteExtensionCodec(),
+			ve: vetypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{
+					codec.MaxCompactCurrencyPairID + 1: codec.EncodePackedInt(big.NewInt(100)),
+				},
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := tc.codec.Encode(tc.ve)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// any compact codec decodes the vote extension, regardless of the value width
+			decoded, err := codec.NewCompactVoteExtensionCodec().Decode(bz)
+			require.NoError(t, err)
+			require.Equal(t, len(tc.ve.Prices), len(decoded.Prices))
+			for id, price := range tc.ve.Prices {
+				require.Equal(t, price, decoded.Prices[id])
+			}
+			require.Equal(t, len(tc.ve.Metadata), len(decoded.Metadata))
+			for id, md := range tc.ve.Metadata {
+				require.Equal(t, md, decoded.Metadata[id])
+			}
+			require.Equal(t, tc.ve.DroppedPrices, decoded.DroppedPrices)
+		})
+	}
+
+	t.Run("rejects malformed vote extensions", func(t *testing.T) {
+		valid, err := codec.NewCompactVoteExtensionCodec().Encode(extended)
+		require.NoError(t, err)
+
+		for _, bz := range [][]byte{
+			{codec.CompactVoteExtensionVersion},
+			{codec.CompactVoteExtensionVersion + 1, 0, 0, 0},
+			{codec.CompactVoteExtensionVersion, 0, 0xff, 0xff, 0xff, 0x01},
+			valid[:len(valid)-1],
+			append(append([]byte{}, valid...), 0x00),
+			// metadata for currency pair 5, which has no price
+			{codec.CompactVoteExtensionVersion, 0, 1, 0x01, 0x02, 1, 5, 0, 0, 0},
+			// duplicate metadata for currency pair 0
+			{codec.CompactVoteExtensionVersion, 0, 2, 0x03, 0x02, 0x02, 2, 0, 0, 0, 0, 0, 0, 0, 0},
+		} {
+			_, err := codec.NewCompactVoteExtensionCodec().Decode(bz)
+			require.Error(t, err, "%x", bz)
+		}
+	})
+
+	t.Run("composes with compression", func(t *testing.T) {
+		compressed := codec.NewCompressionVoteExtensionCodec(codec.NewCompactVoteExtensionCodec(), codec.NewZStdCompressor())
+
+		bz, err := compressed.Encode(extended)
+		require.NoError(t, err)
+
+		decoded, err := compressed.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, extended, decoded)
+	})
+}
+
+// BenchmarkVoteExtensionCodecs reports the size of vote extensions with the given number of
+// prices, encoded by the default and compact codecs with and without compression. Prices are
+// encoded as both raw prices and deltas against the on-chain price, in the manner of the
+// respective currency pair strategies.
+func BenchmarkVoteExtensionCodecs(b *testing.B) {
+	codecs := []struct {
+		name    string
+		compact bool
+		codec   codec.VoteExtensionCodec
+	}{
+		{"default", false, codec.NewDefaultVoteExtensionCodec()},
+		{"default+zlib", false, codec.NewCompressionVoteExtensionCodec(codec.NewDefaultVoteExtensionCodec(), codec.NewZLibCompressor())},
+		{"default+zstd", false, codec.NewCompressionVoteExtensionCodec(codec.NewDefaultVoteExtensionCodec(), codec.NewZStdCompressor())},
+		{"compact", true, codec.NewCompactVoteExtensionCodec()},
+		{"compact-fixed8", true, codec.NewCompactVoteExtensionCodec(codec.WithFixedWidthValues(8))},
+		{"compact+zstd", true, codec.NewCompressionVoteExtensionCodec(codec.NewCompactVoteExtensionCodec(), codec.NewZStdCompressor())},
+	}
+
+	for _, numPrices := range []int{100, 1000, 5000} {
+		for _, delta := range []bool{false, true} {
+			for _, c := range codecs {
+				ve := benchmarkVoteExtension(b, numPrices, delta, c.compact)
+
+				name := fmt.Sprintf("%s/prices=%d/delta=%t", c.name, numPrices, delta)
+				b.Run(name, func(b *testing.B) {
+					var bz []byte
+					for n := 0; n < b.N; n++ {
+						var err error
+						bz, err = c.codec.Encode(ve)
+						if err != nil {
+							b.Fatal(err)
+						}
+					}
+					b.ReportMetric(float64(len(bz)), "bytes")
+				})
+			}
+		}
+	}
+}
+
+// benchmarkVoteExtension returns a vote extension with the given number of prices in the order of
+// 10^11, which is typical for prices with 8 decimals. Deltas are within 0.1% of the price.
+func benchmarkVoteExtension(b *testing.B, numPrices int, delta, compact bool) vetypes.OracleVoteExtension {
+	b.Helper()
+
+	ve := vetypes.OracleVoteExtension{
+		Prices: make(map[uint64][]byte, numPrices),
+	}
+	for i := 0; i < numPrices; i++ {
+		price := big.NewInt(100_000_000_000 + int64(i)*7_919_993)
+		if delta {
+			price = big.NewInt(int64(i*7919)%200_000_000 - 100_000_000)
+		}
+
+		if compact {
+			ve.Prices[uint64(i)] = codec.EncodePackedInt(price)
+			continue
+		}
+
+		bz, err := price.GobEncode()
+		if err != nil {
+			b.Fatal(err)
+		}
+		ve.Prices[uint64(i)] = bz
+	}
+
+	return ve
+}
+
+func gobEncode(t *testing.T, x *big.Int) []byte {
+	t.Helper()
+
+	bz, err := x.GobEncode()
+	require.NoError(t, err)
+
+	return bz
+}
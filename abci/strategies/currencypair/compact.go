@@ -0,0 +1,88 @@
+package currencypair
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// CompactCurrencyPairStrategy is a strategy that encodes/decodes prices as packed integers, which
+// is the price representation expected by the CompactVoteExtensionCodec. If delta encoding is
+// enabled, the packed integer is the difference between the price and the current on-chain price,
+// in the same manner as the DeltaCurrencyPairStrategy.
+type CompactCurrencyPairStrategy struct {
+	*DeltaCurrencyPairStrategy
+	delta bool
+}
+
+// NewCompactCurrencyPairStrategy returns a new CompactCurrencyPairStrategy instance that encodes
+// raw prices.
+func NewCompactCurrencyPairStrategy(oracleKeeper OracleKeeper) *CompactCurrencyPairStrategy {
+	return &CompactCurrencyPairStrategy{
+		DeltaCurrencyPairStrategy: NewDeltaCurrencyPairStrategy(oracleKeeper),
+	}
+}
+
+// NewCompactDeltaCurrencyPairStrategy returns a new CompactCurrencyPairStrategy instance that
+// encodes the delta between each price and the current on-chain price.
+func NewCompactDeltaCurrencyPairStrategy(oracleKeeper OracleKeeper) *CompactCurrencyPairStrategy {
+	return &CompactCurrencyPairStrategy{
+		DeltaCurrencyPairStrategy: NewDeltaCurrencyPairStrategy(oracleKeeper),
+		delta:                     true,
+	}
+}
+
+// GetEncodedPrice returns the encoded price for the given currency pair as a packed integer. If
+// delta encoding is enabled, the current on-chain price is first subtracted from the price.
+func (s *CompactCurrencyPairStrategy) GetEncodedPrice(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	price *big.Int,
+) ([]byte, error) {
+	if price.Sign() < 0 {
+		return nil, fmt.Errorf("price cannot be negative: %s", price.String())
+	}
+
+	if !s.delta {
+		return codec.EncodePackedInt(price), nil
+	}
+
+	onChainPrice, err := s.getOnChainPrice(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.EncodePackedInt(new(big.Int).Sub(price, onChainPrice)), nil
+}
+
+// GetDecodedPrice returns the decoded price for the given currency pair from a packed integer. If
+// delta encoding is enabled, the current on-chain price is added to the decoded delta.
+func (s *CompactCurrencyPairStrategy) GetDecodedPrice(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	priceBytes []byte,
+) (*big.Int, error) {
+	price, err := codec.DecodePackedInt(priceBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.delta {
+		onChainPrice, err := s.getOnChainPrice(ctx, cp)
+		if err != nil {
+			return nil, err
+		}
+
+		price.Add(price, onChainPrice)
+	}
+
+	if price.Sign() < 0 {
+		return nil, fmt.Errorf("price cannot be negative: %s", price.String())
+	}
+
+	return price, nil
+}
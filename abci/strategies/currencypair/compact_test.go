@@ -0,0 +1,102 @@
+package currencypair_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	mocks "github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
+	"github.com/skip-mev/connect/v2/abci/testutils"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestCompactCurrencyPairStrategy(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("BTC", "USD")
+
+	t.Run("raw prices are encoded as packed integers", func(t *testing.T) {
+		ctx := testutils.CreateBaseSDKContext(t)
+		strategy := currencypair.NewCompactCurrencyPairStrategy(mocks.NewOracleKeeper(t))
+
+		price := big.NewInt(100)
+		encodedPrice, err := strategy.GetEncodedPrice(ctx, cp, price)
+		require.NoError(t, err)
+		require.Equal(t, codec.EncodePackedInt(price), encodedPrice)
+
+		decodedPrice, err := strategy.GetDecodedPrice(ctx, cp, encodedPrice)
+		require.NoError(t, err)
+		require.Equal(t, price, decodedPrice)
+	})
+
+	t.Run("deltas are encoded as packed integers", func(t *testing.T) {
+		ok := mocks.NewOracleKeeper(t)
+		ctx := testutils.CreateBaseSDKContext(t)
+		strategy := currencypair.NewCompactDeltaCurrencyPairStrategy(ok)
+
+		ok.On(
+			"GetPriceForCurrencyPair",
+			mock.Anything,
+			cp,
+		).Return(oracletypes.QuotePrice{Price: math.NewInt(100)}, nil).Once()
+
+		price := big.NewInt(80)
+		encodedPrice, err := strategy.GetEncodedPrice(ctx, cp, price)
+		require.NoError(t, err)
+		require.Equal(t, codec.EncodePackedInt(big.NewInt(-20)), encodedPrice)
+
+		decodedPrice, err := strategy.GetDecodedPrice(ctx, cp, encodedPrice)
+		require.NoError(t, err)
+		require.Equal(t, price, decodedPrice)
+	})
+
+	t.Run("delta is the price if the price does not exist in state", func(t *testing.T) {
+		ok := mocks.NewOracleKeeper(t)
+		ctx := testutils.CreateBaseSDKContext(t)
+		strategy := currencypair.NewCompactDeltaCurrencyPairStrategy(ok)
+
+		ok.On(
+			"GetPriceForCurrencyPair",
+			mock.Anything,
+			cp,
+		).Return(oracletypes.QuotePrice{}, oracletypes.QuotePriceNotExistError{}).Once()
+
+		price := big.NewInt(100)
+		encodedPrice, err := strategy.GetEncodedPrice(ctx, cp, price)
+		require.NoError(t, err)
+		require.Equal(t, codec.EncodePackedInt(price), encodedPrice)
+	})
+
+	t.Run("negative prices are rejected", func(t *testing.T) {
+		ok := mocks.NewOracleKeeper(t)
+		ctx := testutils.CreateBaseSDKContext(t)
+		strategy := currencypair.NewCompactDeltaCurrencyPairStrategy(ok)
+
+		_, err := strategy.GetEncodedPrice(ctx, cp, big.NewInt(-1))
+		require.Error(t, err)
+
+		ok.On(
+			"GetPriceForCurrencyPair",
+			mock.Anything,
+			cp,
+		).Return(oracletypes.QuotePrice{Price: math.NewInt(100)}, nil).Once()
+
+		_, err = strategy.GetDecodedPrice(ctx, cp, codec.EncodePackedInt(big.NewInt(-101)))
+		require.Error(t, err)
+	})
+
+	t.Run("prices that are not packed integers are rejected", func(t *testing.T) {
+		ctx := testutils.CreateBaseSDKContext(t)
+		strategy := currencypair.NewCompactCurrencyPairStrategy(mocks.NewOracleKeeper(t))
+
+		bz, err := big.NewInt(100).GobEncode()
+		require.NoError(t, err)
+
+		_, err = strategy.GetDecodedPrice(ctx, cp, bz)
+		require.Error(t, err)
+	})
+}
@@ -1,10 +1,25 @@
 package types
 
+import "time"
+
 const (
 	// MaximumPriceSize defines the maximum size of a price in bytes. This allows
 	// up to 32 bytes for the price and 1 byte for the sign (positive/negative).
 	MaximumPriceSize = 33
 
+	// MaximumProviderCount defines the maximum number of provider prices that a
+	// price in an extended vote extension can be calculated from.
+	MaximumProviderCount = 256
+
+	// MaximumDispersion defines the maximum dispersion of a price in an extended
+	// vote extension, in basis points. Larger dispersions are capped to this value.
+	MaximumDispersion = 10000
+
+	// MaximumTimestampSkew defines how far past the block time the timestamp of a
+	// price in an extended vote extension may be. This accounts for the time between
+	// the proposal and the vote, and for clock drift between validators.
+	MaximumTimestampSkew = 10 * time.Second
+
 	// NumInjectedTxs is the number of transactions that were injected into
 	// the proposal but are not actual transactions. In this case, the oracle
 	// info is injected into the proposal but should be ignored by the application.
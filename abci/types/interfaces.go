@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -20,6 +21,43 @@ type OracleKeeper interface { //golint:ignore
 	SetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair, qp oracletypes.QuotePrice) error
 }
 
+// OracleParamsKeeper defines the interface that must be fulfilled by the oracle keeper to
+// read the x/oracle module's parameters. This interface is utilized by the vote extension
+// handler to determine whether extended vote extensions are enabled.
+//
+//go:generate mockery --name OracleParamsKeeper --filename mock_oracle_params_keeper.go
+type OracleParamsKeeper interface {
+	GetParams(ctx context.Context) (oracletypes.Params, error)
+}
+
+// OraclePriceKeeper defines the interface that must be fulfilled by the oracle keeper to read
+// the latest on-chain prices. This interface is utilized by the vote extension handler to
+// prioritise the prices that moved the most when a vote extension exceeds its size budget.
+//
+//go:generate mockery --name OraclePriceKeeper --filename mock_oracle_price_keeper.go
+type OraclePriceKeeper interface {
+	GetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error)
+}
+
+// OraclePerformanceKeeper defines the interface that must be fulfilled by the oracle keeper to
+// record the oracle performance of validators. This interface is utilized by the PreBlock handler
+// to record a report of each validator's vote extension in every block.
+//
+//go:generate mockery --name OraclePerformanceKeeper --filename mock_oracle_performance_keeper.go
+type OraclePerformanceKeeper interface {
+	GetAllEnabledCurrencyPairs(ctx context.Context) ([]connecttypes.CurrencyPair, error)
+	RecordValidatorReport(ctx context.Context, validator sdk.ConsAddress, report oracletypes.ValidatorReport) error
+}
+
+// OracleSlashingHandler defines the interface that must be fulfilled by a handler that penalises
+// validators that persistently miss their vote extensions. This interface is utilized by the
+// PreBlock handler after it records the report of each validator.
+//
+//go:generate mockery --name OracleSlashingHandler --filename mock_oracle_slashing_handler.go
+type OracleSlashingHandler interface {
+	HandleValidatorPerformance(ctx context.Context, validator sdk.ConsAddress, power int64) error
+}
+
 // OracleClient defines the interface that must be fulfilled by the connect client.
 // This interface is utilized by the vote extension handler to fetch prices.
 type OracleClient interface {
@@ -0,0 +1,252 @@
+package ve
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
+	"github.com/skip-mev/connect/v2/abci/ve/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// SizeBudget limits the size of the vote extensions created by the VoteExtensionHandler. If a
+// vote extension exceeds the maximum size, prices are included in the following order of
+// priority until the budget is exhausted:
+//
+//  1. Critical currency pairs, as set by governance in the x/oracle module's params. This
+//     requires the handler to be created with the WithParamsKeeper option.
+//  2. Currency pairs whose price moved by at least the movement threshold since the latest
+//     on-chain price, largest movement first. Currency pairs without an on-chain price are
+//     considered to have moved.
+//  3. All other currency pairs, round-robin across vote extensions, so that every currency
+//     pair is eventually included.
+type SizeBudget struct {
+	// MaxSize is the maximum size of an encoded vote extension in bytes. If this is zero, the
+	// size of vote extensions is not limited.
+	MaxSize int
+
+	// MovementThreshold is the minimum movement of a price since the latest on-chain price, in
+	// basis points, for it to be prioritised. If this is zero, or PriceKeeper is nil, prices
+	// are not prioritised by their movement.
+	MovementThreshold uint32
+
+	// PriceKeeper is used to read the latest on-chain prices.
+	PriceKeeper connectabci.OraclePriceKeeper
+}
+
+// votePrice is a price that is included in a vote extension.
+type votePrice struct {
+	id    uint64
+	cp    connecttypes.CurrencyPair
+	price *big.Int
+}
+
+// encodeWithinBudget encodes the vote extension. If the encoded vote extension exceeds the size
+// budget, the prices are prioritised, and the vote extension with the most prices in order of
+// priority that fits within the budget is encoded instead.
+func (h *VoteExtensionHandler) encodeWithinBudget(
+	ctx sdk.Context,
+	ve types.OracleVoteExtension,
+	prices []votePrice,
+) ([]byte, error) {
+	bz, err := h.voteExtensionCodec.Encode(ve)
+	if err != nil || h.sizeBudget.MaxSize <= 0 || len(bz) <= h.sizeBudget.MaxSize {
+		return bz, err
+	}
+
+	prioritised, numRoundRobin := h.prioritisePrices(ctx, prices)
+
+	// Find the largest number of prioritised prices whose vote extension fits within the
+	// budget. The encoded size grows with the number of prices, so this is a binary search.
+	var (
+		fitting []byte
+		lo, hi  = 0, len(prioritised)
+	)
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+
+		candidate, err := h.voteExtensionCodec.Encode(subsetVoteExtension(ve, prioritised[:mid]))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(candidate) <= h.sizeBudget.MaxSize {
+			lo, fitting = mid, candidate
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	if lo == 0 {
+		if fitting, err = h.voteExtensionCodec.Encode(subsetVoteExtension(ve, nil)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Continue the round-robin selection after the last included price on the next vote
+	// extension.
+	if lo > len(prioritised)-numRoundRobin {
+		h.roundRobinCursor = prioritised[lo-1].id + 1
+	}
+
+	h.logger.Info(
+		"vote extension exceeds size budget; dropping lowest priority prices",
+		"height", ctx.BlockHeight(),
+		"max_size", h.sizeBudget.MaxSize,
+		"size", len(bz),
+		"prices", len(prioritised),
+		"included_prices", lo,
+	)
+
+	return fitting, nil
+}
+
+// prioritisePrices returns the prices in order of priority, see SizeBudget. The number of prices
+// at the end of the list that are ordered round-robin is returned as well.
+func (h *VoteExtensionHandler) prioritisePrices(ctx sdk.Context, prices []votePrice) ([]votePrice, int) {
+	critical := h.criticalCurrencyPairs(ctx)
+
+	type movedPrice struct {
+		votePrice
+		movement *big.Int
+	}
+
+	var (
+		criticalPrices = make([]votePrice, 0, len(critical))
+		movedPrices    = make([]movedPrice, 0)
+		otherPrices    = make([]votePrice, 0, len(prices))
+	)
+	for _, price := range prices {
+		if _, ok := critical[price.cp]; ok {
+			criticalPrices = append(criticalPrices, price)
+			continue
+		}
+
+		if movement, ok := h.priceMovement(ctx, price); ok {
+			movedPrices = append(movedPrices, movedPrice{votePrice: price, movement: movement})
+			continue
+		}
+
+		otherPrices = append(otherPrices, price)
+	}
+
+	sort.Slice(criticalPrices, func(i, j int) bool {
+		return criticalPrices[i].id < criticalPrices[j].id
+	})
+	sort.Slice(movedPrices, func(i, j int) bool {
+		if c := movedPrices[i].movement.Cmp(movedPrices[j].movement); c != 0 {
+			return c > 0
+		}
+		return movedPrices[i].id < movedPrices[j].id
+	})
+
+	// Order the other prices by ID, starting from the round-robin cursor.
+	sort.Slice(otherPrices, func(i, j int) bool {
+		return otherPrices[i].id < otherPrices[j].id
+	})
+	start := sort.Search(len(otherPrices), func(i int) bool {
+		return otherPrices[i].id >= h.roundRobinCursor
+	})
+	otherPrices = append(otherPrices[start:], otherPrices[:start]...)
+
+	prioritised := make([]votePrice, 0, len(prices))
+	prioritised = append(prioritised, criticalPrices...)
+	for _, price := range movedPrices {
+		prioritised = append(prioritised, price.votePrice)
+	}
+	prioritised = append(prioritised, otherPrices...)
+
+	return prioritised, len(otherPrices)
+}
+
+// criticalCurrencyPairs returns the critical currency pairs set in the x/oracle module's params.
+func (h *VoteExtensionHandler) criticalCurrencyPairs(ctx sdk.Context) map[connecttypes.CurrencyPair]struct{} {
+	critical := make(map[connecttypes.CurrencyPair]struct{})
+	if h.paramsKeeper == nil {
+		return critical
+	}
+
+	params, err := h.paramsKeeper.GetParams(ctx)
+	if err != nil {
+		h.logger.Error(
+			"failed to get oracle params; not prioritising critical currency pairs",
+			"height", ctx.BlockHeight(),
+			"err", err,
+		)
+
+		return critical
+	}
+
+	for _, cp := range params.CriticalCurrencyPairs {
+		critical[cp] = struct{}{}
+	}
+
+	return critical
+}
+
+// priceMovement returns the movement of the price since the latest on-chain price in basis
+// points, and whether it is at least the movement threshold.
+func (h *VoteExtensionHandler) priceMovement(ctx sdk.Context, price votePrice) (*big.Int, bool) {
+	if h.sizeBudget.PriceKeeper == nil || h.sizeBudget.MovementThreshold == 0 {
+		return nil, false
+	}
+
+	quote, err := h.sizeBudget.PriceKeeper.GetPriceForCurrencyPair(ctx, price.cp)
+	if err != nil {
+		// Currency pairs without an on-chain price are prioritised, so that they receive a
+		// price as soon as possible.
+		if errors.As(err, &oracletypes.QuotePriceNotExistError{}) {
+			return maxMovement(), true
+		}
+
+		h.logger.Debug(
+			"failed to get on-chain price; not prioritising price by its movement",
+			"currency_pair", price.cp.String(),
+			"err", err,
+		)
+
+		return nil, false
+	}
+
+	onChainPrice := quote.Price.BigInt()
+	if onChainPrice.Sign() == 0 {
+		return maxMovement(), true
+	}
+
+	movement := new(big.Int).Sub(price.price, onChainPrice)
+	movement.Abs(movement)
+	movement.Mul(movement, big.NewInt(10000))
+	movement.Quo(movement, new(big.Int).Abs(onChainPrice))
+
+	return movement, movement.Cmp(new(big.Int).SetUint64(uint64(h.sizeBudget.MovementThreshold))) >= 0
+}
+
+// maxMovement returns the movement of prices that have no on-chain price to move from.
+func maxMovement() *big.Int {
+	return new(big.Int).SetUint64(^uint64(0))
+}
+
+// subsetVoteExtension returns a vote extension with the prices and metadata of the given prices,
+// and the number of prices that were dropped from the given vote extension.
+func subsetVoteExtension(ve types.OracleVoteExtension, prices []votePrice) types.OracleVoteExtension {
+	subset := types.OracleVoteExtension{
+		Prices:        make(map[uint64][]byte, len(prices)),
+		DroppedPrices: uint32(len(ve.Prices) - len(prices)), //nolint:gosec
+	}
+	if ve.Metadata != nil {
+		subset.Metadata = make(map[uint64]types.PriceMetadata)
+	}
+
+	for _, price := range prices {
+		subset.Prices[price.id] = ve.Prices[price.id]
+		if md, ok := ve.Metadata[price.id]; ok {
+			subset.Metadata[price.id] = md
+		}
+	}
+
+	return subset
+}
@@ -0,0 +1,39 @@
+package ve
+
+import (
+	"time"
+
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
+)
+
+// Option is a function that enables optional configuration of the VoteExtensionHandler.
+type Option func(*VoteExtensionHandler)
+
+// WithMaxPriceAge returns an Option that configures the VoteExtensionHandler to return an empty
+// vote extension if the oracle last updated its prices longer than maxAge ago. The age of the
+// prices is not checked if maxAge is zero, which is the default.
+func WithMaxPriceAge(maxAge time.Duration) Option {
+	return func(h *VoteExtensionHandler) {
+		h.maxPriceAge = maxAge
+	}
+}
+
+// WithParamsKeeper returns an Option that configures the VoteExtensionHandler to read the x/oracle
+// module's params from the given keeper. If extended vote extensions are enabled in the params, the
+// handler includes the metadata of each price in its vote extensions. Without a params keeper,
+// extended vote extensions are never created.
+func WithParamsKeeper(keeper connectabci.OracleParamsKeeper) Option {
+	return func(h *VoteExtensionHandler) {
+		h.paramsKeeper = keeper
+	}
+}
+
+// WithSizeBudget returns an Option that configures the VoteExtensionHandler to limit the size of
+// its vote extensions to the given budget. If a vote extension exceeds the budget's maximum size,
+// prices are included in order of priority until the budget is exhausted. See SizeBudget for the
+// order of priority. The size of vote extensions is not limited by default.
+func WithSizeBudget(budget SizeBudget) Option {
+	return func(h *VoteExtensionHandler) {
+		h.sizeBudget = budget
+	}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -28,6 +29,13 @@ type OracleVoteExtension struct {
 	// 0x123.. (bytes). Notice the `id` function is determined by the
 	// `CurrencyPairIDStrategy` used in the VoteExtensionHandler.
 	Prices map[uint64][]byte `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Metadata defines a map of id(CurrencyPair) -> metadata of the price with
+	// the same id. It is only populated if extended vote extensions are enabled
+	// in the x/oracle module's params.
+	Metadata map[uint64]PriceMetadata `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// DroppedPrices defines the number of prices that were dropped from the vote
+	// extension to fit the validator's vote extension size budget.
+	DroppedPrices uint32 `protobuf:"varint,3,opt,name=dropped_prices,json=droppedPrices,proto3" json:"dropped_prices,omitempty"`
 }
 
 func (m *OracleVoteExtension) Reset()         { *m = OracleVoteExtension{} }
@@ -70,9 +78,93 @@ func (m *OracleVoteExtension) GetPrices() map[uint64][]byte {
 	return nil
 }
 
+func (m *OracleVoteExtension) GetMetadata() map[uint64]PriceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *OracleVoteExtension) GetDroppedPrices() uint32 {
+	if m != nil {
+		return m.DroppedPrices
+	}
+	return 0
+}
+
+// PriceMetadata defines how fresh a price in an extended vote extension is, and
+// how many sources went into it.
+type PriceMetadata struct {
+	// Timestamp defines the time at which the validator's oracle observed the
+	// price, in unix milliseconds.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// ProviderCount defines the number of provider prices that were used to
+	// calculate the price.
+	ProviderCount uint32 `protobuf:"varint,2,opt,name=provider_count,json=providerCount,proto3" json:"provider_count,omitempty"`
+	// Dispersion defines the standard deviation of the provider prices, relative
+	// to the price, in basis points.
+	Dispersion uint32 `protobuf:"varint,3,opt,name=dispersion,proto3" json:"dispersion,omitempty"`
+}
+
+func (m *PriceMetadata) Reset()         { *m = PriceMetadata{} }
+func (m *PriceMetadata) String() string { return proto.CompactTextString(m) }
+func (*PriceMetadata) ProtoMessage()    {}
+func (*PriceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_185ec0708d9f4b6a, []int{1}
+}
+func (m *PriceMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceMetadata.Merge(m, src)
+}
+func (m *PriceMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceMetadata proto.InternalMessageInfo
+
+func (m *PriceMetadata) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PriceMetadata) GetProviderCount() uint32 {
+	if m != nil {
+		return m.ProviderCount
+	}
+	return 0
+}
+
+func (m *PriceMetadata) GetDispersion() uint32 {
+	if m != nil {
+		return m.Dispersion
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OracleVoteExtension)(nil), "connect.abci.v2.OracleVoteExtension")
+	proto.RegisterMapType((map[uint64]PriceMetadata)(nil), "connect.abci.v2.OracleVoteExtension.MetadataEntry")
 	proto.RegisterMapType((map[uint64][]byte)(nil), "connect.abci.v2.OracleVoteExtension.PricesEntry")
+	proto.RegisterType((*PriceMetadata)(nil), "connect.abci.v2.PriceMetadata")
 }
 
 func init() {
@@ -80,22 +172,32 @@ func init() {
 }
 
 var fileDescriptor_185ec0708d9f4b6a = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0xcf, 0xcb,
-	0x4b, 0x4d, 0x2e, 0xd1, 0x4f, 0x4c, 0x4a, 0xce, 0xd4, 0x2f, 0x33, 0xd2, 0x2f, 0xcb, 0x2f, 0x49,
-	0x8d, 0x4f, 0xad, 0x28, 0x49, 0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x87, 0x2a, 0xd3, 0x03, 0x29, 0xd3, 0x2b, 0x33, 0x52, 0x9a, 0xc5, 0xc8, 0x25,
-	0xec, 0x5f, 0x94, 0x98, 0x9c, 0x93, 0x1a, 0x96, 0x5f, 0x92, 0xea, 0x0a, 0x53, 0x2f, 0xe4, 0xc1,
-	0xc5, 0x56, 0x50, 0x94, 0x99, 0x9c, 0x5a, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x64, 0xa0,
-	0x87, 0xa6, 0x53, 0x0f, 0x8b, 0x2e, 0xbd, 0x00, 0xb0, 0x16, 0xd7, 0xbc, 0x92, 0xa2, 0xca, 0x20,
-	0xa8, 0x7e, 0x29, 0x4b, 0x2e, 0x6e, 0x24, 0x61, 0x21, 0x01, 0x2e, 0xe6, 0xec, 0xd4, 0x4a, 0x09,
-	0x46, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x10, 0x53, 0x48, 0x84, 0x8b, 0xb5, 0x2c, 0x31, 0xa7, 0x34,
-	0x55, 0x82, 0x49, 0x81, 0x51, 0x83, 0x27, 0x08, 0xc2, 0xb1, 0x62, 0xb2, 0x60, 0x74, 0x72, 0x3b,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x9d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe2, 0xec, 0xcc, 0x02, 0xdd, 0xdc, 0xd4, 0x32, 0x7d, 0x58,
-	0x10, 0x94, 0x19, 0x41, 0x43, 0x21, 0x55, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec,
-	0x79, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x31, 0x8c, 0x89, 0x25, 0x01, 0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x86, 0xe3, 0xa4, 0x54, 0xe0, 0x12, 0x40, 0xa6, 0x87, 0xa8, 0x42, 0x26, 0xaa, 0x54, 0x29,
+	0x07, 0x48, 0x50, 0xe0, 0x00, 0x1c, 0x8b, 0x8a, 0xb8, 0x20, 0x50, 0x84, 0x38, 0xc0, 0xa1, 0x4a,
+	0x93, 0x51, 0xb1, 0xda, 0xc4, 0x96, 0xe3, 0x46, 0xf4, 0x2d, 0xf6, 0xb1, 0x7a, 0xec, 0x71, 0x4f,
+	0xab, 0x55, 0xfb, 0x0a, 0xfb, 0x00, 0xab, 0xb8, 0xc9, 0x6e, 0xba, 0xdb, 0xc3, 0xde, 0xec, 0xdf,
+	0xf3, 0x7f, 0xf3, 0x7b, 0x34, 0x78, 0x94, 0xf0, 0x3c, 0x87, 0x44, 0x05, 0xf1, 0x2c, 0x61, 0x41,
+	0x19, 0x06, 0x25, 0x57, 0x30, 0x85, 0xff, 0x0a, 0xf2, 0x82, 0xf1, 0xbc, 0xf0, 0x85, 0xe4, 0x8a,
+	0x93, 0xe7, 0x75, 0x99, 0x5f, 0x95, 0xf9, 0x65, 0x38, 0xe8, 0xcf, 0xf9, 0x9c, 0xeb, 0xb7, 0xa0,
+	0x3a, 0x1d, 0xca, 0x86, 0x57, 0x26, 0x7e, 0xf9, 0x43, 0xc6, 0xc9, 0x12, 0x7e, 0x73, 0x05, 0x93,
+	0x86, 0x42, 0xbe, 0xe1, 0xae, 0x90, 0x2c, 0x81, 0xc2, 0x41, 0xae, 0xe5, 0xf5, 0xc2, 0x77, 0xfe,
+	0x1d, 0x9e, 0x7f, 0xc2, 0xe5, 0xff, 0xd4, 0x96, 0x49, 0xae, 0xe4, 0x3a, 0xaa, 0xfd, 0xe4, 0x17,
+	0x7e, 0x9c, 0x81, 0x8a, 0xd3, 0x58, 0xc5, 0x8e, 0xa9, 0x59, 0xe1, 0x83, 0x58, 0xdf, 0x6b, 0x93,
+	0xa6, 0x8d, 0x3b, 0x9b, 0x8b, 0xd7, 0x46, 0x74, 0x43, 0x22, 0x23, 0xfc, 0x2c, 0x95, 0x5c, 0x08,
+	0x48, 0xa7, 0x75, 0x4e, 0xcb, 0x45, 0x9e, 0x1d, 0xd9, 0xb5, 0x7a, 0x48, 0x32, 0xf8, 0x84, 0x7b,
+	0xad, 0x4c, 0xe4, 0x05, 0xb6, 0x16, 0xb0, 0x76, 0x90, 0x8b, 0xbc, 0x4e, 0x54, 0x1d, 0x49, 0x1f,
+	0x3f, 0x2a, 0xe3, 0xe5, 0x0a, 0x1c, 0xd3, 0x45, 0xde, 0xd3, 0xe8, 0x70, 0xf9, 0x6c, 0x7e, 0x44,
+	0x83, 0xbf, 0xd8, 0x3e, 0x8a, 0x70, 0xc2, 0xfc, 0xa1, 0x6d, 0xee, 0x85, 0xf4, 0xde, 0xbf, 0x74,
+	0xef, 0x86, 0xd2, 0x82, 0x0f, 0x15, 0xb6, 0x8f, 0xde, 0xc8, 0x2b, 0xfc, 0x44, 0xb1, 0x0c, 0x0a,
+	0x15, 0x67, 0x42, 0xb7, 0xb0, 0xa2, 0x5b, 0xa1, 0xfa, 0xad, 0x90, 0xbc, 0x64, 0x29, 0xc8, 0x69,
+	0xc2, 0x57, 0xb9, 0xd2, 0x1d, 0xed, 0xc8, 0x6e, 0xd4, 0x2f, 0x95, 0x48, 0x28, 0xc6, 0x29, 0x2b,
+	0x04, 0xc8, 0x6a, 0x80, 0xf5, 0x40, 0x5a, 0xca, 0xf8, 0xeb, 0x66, 0x47, 0xd1, 0x76, 0x47, 0xd1,
+	0xe5, 0x8e, 0xa2, 0xb3, 0x3d, 0x35, 0xb6, 0x7b, 0x6a, 0x9c, 0xef, 0xa9, 0xf1, 0xe7, 0xcd, 0x9c,
+	0xa9, 0x7f, 0xab, 0x99, 0x9f, 0xf0, 0x2c, 0x28, 0x16, 0x4c, 0xbc, 0xcd, 0xa0, 0x0c, 0x9a, 0x45,
+	0x2b, 0xc3, 0x7a, 0xd7, 0x20, 0x50, 0x6b, 0x01, 0xc5, 0xac, 0xab, 0x77, 0xe7, 0xfd, 0xf5, 0x00,
+	0xd1, 0xf9, 0x76, 0x4b, 0x8b, 0x02, 0x00, 0x00,
 }
 
 func (m *OracleVoteExtension) Marshal() (dAtA []byte, err error) {
@@ -118,6 +220,33 @@ func (m *OracleVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DroppedPrices != 0 {
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(m.DroppedPrices))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtensions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintVoteExtensions(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintVoteExtensions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Prices) > 0 {
 		for k := range m.Prices {
 			v := m.Prices[k]
@@ -140,6 +269,44 @@ func (m *OracleVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dispersion != 0 {
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(m.Dispersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProviderCount != 0 {
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(m.ProviderCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVoteExtensions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVoteExtensions(v)
 	base := offset
@@ -169,6 +336,36 @@ func (m *OracleVoteExtension) Size() (n int) {
 			n += mapEntrySize + 1 + sovVoteExtensions(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovVoteExtensions(uint64(k)) + 1 + l + sovVoteExtensions(uint64(l))
+			n += mapEntrySize + 1 + sovVoteExtensions(uint64(mapEntrySize))
+		}
+	}
+	if m.DroppedPrices != 0 {
+		n += 1 + sovVoteExtensions(uint64(m.DroppedPrices))
+	}
+	return n
+}
+
+func (m *PriceMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovVoteExtensions(uint64(m.Timestamp))
+	}
+	if m.ProviderCount != 0 {
+		n += 1 + sovVoteExtensions(uint64(m.ProviderCount))
+	}
+	if m.Dispersion != 0 {
+		n += 1 + sovVoteExtensions(uint64(m.Dispersion))
+	}
 	return n
 }
 
@@ -321,6 +518,247 @@ func (m *OracleVoteExtension) Unmarshal(dAtA []byte) error {
 			}
 			m.Prices[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[uint64]PriceMetadata)
+			}
+			var mapkey uint64
+			mapvalue := &PriceMetadata{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVoteExtensions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVoteExtensions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVoteExtensions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthVoteExtensions
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthVoteExtensions
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PriceMetadata{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthVoteExtensions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedPrices", wireType)
+			}
+			m.DroppedPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedPrices |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderCount", wireType)
+			}
+			m.ProviderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+			}
+			m.Dispersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dispersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
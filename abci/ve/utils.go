@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"time"
 
 	"cosmossdk.io/core/comet"
 	cometabci "github.com/cometbft/cometbft/abci/types"
@@ -35,6 +36,15 @@ func ValidateOracleVoteExtension(
 		return fmt.Errorf("number of oracle vote extension pairs of %d greater than maximum expected pairs of %d", uint64(len(ve.Prices)), maxNumCP)
 	}
 
+	if uint64(len(ve.Prices))+uint64(ve.DroppedPrices) > maxNumCP {
+		return fmt.Errorf(
+			"number of oracle vote extension pairs of %d and dropped pairs of %d greater than maximum expected pairs of %d",
+			uint64(len(ve.Prices)),
+			ve.DroppedPrices,
+			maxNumCP,
+		)
+	}
+
 	// Verify prices are valid.
 	for _, bz := range ve.Prices {
 		// Ensure that the price bytes are not too long.
@@ -43,6 +53,53 @@ func ValidateOracleVoteExtension(
 		}
 	}
 
+	// Verify the metadata of extended vote extensions is valid.
+	for id, md := range ve.Metadata {
+		if _, ok := ve.Prices[id]; !ok {
+			return fmt.Errorf("metadata for currency pair %d has no price", id)
+		}
+
+		if err := ValidatePriceMetadata(md, ctx.BlockTime()); err != nil {
+			return fmt.Errorf("invalid metadata for currency pair %d: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidatePriceMetadata validates the metadata of a price in an extended vote extension. The
+// timestamp of the price may be at most MaximumTimestampSkew past the given block time. A zero
+// block time, which is the case when verifying vote extensions, skips this check.
+func ValidatePriceMetadata(md vetypes.PriceMetadata, blockTime time.Time) error {
+	if md.Timestamp <= 0 {
+		return fmt.Errorf("timestamp must be positive: %d", md.Timestamp)
+	}
+
+	if !blockTime.IsZero() && time.UnixMilli(md.Timestamp).After(blockTime.Add(connectabci.MaximumTimestampSkew)) {
+		return fmt.Errorf(
+			"timestamp %s is more than %s past the block time %s",
+			time.UnixMilli(md.Timestamp).UTC(),
+			connectabci.MaximumTimestampSkew,
+			blockTime.UTC(),
+		)
+	}
+
+	if md.ProviderCount == 0 || md.ProviderCount > connectabci.MaximumProviderCount {
+		return fmt.Errorf(
+			"provider count must be between 1 and %d: %d",
+			connectabci.MaximumProviderCount,
+			md.ProviderCount,
+		)
+	}
+
+	if md.Dispersion > connectabci.MaximumDispersion {
+		return fmt.Errorf(
+			"dispersion must be at most %d basis points: %d",
+			connectabci.MaximumDispersion,
+			md.Dispersion,
+		)
+	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
@@ -21,7 +22,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
 	"github.com/skip-mev/connect/v2/abci/ve"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 )
 
 const (
@@ -529,6 +532,58 @@ func (s *ABCIUtilsTestSuite) TestValidateVoteExtensionsPrunedValidator() {
 	s.Require().NoError(err)
 }
 
+func (s *ABCIUtilsTestSuite) TestValidatePriceMetadata() {
+	blockTime := time.Now()
+
+	cases := []struct {
+		name        string
+		metadata    vetypes.PriceMetadata
+		blockTime   time.Time
+		expectedErr bool
+	}{
+		{
+			name:     "valid metadata",
+			metadata: vetypes.PriceMetadata{Timestamp: blockTime.UnixMilli(), ProviderCount: 3, Dispersion: 100},
+		},
+		{
+			name:      "timestamp within the skew past the block time",
+			metadata:  vetypes.PriceMetadata{Timestamp: blockTime.Add(connectabci.MaximumTimestampSkew).UnixMilli(), ProviderCount: 1},
+			blockTime: blockTime,
+		},
+		{
+			name:        "timestamp more than the skew past the block time",
+			metadata:    vetypes.PriceMetadata{Timestamp: blockTime.Add(connectabci.MaximumTimestampSkew + time.Second).UnixMilli(), ProviderCount: 1},
+			blockTime:   blockTime,
+			expectedErr: true,
+		},
+		{
+			name:     "future timestamp without a block time",
+			metadata: vetypes.PriceMetadata{Timestamp: blockTime.Add(time.Hour).UnixMilli(), ProviderCount: 1},
+		},
+		{
+			name:        "missing timestamp",
+			metadata:    vetypes.PriceMetadata{ProviderCount: 1},
+			expectedErr: true,
+		},
+		{
+			name:        "too many providers",
+			metadata:    vetypes.PriceMetadata{Timestamp: blockTime.UnixMilli(), ProviderCount: connectabci.MaximumProviderCount + 1},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			err := ve.ValidatePriceMetadata(tc.metadata, tc.blockTime)
+			if tc.expectedErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
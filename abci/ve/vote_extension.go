@@ -17,6 +17,7 @@ import (
 	connectabci "github.com/skip-mev/connect/v2/abci/types"
 	"github.com/skip-mev/connect/v2/abci/ve/types"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	client "github.com/skip-mev/connect/v2/service/clients/oracle"
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
 	servicetypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
 )
@@ -47,6 +48,22 @@ type VoteExtensionHandler struct {
 
 	// metrics is the service metrics interface that the vote-extension handler will use to report metrics.
 	metrics servicemetrics.Metrics
+
+	// maxPriceAge is the maximum time since the oracle last updated its prices for them to be
+	// included in a vote extension. If this is zero, the age of the prices is not checked.
+	maxPriceAge time.Duration
+
+	// paramsKeeper is used to determine whether extended vote extensions are enabled. If this is
+	// nil, extended vote extensions are never created.
+	paramsKeeper connectabci.OracleParamsKeeper
+
+	// sizeBudget limits the size of vote extensions. If its maximum size is zero, the size of
+	// vote extensions is not limited.
+	sizeBudget SizeBudget
+
+	// roundRobinCursor is the currency pair ID from which the next round-robin selection of
+	// prices starts, when a vote extension exceeds the size budget.
+	roundRobinCursor uint64
 }
 
 // NewVoteExtensionHandler returns a new VoteExtensionHandler.
@@ -58,8 +75,9 @@ func NewVoteExtensionHandler(
 	codec compression.VoteExtensionCodec,
 	priceApplier aggregator.PriceApplier,
 	metrics servicemetrics.Metrics,
+	opts ...Option,
 ) *VoteExtensionHandler {
-	return &VoteExtensionHandler{
+	h := &VoteExtensionHandler{
 		logger:               logger,
 		oracleClient:         oracleClient,
 		timeout:              timeout,
@@ -68,6 +86,12 @@ func NewVoteExtensionHandler(
 		metrics:              metrics,
 		priceApplier:         priceApplier,
 	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
 }
 
 // ExtendVoteHandler returns a handler that extends a vote with the oracle's
@@ -147,8 +171,11 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 				"err", err,
 			)
 
-			err = OracleClientError{
-				Err: err,
+			// stale prices are reported with their own label, rather than as a client error.
+			if !errors.As(err, &client.StalePricesError{}) {
+				err = OracleClientError{
+					Err: err,
+				}
 			}
 
 			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
@@ -166,8 +193,22 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
 		}
 
+		// If the oracle has not updated its prices recently, e.g. because its aggregation has
+		// stalled, we return an empty vote extension rather than voting on stale prices.
+		if err = client.CheckPricesAge(oracleResp, h.maxPriceAge, time.Now()); err != nil {
+			h.logger.Error(
+				"oracle returned stale prices for vote extension; returning empty vote extension",
+				"height", req.Height,
+				"oracle_timestamp", oracleResp.Timestamp.String(),
+				"max_price_age", h.maxPriceAge.String(),
+				"err", err,
+			)
+
+			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
+		}
+
 		// Transform the response prices into a vote extension.
-		voteExt, err := h.transformOracleServicePrices(ctx, oracleResp.Prices)
+		voteExt, prices, err := h.transformOracleServicePrices(ctx, oracleResp)
 		if err != nil {
 			h.logger.Error(
 				"failed to transform oracle prices for vote extension; returning empty vote extension",
@@ -182,7 +223,9 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
 		}
 
-		bz, err := h.voteExtensionCodec.Encode(voteExt)
+		// Encode the vote extension, dropping the lowest priority prices if it exceeds the size
+		// budget.
+		bz, err := h.encodeWithinBudget(ctx, voteExt, prices)
 		if err != nil {
 			h.logger.Error(
 				"failed to marshal vote extension; returning empty vote extension",
@@ -286,20 +329,31 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 
 // transformOracleServicePrices transforms the oracle service prices into a vote extension. It
 // does this by iterating over the prices submitted by the oracle service and determining the
-// correct decoded price / ID based on the currency pair strategy.
-func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, prices map[string]string) (types.OracleVoteExtension, error) {
+// correct decoded price / ID based on the currency pair strategy. If extended vote extensions
+// are enabled, the metadata of each price is included as well. The raw prices that were included
+// are returned alongside the vote extension.
+func (h *VoteExtensionHandler) transformOracleServicePrices(
+	ctx sdk.Context,
+	resp *servicetypes.QueryPricesResponse,
+) (types.OracleVoteExtension, []votePrice, error) {
 	strategyPrices := make(map[uint64][]byte)
+	prices := make([]votePrice, 0, len(resp.Prices))
+
+	var metadata map[uint64]types.PriceMetadata
+	if h.extendedVoteExtensionsEnabled(ctx) {
+		metadata = make(map[uint64]types.PriceMetadata)
+	}
 
 	// Iterate over the prices and transform them into the correct format.
-	for currencyPairID, priceString := range prices {
+	for currencyPairID, priceString := range resp.Prices {
 		cp, err := connecttypes.CurrencyPairFromString(currencyPairID)
 		if err != nil {
-			return types.OracleVoteExtension{}, err
+			return types.OracleVoteExtension{}, nil, err
 		}
 
 		rawPrice, converted := new(big.Int).SetString(priceString, 10)
 		if !converted {
-			return types.OracleVoteExtension{}, fmt.Errorf("failed to convert price string to big.Int: %s", priceString)
+			return types.OracleVoteExtension{}, nil, fmt.Errorf("failed to convert price string to big.Int: %s", priceString)
 		}
 
 		// Determine if the currency pair is supported by the network.
@@ -333,11 +387,88 @@ func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, pri
 		)
 
 		strategyPrices[cpID] = encodedPrice
+		prices = append(prices, votePrice{id: cpID, cp: cp, price: rawPrice})
+
+		if metadata != nil {
+			if md, ok := priceMetadata(resp, currencyPairID, rawPrice); ok {
+				metadata[cpID] = md
+			}
+		}
 	}
 
-	h.logger.Debug("transformed oracle prices", "prices", len(strategyPrices))
+	h.logger.Debug(
+		"transformed oracle prices",
+		"prices", len(strategyPrices),
+		"metadata", len(metadata),
+	)
 
 	return types.OracleVoteExtension{
-		Prices: strategyPrices,
-	}, nil
+		Prices:   strategyPrices,
+		Metadata: metadata,
+	}, prices, nil
+}
+
+// extendedVoteExtensionsEnabled returns true if extended vote extensions are enabled in the
+// x/oracle module's params.
+func (h *VoteExtensionHandler) extendedVoteExtensionsEnabled(ctx sdk.Context) bool {
+	if h.paramsKeeper == nil {
+		return false
+	}
+
+	params, err := h.paramsKeeper.GetParams(ctx)
+	if err != nil {
+		h.logger.Error(
+			"failed to get oracle params; not including price metadata in vote extension",
+			"height", ctx.BlockHeight(),
+			"err", err,
+		)
+
+		return false
+	}
+
+	return params.ExtendedVoteExtensionsEnabled
+}
+
+// priceMetadata returns the metadata of a price returned by the oracle service. No metadata is
+// returned if the oracle service did not report the dispersion of the price.
+func priceMetadata(
+	resp *servicetypes.QueryPricesResponse,
+	ticker string,
+	price *big.Int,
+) (types.PriceMetadata, bool) {
+	dispersion, ok := resp.Dispersions[ticker]
+	if !ok || dispersion.ProviderCount == 0 {
+		return types.PriceMetadata{}, false
+	}
+
+	return types.PriceMetadata{
+		Timestamp:     resp.Timestamp.UnixMilli(),
+		ProviderCount: uint32(min(dispersion.ProviderCount, connectabci.MaximumProviderCount)), //nolint:gosec
+		Dispersion:    relativeDispersion(dispersion.StdDev, price),
+	}, true
+}
+
+// relativeDispersion returns the standard deviation of the provider prices relative to the
+// price, in basis points, capped at the maximum dispersion.
+func relativeDispersion(stdDev string, price *big.Int) uint32 {
+	dev, ok := new(big.Int).SetString(stdDev, 10)
+	if !ok || dev.Sign() < 0 {
+		return connectabci.MaximumDispersion
+	}
+
+	if dev.Sign() == 0 {
+		return 0
+	}
+
+	if price.Sign() == 0 {
+		return connectabci.MaximumDispersion
+	}
+
+	bps := new(big.Int).Mul(dev, big.NewInt(10000))
+	bps.Quo(bps, new(big.Int).Abs(price))
+	if !bps.IsUint64() || bps.Uint64() > connectabci.MaximumDispersion {
+		return connectabci.MaximumDispersion
+	}
+
+	return uint32(bps.Uint64())
 }
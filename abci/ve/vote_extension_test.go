@@ -1,12 +1,15 @@
 package ve_test
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"testing"
 	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/mock"
@@ -18,6 +21,7 @@ import (
 	mockstrategies "github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
 	"github.com/skip-mev/connect/v2/abci/testutils"
 	connectabci "github.com/skip-mev/connect/v2/abci/types"
+	connectabcimocks "github.com/skip-mev/connect/v2/abci/types/mocks"
 	"github.com/skip-mev/connect/v2/abci/ve"
 	abcitypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -26,6 +30,7 @@ import (
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
 	metricsmocks "github.com/skip-mev/connect/v2/service/metrics/mocks"
 	servicetypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 var (
@@ -322,12 +327,235 @@ func (s *VoteExtensionTestSuite) TestExtendVoteExtension() {
 	}
 }
 
+func (s *VoteExtensionTestSuite) TestExtendVoteExtensionMetadata() {
+	now := time.Now()
+
+	cases := []struct {
+		name             string
+		params           oracletypes.Params
+		dispersions      map[string]servicetypes.PriceDispersion
+		expectedMetadata map[uint64]abcitypes.PriceMetadata
+	}{
+		{
+			name:   "metadata is excluded if extended vote extensions are disabled",
+			params: oracletypes.NewParams(false, 30*time.Second),
+			dispersions: map[string]servicetypes.PriceDispersion{
+				btcUSD.String(): {StdDev: "2", ProviderCount: 3},
+			},
+		},
+		{
+			name:   "metadata is included if extended vote extensions are enabled",
+			params: oracletypes.NewParams(true, 30*time.Second),
+			dispersions: map[string]servicetypes.PriceDispersion{
+				btcUSD.String(): {StdDev: "2", ProviderCount: 3},
+				ethUSD.String(): {StdDev: "0", ProviderCount: 1},
+			},
+			expectedMetadata: map[uint64]abcitypes.PriceMetadata{
+				0: {Timestamp: now.UnixMilli(), ProviderCount: 3, Dispersion: 200},
+				1: {Timestamp: now.UnixMilli(), ProviderCount: 1, Dispersion: 0},
+			},
+		},
+		{
+			name:   "metadata is capped, and excluded for prices without a dispersion",
+			params: oracletypes.NewParams(true, 30*time.Second),
+			dispersions: map[string]servicetypes.PriceDispersion{
+				btcUSD.String(): {StdDev: "300", ProviderCount: 300},
+			},
+			expectedMetadata: map[uint64]abcitypes.PriceMetadata{
+				0: {
+					Timestamp:     now.UnixMilli(),
+					ProviderCount: connectabci.MaximumProviderCount,
+					Dispersion:    connectabci.MaximumDispersion,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			cdc := codec.NewDefaultVoteExtensionCodec()
+
+			oracleClient := mocks.NewOracleClient(s.T())
+			oracleClient.On("Prices", mock.Anything, mock.Anything).Return(
+				&servicetypes.QueryPricesResponse{
+					Prices:      multiplePrices,
+					Timestamp:   now,
+					Dispersions: tc.dispersions,
+				},
+				nil,
+			)
+
+			cps := mockstrategies.NewCurrencyPairStrategy(s.T())
+			cps.On("ID", mock.Anything, btcUSD).Return(uint64(0), nil)
+			cps.On("GetEncodedPrice", mock.Anything, btcUSD, oneHundred).Return(oneHundred.Bytes(), nil)
+			cps.On("ID", mock.Anything, ethUSD).Return(uint64(1), nil)
+			cps.On("GetEncodedPrice", mock.Anything, ethUSD, twoHundred).Return(twoHundred.Bytes(), nil)
+
+			paramsKeeper := connectabcimocks.NewOracleParamsKeeper(s.T())
+			paramsKeeper.On("GetParams", mock.Anything).Return(tc.params, nil)
+
+			mockPriceApplier := aggregatormocks.NewPriceApplier(s.T())
+			mockPriceApplier.On("ApplyPricesFromVoteExtensions", s.ctx, mock.Anything).Return(nil, nil)
+
+			h := ve.NewVoteExtensionHandler(
+				log.NewTestLogger(s.T()),
+				oracleClient,
+				time.Second*1,
+				cps,
+				cdc,
+				mockPriceApplier,
+				servicemetrics.NewNopMetrics(),
+				ve.WithParamsKeeper(paramsKeeper),
+			)
+
+			resp, err := h.ExtendVoteHandler()(s.ctx, &cometabci.RequestExtendVote{})
+			s.Require().NoError(err)
+
+			ext, err := cdc.Decode(resp.VoteExtension)
+			s.Require().NoError(err)
+			s.Require().Len(ext.Prices, 2)
+			if len(tc.expectedMetadata) == 0 {
+				s.Require().Empty(ext.Metadata)
+			} else {
+				s.Require().Equal(tc.expectedMetadata, ext.Metadata)
+			}
+		})
+	}
+}
+
+func (s *VoteExtensionTestSuite) TestExtendVoteSizeBudget() {
+	var (
+		solUSD  = connecttypes.NewCurrencyPair("SOL", "USD")
+		atomUSD = connecttypes.NewCurrencyPair("ATOM", "USD")
+		ids     = map[connecttypes.CurrencyPair]uint64{btcUSD: 0, ethUSD: 1, solUSD: 2, atomUSD: 3}
+	)
+
+	// newHandler returns a handler for prices of 100 for every currency pair except ATOM/USD,
+	// which moved from 100 to 200. SOL/USD is a critical currency pair.
+	newHandler := func(budget ve.SizeBudget) *ve.VoteExtensionHandler {
+		oracleClient := mocks.NewOracleClient(s.T())
+		oracleClient.On("Prices", mock.Anything, mock.Anything).Return(
+			&servicetypes.QueryPricesResponse{
+				Prices: map[string]string{
+					btcUSD.String():  oneHundred.String(),
+					ethUSD.String():  oneHundred.String(),
+					solUSD.String():  oneHundred.String(),
+					atomUSD.String(): twoHundred.String(),
+				},
+				Timestamp: time.Now(),
+			},
+			nil,
+		)
+
+		cps := mockstrategies.NewCurrencyPairStrategy(s.T())
+		priceKeeper := connectabcimocks.NewOraclePriceKeeper(s.T())
+		for cp, id := range ids {
+			price := oneHundred
+			if cp == atomUSD {
+				price = twoHundred
+			}
+
+			bz, err := price.GobEncode()
+			s.Require().NoError(err)
+
+			cps.On("ID", mock.Anything, cp).Return(id, nil)
+			cps.On("GetEncodedPrice", mock.Anything, cp, price).Return(bz, nil)
+			priceKeeper.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(
+				oracletypes.QuotePrice{Price: math.NewIntFromBigInt(oneHundred)},
+				nil,
+			).Maybe()
+		}
+
+		paramsKeeper := connectabcimocks.NewOracleParamsKeeper(s.T())
+		paramsKeeper.On("GetParams", mock.Anything).Return(oracletypes.Params{
+			CriticalCurrencyPairs: []connecttypes.CurrencyPair{solUSD},
+		}, nil)
+
+		mockPriceApplier := aggregatormocks.NewPriceApplier(s.T())
+		mockPriceApplier.On("ApplyPricesFromVoteExtensions", s.ctx, mock.Anything).Return(nil, nil)
+
+		budget.PriceKeeper = priceKeeper
+		return ve.NewVoteExtensionHandler(
+			log.NewTestLogger(s.T()),
+			oracleClient,
+			time.Second*1,
+			cps,
+			codec.NewDefaultVoteExtensionCodec(),
+			mockPriceApplier,
+			servicemetrics.NewNopMetrics(),
+			ve.WithParamsKeeper(paramsKeeper),
+			ve.WithSizeBudget(budget),
+		)
+	}
+
+	// extendVote returns the IDs of the currency pairs included in the vote extension, and checks
+	// that the number of dropped prices is recorded.
+	extendVote := func(h *ve.VoteExtensionHandler, maxSize int) []uint64 {
+		resp, err := h.ExtendVoteHandler()(s.ctx, &cometabci.RequestExtendVote{})
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(resp.VoteExtension), maxSize)
+
+		ext, err := codec.NewDefaultVoteExtensionCodec().Decode(resp.VoteExtension)
+		s.Require().NoError(err)
+		s.Require().Equal(len(ids), len(ext.Prices)+int(ext.DroppedPrices))
+
+		included := make([]uint64, 0, len(ext.Prices))
+		for id := range ext.Prices {
+			included = append(included, id)
+		}
+		slices.Sort(included)
+
+		return included
+	}
+
+	s.Run("includes every price within the budget", func() {
+		h := newHandler(ve.SizeBudget{MaxSize: 1024, MovementThreshold: 50})
+		s.Require().Equal([]uint64{0, 1, 2, 3}, extendVote(h, 1024))
+	})
+
+	// each price takes 8 bytes in the vote extension and the number of dropped prices takes 2
+	// bytes, so 3 prices fit in the budget
+	s.Run("prioritises critical and moved prices, then round-robin", func() {
+		h := newHandler(ve.SizeBudget{MaxSize: 26, MovementThreshold: 50})
+		s.Require().Equal([]uint64{0, 2, 3}, extendVote(h, 26))
+		s.Require().Equal([]uint64{1, 2, 3}, extendVote(h, 26))
+		s.Require().Equal([]uint64{0, 2, 3}, extendVote(h, 26))
+	})
+
+	s.Run("does not prioritise prices that moved less than the threshold", func() {
+		h := newHandler(ve.SizeBudget{MaxSize: 18, MovementThreshold: 20000})
+		s.Require().Equal([]uint64{0, 2}, extendVote(h, 18))
+		s.Require().Equal([]uint64{1, 2}, extendVote(h, 18))
+		s.Require().Equal([]uint64{2, 3}, extendVote(h, 18))
+	})
+}
+
 func (s *VoteExtensionTestSuite) TestVerifyVoteExtension() {
 	cdc := codec.NewCompressionVoteExtensionCodec(
 		codec.NewDefaultVoteExtensionCodec(),
 		codec.NewZLibCompressor(),
 	)
 
+	// extendedReq returns a request to verify an extended vote extension with a single price and
+	// the given metadata.
+	extendedReq := func(metadata map[uint64]abcitypes.PriceMetadata) func() *cometabci.RequestVerifyVoteExtension {
+		return func() *cometabci.RequestVerifyVoteExtension {
+			ext, err := cdc.Encode(abcitypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{
+					0: oneHundred.Bytes(),
+				},
+				Metadata: metadata,
+			})
+			s.Require().NoError(err)
+
+			return &cometabci.RequestVerifyVoteExtension{
+				VoteExtension: ext,
+				Height:        1,
+			}
+		}
+	}
+	timestamp := time.Now().UnixMilli()
+
 	cases := []struct {
 		name                 string
 		getReq               func() *cometabci.RequestVerifyVoteExtension
@@ -502,6 +730,123 @@ func (s *VoteExtensionTestSuite) TestVerifyVoteExtension() {
 			},
 			expectedError: true,
 		},
+		{
+			name: "vote extension with more prices and dropped prices than currency pairs",
+			getReq: func() *cometabci.RequestVerifyVoteExtension {
+				ext, err := cdc.Encode(abcitypes.OracleVoteExtension{
+					Prices: map[uint64][]byte{
+						0: oneHundred.Bytes(),
+					},
+					DroppedPrices: 2,
+				})
+				s.Require().NoError(err)
+
+				return &cometabci.RequestVerifyVoteExtension{
+					VoteExtension: ext,
+					Height:        1,
+				}
+			},
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+				cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil).Once()
+				return cpStrategy
+			},
+			expectedResponse: &cometabci.ResponseVerifyVoteExtension{
+				Status: cometabci.ResponseVerifyVoteExtension_REJECT,
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid extended vote extension",
+			getReq: extendedReq(map[uint64]abcitypes.PriceMetadata{
+				0: {Timestamp: timestamp, ProviderCount: 3, Dispersion: 100},
+			}),
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+				cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil).Once()
+				return cpStrategy
+			},
+			expectedResponse: &cometabci.ResponseVerifyVoteExtension{
+				Status: cometabci.ResponseVerifyVoteExtension_ACCEPT,
+			},
+			expectedError: false,
+		},
+		{
+			name: "extended vote extension with metadata for a missing price",
+			getReq: extendedReq(map[uint64]abcitypes.PriceMetadata{
+				0: {Timestamp: timestamp, ProviderCount: 3},
+				1: {Timestamp: timestamp, ProviderCount: 3},
+			}),
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+				cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil).Once()
+				return cpStrategy
+			},
+			expectedResponse: &cometabci.ResponseVerifyVoteExtension{
+				Status: cometabci.ResponseVerifyVoteExtension_REJECT,
+			},
+			expectedError: true,
+		},
+		{
+			name: "extended vote extension without a timestamp",
+			getReq: extendedReq(map[uint64]abcitypes.PriceMetadata{
+				0: {ProviderCount: 3},
+			}),
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+				cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil).Once()
+				return cpStrategy
+			},
+			expectedResponse: &cometabci.ResponseVerifyVoteExtension{
+				Status: cometabci.ResponseVerifyVoteExtension_REJECT,
+			},
+			expectedError: true,
+		},
+		{
+			name: "extended vote extension without providers",
+			getReq: extendedReq(map[uint64]abcitypes.PriceMetadata{
+				0: {Timestamp: timestamp},
+			}),
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+				cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil).Once()
+				return cpStrategy
+			},
+			expectedResponse: &cometabci.ResponseVerifyVoteExtension{
+				Status: cometabci.ResponseVerifyVoteExtension_REJECT,
+			},
+			expectedError: true,
+		},
+		{
+			name: "extended vote extension with too many providers",
+			getReq: extendedReq(map[uint64]abcitypes.PriceMetadata{
+				0: {Timestamp: timestamp, ProviderCount: connectabci.MaximumProviderCount + 1},
+			}),
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+				cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil).Once()
+				return cpStrategy
+			},
+			expectedResponse: &cometabci.ResponseVerifyVoteExtension{
+				Status: cometabci.ResponseVerifyVoteExtension_REJECT,
+			},
+			expectedError: true,
+		},
+		{
+			name: "extended vote extension with a dispersion that is too large",
+			getReq: extendedReq(map[uint64]abcitypes.PriceMetadata{
+				0: {Timestamp: timestamp, ProviderCount: 3, Dispersion: connectabci.MaximumDispersion + 1},
+			}),
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+				cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil).Once()
+				return cpStrategy
+			},
+			expectedResponse: &cometabci.ResponseVerifyVoteExtension{
+				Status: cometabci.ResponseVerifyVoteExtension_REJECT,
+			},
+			expectedError: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -675,6 +1020,66 @@ func (s *VoteExtensionTestSuite) TestExtendVoteStatus() {
 		s.Require().NoError(err)
 	})
 
+	s.Run("test stale prices", func() {
+		mockMetrics := metricsmocks.NewMetrics(s.T())
+		mockClient := mocks.NewOracleClient(s.T())
+		pamock := aggregatormocks.NewPriceApplier(s.T())
+		handler := ve.NewVoteExtensionHandler(
+			log.NewTestLogger(s.T()),
+			mockClient,
+			time.Second*1,
+			mockstrategies.NewCurrencyPairStrategy(s.T()),
+			nil,
+			pamock,
+			mockMetrics,
+			ve.WithMaxPriceAge(time.Minute),
+		)
+
+		pamock.On("ApplyPricesFromVoteExtensions", s.ctx, mock.Anything, mock.Anything).Return(nil, nil)
+
+		mockMetrics.On("ObserveABCIMethodLatency", servicemetrics.ExtendVote, mock.Anything)
+		mockMetrics.On("AddABCIRequest", servicemetrics.ExtendVote, mock.MatchedBy(func(err error) bool {
+			return errors.As(err, &client.StalePricesError{})
+		}))
+		mockClient.On("Prices", mock.Anything, &servicetypes.QueryPricesRequest{}).Return(&servicetypes.QueryPricesResponse{
+			Prices:    singlePrice,
+			Timestamp: time.Now().Add(-2 * time.Minute),
+		}, nil)
+
+		resp, err := handler.ExtendVoteHandler()(s.ctx, &cometabci.RequestExtendVote{})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.VoteExtension)
+	})
+
+	s.Run("test stale prices from the client", func() {
+		mockMetrics := metricsmocks.NewMetrics(s.T())
+		staleError := client.StalePricesError{
+			Timestamp: time.Now().Add(-2 * time.Minute),
+			Age:       2 * time.Minute,
+			MaxAge:    time.Minute,
+		}
+		mockClient := mocks.NewOracleClient(s.T())
+		pamock := aggregatormocks.NewPriceApplier(s.T())
+		handler := ve.NewVoteExtensionHandler(
+			log.NewTestLogger(s.T()),
+			mockClient,
+			time.Second*1,
+			mockstrategies.NewCurrencyPairStrategy(s.T()),
+			nil,
+			pamock,
+			mockMetrics,
+		)
+
+		pamock.On("ApplyPricesFromVoteExtensions", s.ctx, mock.Anything, mock.Anything).Return(nil, nil)
+
+		mockMetrics.On("ObserveABCIMethodLatency", servicemetrics.ExtendVote, mock.Anything)
+		mockMetrics.On("AddABCIRequest", servicemetrics.ExtendVote, staleError)
+		mockClient.On("Prices", mock.Anything, &servicetypes.QueryPricesRequest{}).Return(nil, staleError)
+
+		_, err := handler.ExtendVoteHandler()(s.ctx, &cometabci.RequestExtendVote{})
+		s.Require().NoError(err)
+	})
+
 	s.Run("test price transformation failures", func() {
 		mockMetrics := metricsmocks.NewMetrics(s.T())
 		transformationError := fmt.Errorf("incorrectly formatted CurrencyPair: \"BTCETH\"")
@@ -4,6 +4,7 @@ package abciv2
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -95,15 +96,109 @@ func (x *_OracleVoteExtension_1_map) IsValid() bool {
 	return x.m != nil
 }
 
+var _ protoreflect.Map = (*_OracleVoteExtension_2_map)(nil)
+
+type _OracleVoteExtension_2_map struct {
+	m *map[uint64]*PriceMetadata
+}
+
+func (x *_OracleVoteExtension_2_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_OracleVoteExtension_2_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint64(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_OracleVoteExtension_2_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Uint()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_OracleVoteExtension_2_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_OracleVoteExtension_2_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleVoteExtension_2_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceMetadata)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_OracleVoteExtension_2_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(PriceMetadata)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_OracleVoteExtension_2_map) NewValue() protoreflect.Value {
+	v := new(PriceMetadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleVoteExtension_2_map) IsValid() bool {
+	return x.m != nil
+}
+
 var (
-	md_OracleVoteExtension        protoreflect.MessageDescriptor
-	fd_OracleVoteExtension_prices protoreflect.FieldDescriptor
+	md_OracleVoteExtension                protoreflect.MessageDescriptor
+	fd_OracleVoteExtension_prices         protoreflect.FieldDescriptor
+	fd_OracleVoteExtension_metadata       protoreflect.FieldDescriptor
+	fd_OracleVoteExtension_dropped_prices protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_abci_v2_vote_extensions_proto_init()
 	md_OracleVoteExtension = File_connect_abci_v2_vote_extensions_proto.Messages().ByName("OracleVoteExtension")
 	fd_OracleVoteExtension_prices = md_OracleVoteExtension.Fields().ByName("prices")
+	fd_OracleVoteExtension_metadata = md_OracleVoteExtension.Fields().ByName("metadata")
+	fd_OracleVoteExtension_dropped_prices = md_OracleVoteExtension.Fields().ByName("dropped_prices")
 }
 
 var _ protoreflect.Message = (*fastReflection_OracleVoteExtension)(nil)
@@ -177,6 +272,18 @@ func (x *fastReflection_OracleVoteExtension) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if len(x.Metadata) != 0 {
+		value := protoreflect.ValueOfMap(&_OracleVoteExtension_2_map{m: &x.Metadata})
+		if !f(fd_OracleVoteExtension_metadata, value) {
+			return
+		}
+	}
+	if x.DroppedPrices != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DroppedPrices)
+		if !f(fd_OracleVoteExtension_dropped_prices, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -194,6 +301,10 @@ func (x *fastReflection_OracleVoteExtension) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "connect.abci.v2.OracleVoteExtension.prices":
 		return len(x.Prices) != 0
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		return len(x.Metadata) != 0
+	case "connect.abci.v2.OracleVoteExtension.dropped_prices":
+		return x.DroppedPrices != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -212,6 +323,10 @@ func (x *fastReflection_OracleVoteExtension) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "connect.abci.v2.OracleVoteExtension.prices":
 		x.Prices = nil
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		x.Metadata = nil
+	case "connect.abci.v2.OracleVoteExtension.dropped_prices":
+		x.DroppedPrices = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -234,6 +349,15 @@ func (x *fastReflection_OracleVoteExtension) Get(descriptor protoreflect.FieldDe
 		}
 		mapValue := &_OracleVoteExtension_1_map{m: &x.Prices}
 		return protoreflect.ValueOfMap(mapValue)
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		if len(x.Metadata) == 0 {
+			return protoreflect.ValueOfMap(&_OracleVoteExtension_2_map{})
+		}
+		mapValue := &_OracleVoteExtension_2_map{m: &x.Metadata}
+		return protoreflect.ValueOfMap(mapValue)
+	case "connect.abci.v2.OracleVoteExtension.dropped_prices":
+		value := x.DroppedPrices
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -258,6 +382,12 @@ func (x *fastReflection_OracleVoteExtension) Set(fd protoreflect.FieldDescriptor
 		mv := value.Map()
 		cmv := mv.(*_OracleVoteExtension_1_map)
 		x.Prices = *cmv.m
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		mv := value.Map()
+		cmv := mv.(*_OracleVoteExtension_2_map)
+		x.Metadata = *cmv.m
+	case "connect.abci.v2.OracleVoteExtension.dropped_prices":
+		x.DroppedPrices = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -284,6 +414,14 @@ func (x *fastReflection_OracleVoteExtension) Mutable(fd protoreflect.FieldDescri
 		}
 		value := &_OracleVoteExtension_1_map{m: &x.Prices}
 		return protoreflect.ValueOfMap(value)
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		if x.Metadata == nil {
+			x.Metadata = make(map[uint64]*PriceMetadata)
+		}
+		value := &_OracleVoteExtension_2_map{m: &x.Metadata}
+		return protoreflect.ValueOfMap(value)
+	case "connect.abci.v2.OracleVoteExtension.dropped_prices":
+		panic(fmt.Errorf("field dropped_prices of message connect.abci.v2.OracleVoteExtension is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -300,6 +438,11 @@ func (x *fastReflection_OracleVoteExtension) NewField(fd protoreflect.FieldDescr
 	case "connect.abci.v2.OracleVoteExtension.prices":
 		m := make(map[uint64][]byte)
 		return protoreflect.ValueOfMap(&_OracleVoteExtension_1_map{m: &m})
+	case "connect.abci.v2.OracleVoteExtension.metadata":
+		m := make(map[uint64]*PriceMetadata)
+		return protoreflect.ValueOfMap(&_OracleVoteExtension_2_map{m: &m})
+	case "connect.abci.v2.OracleVoteExtension.dropped_prices":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -393,6 +536,37 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 				}
 			}
 		}
+		if len(x.Metadata) > 0 {
+			SiZeMaP := func(k uint64, v *PriceMetadata) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint64, 0, len(x.Metadata))
+				for k := range x.Metadata {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.Metadata[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Metadata {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.DroppedPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.DroppedPrices))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -422,6 +596,59 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DroppedPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DroppedPrices))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Metadata) > 0 {
+			MaRsHaLmAp := func(k uint64, v *PriceMetadata) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x12
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForMetadata := make([]uint64, 0, len(x.Metadata))
+				for k := range x.Metadata {
+					keysForMetadata = append(keysForMetadata, uint64(k))
+				}
+				sort.Slice(keysForMetadata, func(i, j int) bool {
+					return keysForMetadata[i] < keysForMetadata[j]
+				})
+				for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Metadata[uint64(keysForMetadata[iNdEx])]
+					out, err := MaRsHaLmAp(keysForMetadata[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Metadata {
+					v := x.Metadata[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
 		if len(x.Prices) > 0 {
 			MaRsHaLmAp := func(k uint64, v []byte) (protoiface.MarshalOutput, error) {
 				baseI := i
@@ -626,6 +853,140 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 				}
 				x.Prices[mapkey] = mapvalue
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Metadata == nil {
+					x.Metadata = make(map[uint64]*PriceMetadata)
+				}
+				var mapkey uint64
+				var mapvalue *PriceMetadata
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						var mapmsglen int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapmsglen |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &PriceMetadata{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.Metadata[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DroppedPrices", wireType)
+				}
+				x.DroppedPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DroppedPrices |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -661,22 +1022,522 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: connect/abci/v2/vote_extensions.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_PriceMetadata                protoreflect.MessageDescriptor
+	fd_PriceMetadata_timestamp      protoreflect.FieldDescriptor
+	fd_PriceMetadata_provider_count protoreflect.FieldDescriptor
+	fd_PriceMetadata_dispersion     protoreflect.FieldDescriptor
 )
 
-// OracleVoteExtension defines the vote extension structure for oracle prices.
-type OracleVoteExtension struct {
-	state         protoimpl.MessageState
+func init() {
+	file_connect_abci_v2_vote_extensions_proto_init()
+	md_PriceMetadata = File_connect_abci_v2_vote_extensions_proto.Messages().ByName("PriceMetadata")
+	fd_PriceMetadata_timestamp = md_PriceMetadata.Fields().ByName("timestamp")
+	fd_PriceMetadata_provider_count = md_PriceMetadata.Fields().ByName("provider_count")
+	fd_PriceMetadata_dispersion = md_PriceMetadata.Fields().ByName("dispersion")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceMetadata)(nil)
+
+type fastReflection_PriceMetadata PriceMetadata
+
+func (x *PriceMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceMetadata)(x)
+}
+
+func (x *PriceMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_abci_v2_vote_extensions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceMetadata_messageType fastReflection_PriceMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_PriceMetadata_messageType{}
+
+type fastReflection_PriceMetadata_messageType struct{}
+
+func (x fastReflection_PriceMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceMetadata)(nil)
+}
+func (x fastReflection_PriceMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceMetadata)
+}
+func (x fastReflection_PriceMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_PriceMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceMetadata) New() protoreflect.Message {
+	return new(fastReflection_PriceMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceMetadata) Interface() protoreflect.ProtoMessage {
+	return (*PriceMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Timestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Timestamp)
+		if !f(fd_PriceMetadata_timestamp, value) {
+			return
+		}
+	}
+	if x.ProviderCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ProviderCount)
+		if !f(fd_PriceMetadata_provider_count, value) {
+			return
+		}
+	}
+	if x.Dispersion != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Dispersion)
+		if !f(fd_PriceMetadata_dispersion, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.abci.v2.PriceMetadata.timestamp":
+		return x.Timestamp != int64(0)
+	case "connect.abci.v2.PriceMetadata.provider_count":
+		return x.ProviderCount != uint32(0)
+	case "connect.abci.v2.PriceMetadata.dispersion":
+		return x.Dispersion != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.abci.v2.PriceMetadata.timestamp":
+		x.Timestamp = int64(0)
+	case "connect.abci.v2.PriceMetadata.provider_count":
+		x.ProviderCount = uint32(0)
+	case "connect.abci.v2.PriceMetadata.dispersion":
+		x.Dispersion = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.abci.v2.PriceMetadata.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfInt64(value)
+	case "connect.abci.v2.PriceMetadata.provider_count":
+		value := x.ProviderCount
+		return protoreflect.ValueOfUint32(value)
+	case "connect.abci.v2.PriceMetadata.dispersion":
+		value := x.Dispersion
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.abci.v2.PriceMetadata.timestamp":
+		x.Timestamp = value.Int()
+	case "connect.abci.v2.PriceMetadata.provider_count":
+		x.ProviderCount = uint32(value.Uint())
+	case "connect.abci.v2.PriceMetadata.dispersion":
+		x.Dispersion = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.abci.v2.PriceMetadata.timestamp":
+		panic(fmt.Errorf("field timestamp of message connect.abci.v2.PriceMetadata is not mutable"))
+	case "connect.abci.v2.PriceMetadata.provider_count":
+		panic(fmt.Errorf("field provider_count of message connect.abci.v2.PriceMetadata is not mutable"))
+	case "connect.abci.v2.PriceMetadata.dispersion":
+		panic(fmt.Errorf("field dispersion of message connect.abci.v2.PriceMetadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.abci.v2.PriceMetadata.timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "connect.abci.v2.PriceMetadata.provider_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.abci.v2.PriceMetadata.dispersion":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.PriceMetadata"))
+		}
+		panic(fmt.Errorf("message connect.abci.v2.PriceMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.abci.v2.PriceMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
+		if x.ProviderCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProviderCount))
+		}
+		if x.Dispersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.Dispersion))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Dispersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Dispersion))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ProviderCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProviderCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceMetadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceMetadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderCount", wireType)
+				}
+				x.ProviderCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProviderCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+				}
+				x.Dispersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Dispersion |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: connect/abci/v2/vote_extensions.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OracleVoteExtension defines the vote extension structure for oracle prices.
+type OracleVoteExtension struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
@@ -684,6 +1545,13 @@ type OracleVoteExtension struct {
 	// 0x123.. (bytes). Notice the `id` function is determined by the
 	// `CurrencyPairIDStrategy` used in the VoteExtensionHandler.
 	Prices map[uint64][]byte `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Metadata defines a map of id(CurrencyPair) -> metadata of the price with
+	// the same id. It is only populated if extended vote extensions are enabled
+	// in the x/oracle module's params.
+	Metadata map[uint64]*PriceMetadata `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// DroppedPrices defines the number of prices that were dropped from the vote
+	// extension to fit the validator's vote extension size budget.
+	DroppedPrices uint32 `protobuf:"varint,3,opt,name=dropped_prices,json=droppedPrices,proto3" json:"dropped_prices,omitempty"`
 }
 
 func (x *OracleVoteExtension) Reset() {
@@ -713,35 +1581,130 @@ func (x *OracleVoteExtension) GetPrices() map[uint64][]byte {
 	return nil
 }
 
+func (x *OracleVoteExtension) GetMetadata() map[uint64]*PriceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *OracleVoteExtension) GetDroppedPrices() uint32 {
+	if x != nil {
+		return x.DroppedPrices
+	}
+	return 0
+}
+
+// PriceMetadata defines how fresh a price in an extended vote extension is, and
+// how many sources went into it.
+type PriceMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Timestamp defines the time at which the validator's oracle observed the
+	// price, in unix milliseconds.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// ProviderCount defines the number of provider prices that were used to
+	// calculate the price.
+	ProviderCount uint32 `protobuf:"varint,2,opt,name=provider_count,json=providerCount,proto3" json:"provider_count,omitempty"`
+	// Dispersion defines the standard deviation of the provider prices, relative
+	// to the price, in basis points.
+	Dispersion uint32 `protobuf:"varint,3,opt,name=dispersion,proto3" json:"dispersion,omitempty"`
+}
+
+func (x *PriceMetadata) Reset() {
+	*x = PriceMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_abci_v2_vote_extensions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceMetadata) ProtoMessage() {}
+
+// Deprecated: Use PriceMetadata.ProtoReflect.Descriptor instead.
+func (*PriceMetadata) Descriptor() ([]byte, []int) {
+	return file_connect_abci_v2_vote_extensions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PriceMetadata) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PriceMetadata) GetProviderCount() uint32 {
+	if x != nil {
+		return x.ProviderCount
+	}
+	return 0
+}
+
+func (x *PriceMetadata) GetDispersion() uint32 {
+	if x != nil {
+		return x.Dispersion
+	}
+	return 0
+}
+
 var File_connect_abci_v2_vote_extensions_proto protoreflect.FileDescriptor
 
 var file_connect_abci_v2_vote_extensions_proto_rawDesc = []byte{
 	0x0a, 0x25, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76,
 	0x32, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x48, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e,
-	0x76, 0x32, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0xb1, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x42, 0x13, 0x56,
-	0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x61,
-	0x62, 0x63, 0x69, 0x2f, 0x76, 0x32, 0x3b, 0x61, 0x62, 0x63, 0x69, 0x76, 0x32, 0xa2, 0x02, 0x03,
-	0x43, 0x41, 0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x62,
-	0x63, 0x69, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c,
-	0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a,
-	0x3a, 0x41, 0x62, 0x63, 0x69, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4,
+	0x02, 0x0a, 0x13, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56,
+	0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x54, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63,
+	0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x39, 0x0a,
+	0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x74, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x64, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0xb1, 0x01, 0x0a, 0x13,
+	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69,
+	0x2e, 0x76, 0x32, 0x42, 0x13, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x32, 0x3b, 0x61, 0x62, 0x63,
+	0x69, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x2e, 0x41, 0x62, 0x63, 0x69, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0f, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1b,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x32, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69, 0x3a, 0x3a, 0x56, 0x32, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -756,18 +1719,22 @@ func file_connect_abci_v2_vote_extensions_proto_rawDescGZIP() []byte {
 	return file_connect_abci_v2_vote_extensions_proto_rawDescData
 }
 
-var file_connect_abci_v2_vote_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_connect_abci_v2_vote_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_connect_abci_v2_vote_extensions_proto_goTypes = []interface{}{
 	(*OracleVoteExtension)(nil), // 0: connect.abci.v2.OracleVoteExtension
-	nil,                         // 1: connect.abci.v2.OracleVoteExtension.PricesEntry
+	(*PriceMetadata)(nil),       // 1: connect.abci.v2.PriceMetadata
+	nil,                         // 2: connect.abci.v2.OracleVoteExtension.PricesEntry
+	nil,                         // 3: connect.abci.v2.OracleVoteExtension.MetadataEntry
 }
 var file_connect_abci_v2_vote_extensions_proto_depIdxs = []int32{
-	1, // 0: connect.abci.v2.OracleVoteExtension.prices:type_name -> connect.abci.v2.OracleVoteExtension.PricesEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: connect.abci.v2.OracleVoteExtension.prices:type_name -> connect.abci.v2.OracleVoteExtension.PricesEntry
+	3, // 1: connect.abci.v2.OracleVoteExtension.metadata:type_name -> connect.abci.v2.OracleVoteExtension.MetadataEntry
+	1, // 2: connect.abci.v2.OracleVoteExtension.MetadataEntry.value:type_name -> connect.abci.v2.PriceMetadata
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_connect_abci_v2_vote_extensions_proto_init() }
@@ -788,6 +1755,18 @@ func file_connect_abci_v2_vote_extensions_proto_init() {
 				return nil
 			}
 		}
+		file_connect_abci_v2_vote_extensions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -795,7 +1774,7 @@ func file_connect_abci_v2_vote_extensions_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_connect_abci_v2_vote_extensions_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1237,13 +1237,66 @@ func (x *fastReflection_Ticker) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ProviderConfig_5_list)(nil)
+
+type _ProviderConfig_5_list struct {
+	list *[]*NormalizationPair
+}
+
+func (x *_ProviderConfig_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderConfig_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NormalizationPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderConfig_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NormalizationPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderConfig_5_list) AppendMutable() protoreflect.Value {
+	v := new(NormalizationPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderConfig_5_list) NewElement() protoreflect.Value {
+	v := new(NormalizationPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ProviderConfig                   protoreflect.MessageDescriptor
-	fd_ProviderConfig_name              protoreflect.FieldDescriptor
-	fd_ProviderConfig_off_chain_ticker  protoreflect.FieldDescriptor
-	fd_ProviderConfig_normalize_by_pair protoreflect.FieldDescriptor
-	fd_ProviderConfig_invert            protoreflect.FieldDescriptor
-	fd_ProviderConfig_metadata_JSON     protoreflect.FieldDescriptor
+	md_ProviderConfig                    protoreflect.MessageDescriptor
+	fd_ProviderConfig_name               protoreflect.FieldDescriptor
+	fd_ProviderConfig_off_chain_ticker   protoreflect.FieldDescriptor
+	fd_ProviderConfig_normalize_by_pair  protoreflect.FieldDescriptor
+	fd_ProviderConfig_invert             protoreflect.FieldDescriptor
+	fd_ProviderConfig_normalize_by_pairs protoreflect.FieldDescriptor
+	fd_ProviderConfig_priority           protoreflect.FieldDescriptor
+	fd_ProviderConfig_metadata_JSON      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1253,6 +1306,8 @@ func init() {
 	fd_ProviderConfig_off_chain_ticker = md_ProviderConfig.Fields().ByName("off_chain_ticker")
 	fd_ProviderConfig_normalize_by_pair = md_ProviderConfig.Fields().ByName("normalize_by_pair")
 	fd_ProviderConfig_invert = md_ProviderConfig.Fields().ByName("invert")
+	fd_ProviderConfig_normalize_by_pairs = md_ProviderConfig.Fields().ByName("normalize_by_pairs")
+	fd_ProviderConfig_priority = md_ProviderConfig.Fields().ByName("priority")
 	fd_ProviderConfig_metadata_JSON = md_ProviderConfig.Fields().ByName("metadata_JSON")
 }
 
@@ -1345,6 +1400,18 @@ func (x *fastReflection_ProviderConfig) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.NormalizeByPairs) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderConfig_5_list{list: &x.NormalizeByPairs})
+		if !f(fd_ProviderConfig_normalize_by_pairs, value) {
+			return
+		}
+	}
+	if x.Priority != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Priority)
+		if !f(fd_ProviderConfig_priority, value) {
+			return
+		}
+	}
 	if x.Metadata_JSON != "" {
 		value := protoreflect.ValueOfString(x.Metadata_JSON)
 		if !f(fd_ProviderConfig_metadata_JSON, value) {
@@ -1374,6 +1441,10 @@ func (x *fastReflection_ProviderConfig) Has(fd protoreflect.FieldDescriptor) boo
 		return x.NormalizeByPair != nil
 	case "connect.marketmap.v2.ProviderConfig.invert":
 		return x.Invert != false
+	case "connect.marketmap.v2.ProviderConfig.normalize_by_pairs":
+		return len(x.NormalizeByPairs) != 0
+	case "connect.marketmap.v2.ProviderConfig.priority":
+		return x.Priority != uint32(0)
 	case "connect.marketmap.v2.ProviderConfig.metadata_JSON":
 		return x.Metadata_JSON != ""
 	default:
@@ -1400,6 +1471,10 @@ func (x *fastReflection_ProviderConfig) Clear(fd protoreflect.FieldDescriptor) {
 		x.NormalizeByPair = nil
 	case "connect.marketmap.v2.ProviderConfig.invert":
 		x.Invert = false
+	case "connect.marketmap.v2.ProviderConfig.normalize_by_pairs":
+		x.NormalizeByPairs = nil
+	case "connect.marketmap.v2.ProviderConfig.priority":
+		x.Priority = uint32(0)
 	case "connect.marketmap.v2.ProviderConfig.metadata_JSON":
 		x.Metadata_JSON = ""
 	default:
@@ -1430,6 +1505,15 @@ func (x *fastReflection_ProviderConfig) Get(descriptor protoreflect.FieldDescrip
 	case "connect.marketmap.v2.ProviderConfig.invert":
 		value := x.Invert
 		return protoreflect.ValueOfBool(value)
+	case "connect.marketmap.v2.ProviderConfig.normalize_by_pairs":
+		if len(x.NormalizeByPairs) == 0 {
+			return protoreflect.ValueOfList(&_ProviderConfig_5_list{})
+		}
+		listValue := &_ProviderConfig_5_list{list: &x.NormalizeByPairs}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.ProviderConfig.priority":
+		value := x.Priority
+		return protoreflect.ValueOfUint32(value)
 	case "connect.marketmap.v2.ProviderConfig.metadata_JSON":
 		value := x.Metadata_JSON
 		return protoreflect.ValueOfString(value)
@@ -1461,13 +1545,745 @@ func (x *fastReflection_ProviderConfig) Set(fd protoreflect.FieldDescriptor, val
 		x.NormalizeByPair = value.Message().Interface().(*v2.CurrencyPair)
 	case "connect.marketmap.v2.ProviderConfig.invert":
 		x.Invert = value.Bool()
-	case "connect.marketmap.v2.ProviderConfig.metadata_JSON":
-		x.Metadata_JSON = value.Interface().(string)
+	case "connect.marketmap.v2.ProviderConfig.normalize_by_pairs":
+		lv := value.List()
+		clv := lv.(*_ProviderConfig_5_list)
+		x.NormalizeByPairs = *clv.list
+	case "connect.marketmap.v2.ProviderConfig.priority":
+		x.Priority = uint32(value.Uint())
+	case "connect.marketmap.v2.ProviderConfig.metadata_JSON":
+		x.Metadata_JSON = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProviderConfig"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProviderConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ProviderConfig.normalize_by_pair":
+		if x.NormalizeByPair == nil {
+			x.NormalizeByPair = new(v2.CurrencyPair)
+		}
+		return protoreflect.ValueOfMessage(x.NormalizeByPair.ProtoReflect())
+	case "connect.marketmap.v2.ProviderConfig.normalize_by_pairs":
+		if x.NormalizeByPairs == nil {
+			x.NormalizeByPairs = []*NormalizationPair{}
+		}
+		value := &_ProviderConfig_5_list{list: &x.NormalizeByPairs}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.ProviderConfig.name":
+		panic(fmt.Errorf("field name of message connect.marketmap.v2.ProviderConfig is not mutable"))
+	case "connect.marketmap.v2.ProviderConfig.off_chain_ticker":
+		panic(fmt.Errorf("field off_chain_ticker of message connect.marketmap.v2.ProviderConfig is not mutable"))
+	case "connect.marketmap.v2.ProviderConfig.invert":
+		panic(fmt.Errorf("field invert of message connect.marketmap.v2.ProviderConfig is not mutable"))
+	case "connect.marketmap.v2.ProviderConfig.priority":
+		panic(fmt.Errorf("field priority of message connect.marketmap.v2.ProviderConfig is not mutable"))
+	case "connect.marketmap.v2.ProviderConfig.metadata_JSON":
+		panic(fmt.Errorf("field metadata_JSON of message connect.marketmap.v2.ProviderConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProviderConfig"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProviderConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProviderConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ProviderConfig.name":
+		return protoreflect.ValueOfString("")
+	case "connect.marketmap.v2.ProviderConfig.off_chain_ticker":
+		return protoreflect.ValueOfString("")
+	case "connect.marketmap.v2.ProviderConfig.normalize_by_pair":
+		m := new(v2.CurrencyPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "connect.marketmap.v2.ProviderConfig.invert":
+		return protoreflect.ValueOfBool(false)
+	case "connect.marketmap.v2.ProviderConfig.normalize_by_pairs":
+		list := []*NormalizationPair{}
+		return protoreflect.ValueOfList(&_ProviderConfig_5_list{list: &list})
+	case "connect.marketmap.v2.ProviderConfig.priority":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "connect.marketmap.v2.ProviderConfig.metadata_JSON":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProviderConfig"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProviderConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProviderConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.ProviderConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProviderConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProviderConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProviderConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OffChainTicker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NormalizeByPair != nil {
+			l = options.Size(x.NormalizeByPair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Invert {
+			n += 2
+		}
+		if len(x.NormalizeByPairs) > 0 {
+			for _, e := range x.NormalizeByPairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Priority != 0 {
+			n += 1 + runtime.Sov(uint64(x.Priority))
+		}
+		l = len(x.Metadata_JSON)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Metadata_JSON) > 0 {
+			i -= len(x.Metadata_JSON)
+			copy(dAtA[i:], x.Metadata_JSON)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata_JSON)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.Priority != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Priority))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.NormalizeByPairs) > 0 {
+			for iNdEx := len(x.NormalizeByPairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NormalizeByPairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Invert {
+			i--
+			if x.Invert {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NormalizeByPair != nil {
+			encoded, err := options.Marshal(x.NormalizeByPair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OffChainTicker) > 0 {
+			i -= len(x.OffChainTicker)
+			copy(dAtA[i:], x.OffChainTicker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OffChainTicker)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OffChainTicker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPair", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NormalizeByPair == nil {
+					x.NormalizeByPair = &v2.CurrencyPair{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NormalizeByPair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Invert = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPairs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NormalizeByPairs = append(x.NormalizeByPairs, &NormalizationPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NormalizeByPairs[len(x.NormalizeByPairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				}
+				x.Priority = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Priority |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata_JSON = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_NormalizationPair               protoreflect.MessageDescriptor
+	fd_NormalizationPair_currency_pair protoreflect.FieldDescriptor
+	fd_NormalizationPair_invert        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_market_proto_init()
+	md_NormalizationPair = File_connect_marketmap_v2_market_proto.Messages().ByName("NormalizationPair")
+	fd_NormalizationPair_currency_pair = md_NormalizationPair.Fields().ByName("currency_pair")
+	fd_NormalizationPair_invert = md_NormalizationPair.Fields().ByName("invert")
+}
+
+var _ protoreflect.Message = (*fastReflection_NormalizationPair)(nil)
+
+type fastReflection_NormalizationPair NormalizationPair
+
+func (x *NormalizationPair) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NormalizationPair)(x)
+}
+
+func (x *NormalizationPair) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_market_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NormalizationPair_messageType fastReflection_NormalizationPair_messageType
+var _ protoreflect.MessageType = fastReflection_NormalizationPair_messageType{}
+
+type fastReflection_NormalizationPair_messageType struct{}
+
+func (x fastReflection_NormalizationPair_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NormalizationPair)(nil)
+}
+func (x fastReflection_NormalizationPair_messageType) New() protoreflect.Message {
+	return new(fastReflection_NormalizationPair)
+}
+func (x fastReflection_NormalizationPair_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NormalizationPair
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NormalizationPair) Descriptor() protoreflect.MessageDescriptor {
+	return md_NormalizationPair
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NormalizationPair) Type() protoreflect.MessageType {
+	return _fastReflection_NormalizationPair_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NormalizationPair) New() protoreflect.Message {
+	return new(fastReflection_NormalizationPair)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NormalizationPair) Interface() protoreflect.ProtoMessage {
+	return (*NormalizationPair)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NormalizationPair) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		if !f(fd_NormalizationPair_currency_pair, value) {
+			return
+		}
+	}
+	if x.Invert != false {
+		value := protoreflect.ValueOfBool(x.Invert)
+		if !f(fd_NormalizationPair_invert, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NormalizationPair) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.NormalizationPair.currency_pair":
+		return x.CurrencyPair != nil
+	case "connect.marketmap.v2.NormalizationPair.invert":
+		return x.Invert != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.NormalizationPair"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.NormalizationPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NormalizationPair) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.NormalizationPair.currency_pair":
+		x.CurrencyPair = nil
+	case "connect.marketmap.v2.NormalizationPair.invert":
+		x.Invert = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.NormalizationPair"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.NormalizationPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NormalizationPair) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.NormalizationPair.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "connect.marketmap.v2.NormalizationPair.invert":
+		value := x.Invert
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.NormalizationPair"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.NormalizationPair does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NormalizationPair) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.NormalizationPair.currency_pair":
+		x.CurrencyPair = value.Message().Interface().(*v2.CurrencyPair)
+	case "connect.marketmap.v2.NormalizationPair.invert":
+		x.Invert = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProviderConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.NormalizationPair"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ProviderConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.NormalizationPair does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1481,60 +2297,48 @@ func (x *fastReflection_ProviderConfig) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProviderConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NormalizationPair) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.ProviderConfig.normalize_by_pair":
-		if x.NormalizeByPair == nil {
-			x.NormalizeByPair = new(v2.CurrencyPair)
+	case "connect.marketmap.v2.NormalizationPair.currency_pair":
+		if x.CurrencyPair == nil {
+			x.CurrencyPair = new(v2.CurrencyPair)
 		}
-		return protoreflect.ValueOfMessage(x.NormalizeByPair.ProtoReflect())
-	case "connect.marketmap.v2.ProviderConfig.name":
-		panic(fmt.Errorf("field name of message connect.marketmap.v2.ProviderConfig is not mutable"))
-	case "connect.marketmap.v2.ProviderConfig.off_chain_ticker":
-		panic(fmt.Errorf("field off_chain_ticker of message connect.marketmap.v2.ProviderConfig is not mutable"))
-	case "connect.marketmap.v2.ProviderConfig.invert":
-		panic(fmt.Errorf("field invert of message connect.marketmap.v2.ProviderConfig is not mutable"))
-	case "connect.marketmap.v2.ProviderConfig.metadata_JSON":
-		panic(fmt.Errorf("field metadata_JSON of message connect.marketmap.v2.ProviderConfig is not mutable"))
+		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+	case "connect.marketmap.v2.NormalizationPair.invert":
+		panic(fmt.Errorf("field invert of message connect.marketmap.v2.NormalizationPair is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProviderConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.NormalizationPair"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ProviderConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.NormalizationPair does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ProviderConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NormalizationPair) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.ProviderConfig.name":
-		return protoreflect.ValueOfString("")
-	case "connect.marketmap.v2.ProviderConfig.off_chain_ticker":
-		return protoreflect.ValueOfString("")
-	case "connect.marketmap.v2.ProviderConfig.normalize_by_pair":
+	case "connect.marketmap.v2.NormalizationPair.currency_pair":
 		m := new(v2.CurrencyPair)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "connect.marketmap.v2.ProviderConfig.invert":
+	case "connect.marketmap.v2.NormalizationPair.invert":
 		return protoreflect.ValueOfBool(false)
-	case "connect.marketmap.v2.ProviderConfig.metadata_JSON":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProviderConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.NormalizationPair"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ProviderConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.NormalizationPair does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ProviderConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_NormalizationPair) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.ProviderConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.NormalizationPair", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1542,7 +2346,7 @@ func (x *fastReflection_ProviderConfig) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ProviderConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_NormalizationPair) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1553,7 +2357,7 @@ func (x *fastReflection_ProviderConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProviderConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_NormalizationPair) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1565,7 +2369,7 @@ func (x *fastReflection_ProviderConfig) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ProviderConfig) IsValid() bool {
+func (x *fastReflection_NormalizationPair) IsValid() bool {
 	return x != nil
 }
 
@@ -1575,9 +2379,9 @@ func (x *fastReflection_ProviderConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_NormalizationPair) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ProviderConfig)
+		x := input.Message.Interface().(*NormalizationPair)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1589,25 +2393,13 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.OffChainTicker)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.NormalizeByPair != nil {
-			l = options.Size(x.NormalizeByPair)
+		if x.CurrencyPair != nil {
+			l = options.Size(x.CurrencyPair)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Invert {
 			n += 2
 		}
-		l = len(x.Metadata_JSON)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1618,7 +2410,7 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ProviderConfig)
+		x := input.Message.Interface().(*NormalizationPair)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1637,13 +2429,6 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Metadata_JSON) > 0 {
-			i -= len(x.Metadata_JSON)
-			copy(dAtA[i:], x.Metadata_JSON)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata_JSON)))
-			i--
-			dAtA[i] = 0x7a
-		}
 		if x.Invert {
 			i--
 			if x.Invert {
@@ -1652,10 +2437,10 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 				dAtA[i] = 0
 			}
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x10
 		}
-		if x.NormalizeByPair != nil {
-			encoded, err := options.Marshal(x.NormalizeByPair)
+		if x.CurrencyPair != nil {
+			encoded, err := options.Marshal(x.CurrencyPair)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1666,20 +2451,6 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.OffChainTicker) > 0 {
-			i -= len(x.OffChainTicker)
-			copy(dAtA[i:], x.OffChainTicker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OffChainTicker)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -1693,7 +2464,7 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ProviderConfig)
+		x := input.Message.Interface().(*NormalizationPair)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1725,79 +2496,15 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NormalizationPair: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NormalizationPair: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.OffChainTicker = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPair", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1824,14 +2531,14 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.NormalizeByPair == nil {
-					x.NormalizeByPair = &v2.CurrencyPair{}
+				if x.CurrencyPair == nil {
+					x.CurrencyPair = &v2.CurrencyPair{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NormalizeByPair); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 2:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
 				}
@@ -1851,38 +2558,6 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Invert = bool(v != 0)
-			case 15:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Metadata_JSON = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2028,7 +2703,7 @@ func (x *MarketMap) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MarketMap) slowProtoReflect() protoreflect.Message {
-	mi := &file_connect_marketmap_v2_market_proto_msgTypes[3]
+	mi := &file_connect_marketmap_v2_market_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2760,6 +3435,18 @@ type ProviderConfig struct {
 	// Invert is a boolean indicating if the BASE and QUOTE of the market should
 	// be inverted. i.e. BASE -> QUOTE, QUOTE -> BASE
 	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// NormalizeByPairs is an ordered list of currency pairs for this ticker to be
+	// normalized by. Each pair is applied in order, which allows a ticker to be
+	// reached through several conversions. For example, if the desired Ticker is
+	// FOO/USD, this market could be reached using: OffChainTicker = FOO/ATOM
+	// NormalizeByPairs = [ATOM/USDT, USDT/USD]. This field is optional and cannot
+	// be set alongside NormalizeByPair.
+	NormalizeByPairs []*NormalizationPair `protobuf:"bytes,5,rep,name=normalize_by_pairs,json=normalizeByPairs,proto3" json:"normalize_by_pairs,omitempty"`
+	// Priority is the priority tier of the provider config. Tier 0 is the highest
+	// priority. The oracle only uses the prices of lower priority tiers when the
+	// higher priority tiers do not report enough prices to meet the ticker's
+	// MinProviderCount. Provider configs default to tier 0.
+	Priority uint32 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given provider config.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
@@ -2813,6 +3500,20 @@ func (x *ProviderConfig) GetInvert() bool {
 	return false
 }
 
+func (x *ProviderConfig) GetNormalizeByPairs() []*NormalizationPair {
+	if x != nil {
+		return x.NormalizeByPairs
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 func (x *ProviderConfig) GetMetadata_JSON() string {
 	if x != nil {
 		return x.Metadata_JSON
@@ -2820,6 +3521,56 @@ func (x *ProviderConfig) GetMetadata_JSON() string {
 	return ""
 }
 
+// NormalizationPair is a single conversion in a provider config's
+// normalization path.
+type NormalizationPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CurrencyPair is the currency pair whose index price the price is
+	// normalized by.
+	CurrencyPair *v2.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// Invert is a boolean indicating if the price should be divided by, rather
+	// than multiplied by, the index price of the currency pair. i.e. the price
+	// is normalized by QUOTE/BASE rather than BASE/QUOTE.
+	Invert bool `protobuf:"varint,2,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (x *NormalizationPair) Reset() {
+	*x = NormalizationPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_market_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NormalizationPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizationPair) ProtoMessage() {}
+
+// Deprecated: Use NormalizationPair.ProtoReflect.Descriptor instead.
+func (*NormalizationPair) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_market_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NormalizationPair) GetCurrencyPair() *v2.CurrencyPair {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return nil
+}
+
+func (x *NormalizationPair) GetInvert() bool {
+	if x != nil {
+		return x.Invert
+	}
+	return false
+}
+
 // MarketMap maps ticker strings to their Markets.
 type MarketMap struct {
 	state         protoimpl.MessageState
@@ -2834,7 +3585,7 @@ type MarketMap struct {
 func (x *MarketMap) Reset() {
 	*x = MarketMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_market_proto_msgTypes[3]
+		mi := &file_connect_marketmap_v2_market_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2848,7 +3599,7 @@ func (*MarketMap) ProtoMessage() {}
 
 // Deprecated: Use MarketMap.ProtoReflect.Descriptor instead.
 func (*MarketMap) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_market_proto_rawDescGZIP(), []int{3}
+	return file_connect_marketmap_v2_market_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MarketMap) GetMarkets() map[string]*Market {
@@ -2893,7 +3644,7 @@ var file_connect_marketmap_v2_market_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64,
 	0x61, 0x74, 0x61, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
 	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x3a, 0x08, 0x98, 0xa0,
-	0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x22, 0xd7, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x22, 0xd0, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69,
 	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
 	0x10, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
@@ -2904,35 +3655,50 @@ var file_connect_marketmap_v2_market_proto_rawDesc = []byte{
 	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61,
 	0x69, 0x72, 0x52, 0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x50,
 	0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e,
-	0x22, 0xbd, 0x01, 0x0a, 0x09, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x4c,
-	0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70,
-	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x58, 0x0a, 0x0c,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
-	0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00,
-	0x42, 0xcc, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
-	0x2f, 0x76, 0x32, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x76, 0x32, 0xa2,
-	0x02, 0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x14, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
-	0x5c, 0x56, 0x32, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x32, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x5b, 0x0a, 0x12, 0x6e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x76, 0x0a, 0x11, 0x4e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x49,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x22, 0xbd, 0x01, 0x0a, 0x09, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12,
+	0x4c, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x58, 0x0a,
+	0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
+	0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20,
+	0x00, 0x42, 0xcc, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x42, 0x0b,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x2f, 0x76, 0x32, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x76, 0x32,
+	0xa2, 0x02, 0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x14,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x32,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2947,27 +3713,30 @@ func file_connect_marketmap_v2_market_proto_rawDescGZIP() []byte {
 	return file_connect_marketmap_v2_market_proto_rawDescData
 }
 
-var file_connect_marketmap_v2_market_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_connect_marketmap_v2_market_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_connect_marketmap_v2_market_proto_goTypes = []interface{}{
-	(*Market)(nil),          // 0: connect.marketmap.v2.Market
-	(*Ticker)(nil),          // 1: connect.marketmap.v2.Ticker
-	(*ProviderConfig)(nil),  // 2: connect.marketmap.v2.ProviderConfig
-	(*MarketMap)(nil),       // 3: connect.marketmap.v2.MarketMap
-	nil,                     // 4: connect.marketmap.v2.MarketMap.MarketsEntry
-	(*v2.CurrencyPair)(nil), // 5: connect.types.v2.CurrencyPair
+	(*Market)(nil),            // 0: connect.marketmap.v2.Market
+	(*Ticker)(nil),            // 1: connect.marketmap.v2.Ticker
+	(*ProviderConfig)(nil),    // 2: connect.marketmap.v2.ProviderConfig
+	(*NormalizationPair)(nil), // 3: connect.marketmap.v2.NormalizationPair
+	(*MarketMap)(nil),         // 4: connect.marketmap.v2.MarketMap
+	nil,                       // 5: connect.marketmap.v2.MarketMap.MarketsEntry
+	(*v2.CurrencyPair)(nil),   // 6: connect.types.v2.CurrencyPair
 }
 var file_connect_marketmap_v2_market_proto_depIdxs = []int32{
 	1, // 0: connect.marketmap.v2.Market.ticker:type_name -> connect.marketmap.v2.Ticker
 	2, // 1: connect.marketmap.v2.Market.provider_configs:type_name -> connect.marketmap.v2.ProviderConfig
-	5, // 2: connect.marketmap.v2.Ticker.currency_pair:type_name -> connect.types.v2.CurrencyPair
-	5, // 3: connect.marketmap.v2.ProviderConfig.normalize_by_pair:type_name -> connect.types.v2.CurrencyPair
-	4, // 4: connect.marketmap.v2.MarketMap.markets:type_name -> connect.marketmap.v2.MarketMap.MarketsEntry
-	0, // 5: connect.marketmap.v2.MarketMap.MarketsEntry.value:type_name -> connect.marketmap.v2.Market
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	6, // 2: connect.marketmap.v2.Ticker.currency_pair:type_name -> connect.types.v2.CurrencyPair
+	6, // 3: connect.marketmap.v2.ProviderConfig.normalize_by_pair:type_name -> connect.types.v2.CurrencyPair
+	3, // 4: connect.marketmap.v2.ProviderConfig.normalize_by_pairs:type_name -> connect.marketmap.v2.NormalizationPair
+	6, // 5: connect.marketmap.v2.NormalizationPair.currency_pair:type_name -> connect.types.v2.CurrencyPair
+	5, // 6: connect.marketmap.v2.MarketMap.markets:type_name -> connect.marketmap.v2.MarketMap.MarketsEntry
+	0, // 7: connect.marketmap.v2.MarketMap.MarketsEntry.value:type_name -> connect.marketmap.v2.Market
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_connect_marketmap_v2_market_proto_init() }
@@ -3013,6 +3782,18 @@ func file_connect_marketmap_v2_market_proto_init() {
 			}
 		}
 		file_connect_marketmap_v2_market_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NormalizationPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_market_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketMap); i {
 			case 0:
 				return &v.state
@@ -3031,7 +3812,7 @@ func file_connect_marketmap_v2_market_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_connect_marketmap_v2_market_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,1829 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package oraclev2
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ValidatorReport                       protoreflect.MessageDescriptor
+	fd_ValidatorReport_missed_vote_extension protoreflect.FieldDescriptor
+	fd_ValidatorReport_missing_prices        protoreflect.FieldDescriptor
+	fd_ValidatorReport_reported_prices       protoreflect.FieldDescriptor
+	fd_ValidatorReport_deviation             protoreflect.FieldDescriptor
+	fd_ValidatorReport_dropped_prices        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_oracle_v2_performance_proto_init()
+	md_ValidatorReport = File_connect_oracle_v2_performance_proto.Messages().ByName("ValidatorReport")
+	fd_ValidatorReport_missed_vote_extension = md_ValidatorReport.Fields().ByName("missed_vote_extension")
+	fd_ValidatorReport_missing_prices = md_ValidatorReport.Fields().ByName("missing_prices")
+	fd_ValidatorReport_reported_prices = md_ValidatorReport.Fields().ByName("reported_prices")
+	fd_ValidatorReport_deviation = md_ValidatorReport.Fields().ByName("deviation")
+	fd_ValidatorReport_dropped_prices = md_ValidatorReport.Fields().ByName("dropped_prices")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorReport)(nil)
+
+type fastReflection_ValidatorReport ValidatorReport
+
+func (x *ValidatorReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorReport)(x)
+}
+
+func (x *ValidatorReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_oracle_v2_performance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorReport_messageType fastReflection_ValidatorReport_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorReport_messageType{}
+
+type fastReflection_ValidatorReport_messageType struct{}
+
+func (x fastReflection_ValidatorReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorReport)(nil)
+}
+func (x fastReflection_ValidatorReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorReport)
+}
+func (x fastReflection_ValidatorReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorReport
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorReport
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorReport) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorReport_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorReport) New() protoreflect.Message {
+	return new(fastReflection_ValidatorReport)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorReport) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorReport)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MissedVoteExtension != false {
+		value := protoreflect.ValueOfBool(x.MissedVoteExtension)
+		if !f(fd_ValidatorReport_missed_vote_extension, value) {
+			return
+		}
+	}
+	if x.MissingPrices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissingPrices)
+		if !f(fd_ValidatorReport_missing_prices, value) {
+			return
+		}
+	}
+	if x.ReportedPrices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportedPrices)
+		if !f(fd_ValidatorReport_reported_prices, value) {
+			return
+		}
+	}
+	if x.Deviation != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Deviation)
+		if !f(fd_ValidatorReport_deviation, value) {
+			return
+		}
+	}
+	if x.DroppedPrices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DroppedPrices)
+		if !f(fd_ValidatorReport_dropped_prices, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorReport) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorReport.missed_vote_extension":
+		return x.MissedVoteExtension != false
+	case "connect.oracle.v2.ValidatorReport.missing_prices":
+		return x.MissingPrices != uint64(0)
+	case "connect.oracle.v2.ValidatorReport.reported_prices":
+		return x.ReportedPrices != uint64(0)
+	case "connect.oracle.v2.ValidatorReport.deviation":
+		return x.Deviation != uint64(0)
+	case "connect.oracle.v2.ValidatorReport.dropped_prices":
+		return x.DroppedPrices != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorReport) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorReport.missed_vote_extension":
+		x.MissedVoteExtension = false
+	case "connect.oracle.v2.ValidatorReport.missing_prices":
+		x.MissingPrices = uint64(0)
+	case "connect.oracle.v2.ValidatorReport.reported_prices":
+		x.ReportedPrices = uint64(0)
+	case "connect.oracle.v2.ValidatorReport.deviation":
+		x.Deviation = uint64(0)
+	case "connect.oracle.v2.ValidatorReport.dropped_prices":
+		x.DroppedPrices = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.oracle.v2.ValidatorReport.missed_vote_extension":
+		value := x.MissedVoteExtension
+		return protoreflect.ValueOfBool(value)
+	case "connect.oracle.v2.ValidatorReport.missing_prices":
+		value := x.MissingPrices
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorReport.reported_prices":
+		value := x.ReportedPrices
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorReport.deviation":
+		value := x.Deviation
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorReport.dropped_prices":
+		value := x.DroppedPrices
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorReport does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorReport.missed_vote_extension":
+		x.MissedVoteExtension = value.Bool()
+	case "connect.oracle.v2.ValidatorReport.missing_prices":
+		x.MissingPrices = value.Uint()
+	case "connect.oracle.v2.ValidatorReport.reported_prices":
+		x.ReportedPrices = value.Uint()
+	case "connect.oracle.v2.ValidatorReport.deviation":
+		x.Deviation = value.Uint()
+	case "connect.oracle.v2.ValidatorReport.dropped_prices":
+		x.DroppedPrices = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorReport.missed_vote_extension":
+		panic(fmt.Errorf("field missed_vote_extension of message connect.oracle.v2.ValidatorReport is not mutable"))
+	case "connect.oracle.v2.ValidatorReport.missing_prices":
+		panic(fmt.Errorf("field missing_prices of message connect.oracle.v2.ValidatorReport is not mutable"))
+	case "connect.oracle.v2.ValidatorReport.reported_prices":
+		panic(fmt.Errorf("field reported_prices of message connect.oracle.v2.ValidatorReport is not mutable"))
+	case "connect.oracle.v2.ValidatorReport.deviation":
+		panic(fmt.Errorf("field deviation of message connect.oracle.v2.ValidatorReport is not mutable"))
+	case "connect.oracle.v2.ValidatorReport.dropped_prices":
+		panic(fmt.Errorf("field dropped_prices of message connect.oracle.v2.ValidatorReport is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorReport.missed_vote_extension":
+		return protoreflect.ValueOfBool(false)
+	case "connect.oracle.v2.ValidatorReport.missing_prices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorReport.reported_prices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorReport.deviation":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorReport.dropped_prices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.oracle.v2.ValidatorReport", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorReport) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorReport) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorReport) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorReport) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorReport)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MissedVoteExtension {
+			n += 2
+		}
+		if x.MissingPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissingPrices))
+		}
+		if x.ReportedPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportedPrices))
+		}
+		if x.Deviation != 0 {
+			n += 1 + runtime.Sov(uint64(x.Deviation))
+		}
+		if x.DroppedPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.DroppedPrices))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorReport)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DroppedPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DroppedPrices))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Deviation != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Deviation))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ReportedPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportedPrices))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MissingPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissingPrices))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MissedVoteExtension {
+			i--
+			if x.MissedVoteExtension {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorReport)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorReport: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedVoteExtension", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.MissedVoteExtension = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissingPrices", wireType)
+				}
+				x.MissingPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissingPrices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportedPrices", wireType)
+				}
+				x.ReportedPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportedPrices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+				}
+				x.Deviation = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Deviation |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DroppedPrices", wireType)
+				}
+				x.DroppedPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DroppedPrices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorPerformance                        protoreflect.MessageDescriptor
+	fd_ValidatorPerformance_validator              protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_window                 protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_index_offset           protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_blocks                 protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_missed_vote_extensions protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_missing_prices         protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_reported_prices        protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_deviation              protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_last_height            protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_dropped_prices         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_oracle_v2_performance_proto_init()
+	md_ValidatorPerformance = File_connect_oracle_v2_performance_proto.Messages().ByName("ValidatorPerformance")
+	fd_ValidatorPerformance_validator = md_ValidatorPerformance.Fields().ByName("validator")
+	fd_ValidatorPerformance_window = md_ValidatorPerformance.Fields().ByName("window")
+	fd_ValidatorPerformance_index_offset = md_ValidatorPerformance.Fields().ByName("index_offset")
+	fd_ValidatorPerformance_blocks = md_ValidatorPerformance.Fields().ByName("blocks")
+	fd_ValidatorPerformance_missed_vote_extensions = md_ValidatorPerformance.Fields().ByName("missed_vote_extensions")
+	fd_ValidatorPerformance_missing_prices = md_ValidatorPerformance.Fields().ByName("missing_prices")
+	fd_ValidatorPerformance_reported_prices = md_ValidatorPerformance.Fields().ByName("reported_prices")
+	fd_ValidatorPerformance_deviation = md_ValidatorPerformance.Fields().ByName("deviation")
+	fd_ValidatorPerformance_last_height = md_ValidatorPerformance.Fields().ByName("last_height")
+	fd_ValidatorPerformance_dropped_prices = md_ValidatorPerformance.Fields().ByName("dropped_prices")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorPerformance)(nil)
+
+type fastReflection_ValidatorPerformance ValidatorPerformance
+
+func (x *ValidatorPerformance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPerformance)(x)
+}
+
+func (x *ValidatorPerformance) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_oracle_v2_performance_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorPerformance_messageType fastReflection_ValidatorPerformance_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPerformance_messageType{}
+
+type fastReflection_ValidatorPerformance_messageType struct{}
+
+func (x fastReflection_ValidatorPerformance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPerformance)(nil)
+}
+func (x fastReflection_ValidatorPerformance_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPerformance)
+}
+func (x fastReflection_ValidatorPerformance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPerformance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorPerformance) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPerformance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorPerformance) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPerformance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorPerformance) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPerformance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorPerformance) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPerformance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorPerformance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorPerformance_validator, value) {
+			return
+		}
+	}
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_ValidatorPerformance_window, value) {
+			return
+		}
+	}
+	if x.IndexOffset != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IndexOffset)
+		if !f(fd_ValidatorPerformance_index_offset, value) {
+			return
+		}
+	}
+	if x.Blocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Blocks)
+		if !f(fd_ValidatorPerformance_blocks, value) {
+			return
+		}
+	}
+	if x.MissedVoteExtensions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedVoteExtensions)
+		if !f(fd_ValidatorPerformance_missed_vote_extensions, value) {
+			return
+		}
+	}
+	if x.MissingPrices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissingPrices)
+		if !f(fd_ValidatorPerformance_missing_prices, value) {
+			return
+		}
+	}
+	if x.ReportedPrices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportedPrices)
+		if !f(fd_ValidatorPerformance_reported_prices, value) {
+			return
+		}
+	}
+	if x.Deviation != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Deviation)
+		if !f(fd_ValidatorPerformance_deviation, value) {
+			return
+		}
+	}
+	if x.LastHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastHeight)
+		if !f(fd_ValidatorPerformance_last_height, value) {
+			return
+		}
+	}
+	if x.DroppedPrices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DroppedPrices)
+		if !f(fd_ValidatorPerformance_dropped_prices, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorPerformance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.validator":
+		return x.Validator != ""
+	case "connect.oracle.v2.ValidatorPerformance.window":
+		return x.Window != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.index_offset":
+		return x.IndexOffset != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.blocks":
+		return x.Blocks != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.missed_vote_extensions":
+		return x.MissedVoteExtensions != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.missing_prices":
+		return x.MissingPrices != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.reported_prices":
+		return x.ReportedPrices != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.deviation":
+		return x.Deviation != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.last_height":
+		return x.LastHeight != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.dropped_prices":
+		return x.DroppedPrices != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.validator":
+		x.Validator = ""
+	case "connect.oracle.v2.ValidatorPerformance.window":
+		x.Window = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.index_offset":
+		x.IndexOffset = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.blocks":
+		x.Blocks = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.missed_vote_extensions":
+		x.MissedVoteExtensions = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.missing_prices":
+		x.MissingPrices = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.reported_prices":
+		x.ReportedPrices = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.deviation":
+		x.Deviation = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.last_height":
+		x.LastHeight = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.dropped_prices":
+		x.DroppedPrices = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorPerformance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "connect.oracle.v2.ValidatorPerformance.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.index_offset":
+		value := x.IndexOffset
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.blocks":
+		value := x.Blocks
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.missed_vote_extensions":
+		value := x.MissedVoteExtensions
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.missing_prices":
+		value := x.MissingPrices
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.reported_prices":
+		value := x.ReportedPrices
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.deviation":
+		value := x.Deviation
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.last_height":
+		value := x.LastHeight
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.dropped_prices":
+		value := x.DroppedPrices
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.validator":
+		x.Validator = value.Interface().(string)
+	case "connect.oracle.v2.ValidatorPerformance.window":
+		x.Window = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.index_offset":
+		x.IndexOffset = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.blocks":
+		x.Blocks = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.missed_vote_extensions":
+		x.MissedVoteExtensions = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.missing_prices":
+		x.MissingPrices = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.reported_prices":
+		x.ReportedPrices = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.deviation":
+		x.Deviation = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.last_height":
+		x.LastHeight = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.dropped_prices":
+		x.DroppedPrices = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.validator":
+		panic(fmt.Errorf("field validator of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.window":
+		panic(fmt.Errorf("field window of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.index_offset":
+		panic(fmt.Errorf("field index_offset of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.blocks":
+		panic(fmt.Errorf("field blocks of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.missed_vote_extensions":
+		panic(fmt.Errorf("field missed_vote_extensions of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.missing_prices":
+		panic(fmt.Errorf("field missing_prices of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.reported_prices":
+		panic(fmt.Errorf("field reported_prices of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.deviation":
+		panic(fmt.Errorf("field deviation of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.last_height":
+		panic(fmt.Errorf("field last_height of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.dropped_prices":
+		panic(fmt.Errorf("field dropped_prices of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorPerformance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.validator":
+		return protoreflect.ValueOfString("")
+	case "connect.oracle.v2.ValidatorPerformance.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.index_offset":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.missed_vote_extensions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.missing_prices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.reported_prices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.deviation":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.last_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.dropped_prices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorPerformance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.oracle.v2.ValidatorPerformance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorPerformance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorPerformance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorPerformance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if x.IndexOffset != 0 {
+			n += 1 + runtime.Sov(uint64(x.IndexOffset))
+		}
+		if x.Blocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.Blocks))
+		}
+		if x.MissedVoteExtensions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedVoteExtensions))
+		}
+		if x.MissingPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissingPrices))
+		}
+		if x.ReportedPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportedPrices))
+		}
+		if x.Deviation != 0 {
+			n += 1 + runtime.Sov(uint64(x.Deviation))
+		}
+		if x.LastHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastHeight))
+		}
+		if x.DroppedPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.DroppedPrices))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DroppedPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DroppedPrices))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.LastHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastHeight))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Deviation != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Deviation))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ReportedPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportedPrices))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MissingPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissingPrices))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MissedVoteExtensions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedVoteExtensions))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Blocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Blocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.IndexOffset != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IndexOffset))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+				}
+				x.IndexOffset = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IndexOffset |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				}
+				x.Blocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Blocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedVoteExtensions", wireType)
+				}
+				x.MissedVoteExtensions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedVoteExtensions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissingPrices", wireType)
+				}
+				x.MissingPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissingPrices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportedPrices", wireType)
+				}
+				x.ReportedPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportedPrices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+				}
+				x.Deviation = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Deviation |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				}
+				x.LastHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DroppedPrices", wireType)
+				}
+				x.DroppedPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DroppedPrices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: connect/oracle/v2/performance.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ValidatorReport is the oracle report of a validator for a single block, as
+// derived from the validator's vote extension.
+type ValidatorReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MissedVoteExtension is true if the validator signed the block but did not
+	// report any prices, i.e. its vote extension was empty or invalid. Absent
+	// validators are left to the x/slashing module's downtime handling.
+	MissedVoteExtension bool `protobuf:"varint,1,opt,name=missed_vote_extension,json=missedVoteExtension,proto3" json:"missed_vote_extension,omitempty"`
+	// MissingPrices is the number of currency pairs of enabled markets the
+	// validator did not report a price for, including the prices it dropped to
+	// fit its vote extension size budget. It is zero if the validator missed its
+	// vote extension.
+	MissingPrices uint64 `protobuf:"varint,2,opt,name=missing_prices,json=missingPrices,proto3" json:"missing_prices,omitempty"`
+	// ReportedPrices is the number of prices reported by the validator that were
+	// compared against the aggregate price of their currency pair.
+	ReportedPrices uint64 `protobuf:"varint,3,opt,name=reported_prices,json=reportedPrices,proto3" json:"reported_prices,omitempty"`
+	// Deviation is the sum of the deviations of the validator's reported prices
+	// from the aggregate prices, in basis points.
+	Deviation uint64 `protobuf:"varint,4,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	// DroppedPrices is the number of missing prices the validator did not report
+	// because they did not fit its vote extension size budget, as reported in its
+	// vote extension. The count is self-reported and cannot be verified, so these
+	// prices are still counted in MissingPrices.
+	DroppedPrices uint64 `protobuf:"varint,5,opt,name=dropped_prices,json=droppedPrices,proto3" json:"dropped_prices,omitempty"`
+}
+
+func (x *ValidatorReport) Reset() {
+	*x = ValidatorReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_oracle_v2_performance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorReport) ProtoMessage() {}
+
+// Deprecated: Use ValidatorReport.ProtoReflect.Descriptor instead.
+func (*ValidatorReport) Descriptor() ([]byte, []int) {
+	return file_connect_oracle_v2_performance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorReport) GetMissedVoteExtension() bool {
+	if x != nil {
+		return x.MissedVoteExtension
+	}
+	return false
+}
+
+func (x *ValidatorReport) GetMissingPrices() uint64 {
+	if x != nil {
+		return x.MissingPrices
+	}
+	return 0
+}
+
+func (x *ValidatorReport) GetReportedPrices() uint64 {
+	if x != nil {
+		return x.ReportedPrices
+	}
+	return 0
+}
+
+func (x *ValidatorReport) GetDeviation() uint64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *ValidatorReport) GetDroppedPrices() uint64 {
+	if x != nil {
+		return x.DroppedPrices
+	}
+	return 0
+}
+
+// ValidatorPerformance is the oracle performance of a validator over a sliding
+// window of blocks.
+type ValidatorPerformance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Window is the size of the sliding window in blocks, i.e. the
+	// performance_window param when the window was started.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// IndexOffset is the number of reports recorded since the window was
+	// started. The next report is recorded at IndexOffset modulo Window.
+	IndexOffset uint64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// Blocks is the number of blocks in the window that reports were recorded
+	// for.
+	Blocks uint64 `protobuf:"varint,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// MissedVoteExtensions is the number of blocks in the window in which the
+	// validator missed its vote extension.
+	MissedVoteExtensions uint64 `protobuf:"varint,5,opt,name=missed_vote_extensions,json=missedVoteExtensions,proto3" json:"missed_vote_extensions,omitempty"`
+	// MissingPrices is the number of prices the validator did not report in the
+	// window.
+	MissingPrices uint64 `protobuf:"varint,6,opt,name=missing_prices,json=missingPrices,proto3" json:"missing_prices,omitempty"`
+	// ReportedPrices is the number of prices reported by the validator in the
+	// window that were compared against an aggregate price.
+	ReportedPrices uint64 `protobuf:"varint,7,opt,name=reported_prices,json=reportedPrices,proto3" json:"reported_prices,omitempty"`
+	// Deviation is the sum of the deviations of the validator's reported prices
+	// in the window from the aggregate prices, in basis points.
+	Deviation uint64 `protobuf:"varint,8,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	// LastHeight is the height of the block the latest report was recorded in.
+	// If a validator is absent from the commit for a block, e.g. because it was
+	// jailed or left the active set, its window restarts with its next report.
+	LastHeight uint64 `protobuf:"varint,9,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// DroppedPrices is the number of prices the validator dropped from its vote
+	// extensions in the window to fit its vote extension size budget. These
+	// prices are also counted in MissingPrices.
+	DroppedPrices uint64 `protobuf:"varint,10,opt,name=dropped_prices,json=droppedPrices,proto3" json:"dropped_prices,omitempty"`
+}
+
+func (x *ValidatorPerformance) Reset() {
+	*x = ValidatorPerformance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_oracle_v2_performance_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPerformance) ProtoMessage() {}
+
+// Deprecated: Use ValidatorPerformance.ProtoReflect.Descriptor instead.
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return file_connect_oracle_v2_performance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorPerformance) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorPerformance) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetIndexOffset() uint64 {
+	if x != nil {
+		return x.IndexOffset
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetBlocks() uint64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetMissedVoteExtensions() uint64 {
+	if x != nil {
+		return x.MissedVoteExtensions
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetMissingPrices() uint64 {
+	if x != nil {
+		return x.MissingPrices
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetReportedPrices() uint64 {
+	if x != nil {
+		return x.ReportedPrices
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetDeviation() uint64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetLastHeight() uint64 {
+	if x != nil {
+		return x.LastHeight
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetDroppedPrices() uint64 {
+	if x != nil {
+		return x.DroppedPrices
+	}
+	return 0
+}
+
+var File_connect_oracle_v2_performance_proto protoreflect.FileDescriptor
+
+var file_connect_oracle_v2_performance_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x22, 0xda, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x15,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x0a, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0xf3, 0x02, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12,
+	0x34, 0x0a, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x42, 0xbc, 0x01, 0x0a, 0x15,
+	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x32, 0x42, 0x10, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
+	0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x3b, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa, 0x02, 0x11, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x32,
+	0xca, 0x02, 0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a, 0x3a,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_connect_oracle_v2_performance_proto_rawDescOnce sync.Once
+	file_connect_oracle_v2_performance_proto_rawDescData = file_connect_oracle_v2_performance_proto_rawDesc
+)
+
+func file_connect_oracle_v2_performance_proto_rawDescGZIP() []byte {
+	file_connect_oracle_v2_performance_proto_rawDescOnce.Do(func() {
+		file_connect_oracle_v2_performance_proto_rawDescData = protoimpl.X.CompressGZIP(file_connect_oracle_v2_performance_proto_rawDescData)
+	})
+	return file_connect_oracle_v2_performance_proto_rawDescData
+}
+
+var file_connect_oracle_v2_performance_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_connect_oracle_v2_performance_proto_goTypes = []interface{}{
+	(*ValidatorReport)(nil),      // 0: connect.oracle.v2.ValidatorReport
+	(*ValidatorPerformance)(nil), // 1: connect.oracle.v2.ValidatorPerformance
+}
+var file_connect_oracle_v2_performance_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_connect_oracle_v2_performance_proto_init() }
+func file_connect_oracle_v2_performance_proto_init() {
+	if File_connect_oracle_v2_performance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_connect_oracle_v2_performance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_oracle_v2_performance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPerformance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_connect_oracle_v2_performance_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_connect_oracle_v2_performance_proto_goTypes,
+		DependencyIndexes: file_connect_oracle_v2_performance_proto_depIdxs,
+		MessageInfos:      file_connect_oracle_v2_performance_proto_msgTypes,
+	}.Build()
+	File_connect_oracle_v2_performance_proto = out.File
+	file_connect_oracle_v2_performance_proto_rawDesc = nil
+	file_connect_oracle_v2_performance_proto_goTypes = nil
+	file_connect_oracle_v2_performance_proto_depIdxs = nil
+}
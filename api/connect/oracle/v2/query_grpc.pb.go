@@ -24,6 +24,9 @@ const (
 	Query_GetPrices_FullMethodName                  = "/connect.oracle.v2.Query/GetPrices"
 	Query_GetCurrencyPairMapping_FullMethodName     = "/connect.oracle.v2.Query/GetCurrencyPairMapping"
 	Query_GetCurrencyPairMappingList_FullMethodName = "/connect.oracle.v2.Query/GetCurrencyPairMappingList"
+	Query_GetParams_FullMethodName                  = "/connect.oracle.v2.Query/GetParams"
+	Query_GetValidatorPerformance_FullMethodName    = "/connect.oracle.v2.Query/GetValidatorPerformance"
+	Query_GetAllValidatorPerformance_FullMethodName = "/connect.oracle.v2.Query/GetAllValidatorPerformance"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +49,14 @@ type QueryClient interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error)
+	// Get the parameters of the x/oracle module.
+	GetParams(ctx context.Context, in *GetParamsRequest, opts ...grpc.CallOption) (*GetParamsResponse, error)
+	// Get the oracle performance of a validator over the sliding window of
+	// blocks defined by the performance_window param.
+	GetValidatorPerformance(ctx context.Context, in *GetValidatorPerformanceRequest, opts ...grpc.CallOption) (*GetValidatorPerformanceResponse, error)
+	// Get the oracle performance of all validators over the sliding window of
+	// blocks defined by the performance_window param.
+	GetAllValidatorPerformance(ctx context.Context, in *GetAllValidatorPerformanceRequest, opts ...grpc.CallOption) (*GetAllValidatorPerformanceResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +117,36 @@ func (c *queryClient) GetCurrencyPairMappingList(ctx context.Context, in *GetCur
 	return out, nil
 }
 
+func (c *queryClient) GetParams(ctx context.Context, in *GetParamsRequest, opts ...grpc.CallOption) (*GetParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetParamsResponse)
+	err := c.cc.Invoke(ctx, Query_GetParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetValidatorPerformance(ctx context.Context, in *GetValidatorPerformanceRequest, opts ...grpc.CallOption) (*GetValidatorPerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_GetValidatorPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAllValidatorPerformance(ctx context.Context, in *GetAllValidatorPerformanceRequest, opts ...grpc.CallOption) (*GetAllValidatorPerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_GetAllValidatorPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -126,6 +167,14 @@ type QueryServer interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error)
+	// Get the parameters of the x/oracle module.
+	GetParams(context.Context, *GetParamsRequest) (*GetParamsResponse, error)
+	// Get the oracle performance of a validator over the sliding window of
+	// blocks defined by the performance_window param.
+	GetValidatorPerformance(context.Context, *GetValidatorPerformanceRequest) (*GetValidatorPerformanceResponse, error)
+	// Get the oracle performance of all validators over the sliding window of
+	// blocks defined by the performance_window param.
+	GetAllValidatorPerformance(context.Context, *GetAllValidatorPerformanceRequest) (*GetAllValidatorPerformanceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -151,6 +200,15 @@ func (UnimplementedQueryServer) GetCurrencyPairMapping(context.Context, *GetCurr
 func (UnimplementedQueryServer) GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMappingList not implemented")
 }
+func (UnimplementedQueryServer) GetParams(context.Context, *GetParamsRequest) (*GetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParams not implemented")
+}
+func (UnimplementedQueryServer) GetValidatorPerformance(context.Context, *GetValidatorPerformanceRequest) (*GetValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformance not implemented")
+}
+func (UnimplementedQueryServer) GetAllValidatorPerformance(context.Context, *GetAllValidatorPerformanceRequest) (*GetAllValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllValidatorPerformance not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -262,6 +320,60 @@ func _Query_GetCurrencyPairMappingList_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParams(ctx, req.(*GetParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetValidatorPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorPerformance(ctx, req.(*GetValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetAllValidatorPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllValidatorPerformance(ctx, req.(*GetAllValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +401,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyPairMappingList",
 			Handler:    _Query_GetCurrencyPairMappingList_Handler,
 		},
+		{
+			MethodName: "GetParams",
+			Handler:    _Query_GetParams_Handler,
+		},
+		{
+			MethodName: "GetValidatorPerformance",
+			Handler:    _Query_GetValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "GetAllValidatorPerformance",
+			Handler:    _Query_GetAllValidatorPerformance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/oracle/v2/query.proto",
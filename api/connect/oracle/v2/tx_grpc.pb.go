@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Msg_AddCurrencyPairs_FullMethodName    = "/connect.oracle.v2.Msg/AddCurrencyPairs"
 	Msg_RemoveCurrencyPairs_FullMethodName = "/connect.oracle.v2.Msg/RemoveCurrencyPairs"
+	Msg_UpdateParams_FullMethodName        = "/connect.oracle.v2.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +38,9 @@ type MsgClient interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams will be used explicitly by governance to update the x/oracle
+	// module's parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -67,6 +71,16 @@ func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurren
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -81,6 +95,9 @@ type MsgServer interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams will be used explicitly by governance to update the x/oracle
+	// module's parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -97,6 +114,9 @@ func (UnimplementedMsgServer) AddCurrencyPairs(context.Context, *MsgAddCurrencyP
 func (UnimplementedMsgServer) RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
 }
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -154,6 +174,24 @@ func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -169,6 +207,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCurrencyPairs",
 			Handler:    _Msg_RemoveCurrencyPairs_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/oracle/v2/tx.proto",
@@ -30,6 +30,10 @@ const (
 	DefaultHost = "0.0.0.0"
 	// DefaultPort is the default for the connect oracle server port.
 	DefaultPort = "8080"
+	// DefaultReadinessMaxSyncAge is the default value for the oldest price update for which the connect oracle server is ready.
+	DefaultReadinessMaxSyncAge = 10000000000
+	// DefaultReadinessMinPricedFraction is the default value for the fraction of enabled markets that must be priced for the connect oracle server to be ready.
+	DefaultReadinessMinPricedFraction = 0.5
 	// jsonFieldDelimiter is the delimiter used to separate fields in the JSON output.
 	jsonFieldDelimiter = "."
 	// ConnectConfigEnvironmentPrefix is the prefix for environment variables that override the connect config.
@@ -52,8 +56,12 @@ func DefaultOracleConfig() config.OracleConfig {
 			},
 		},
 		Providers: make(map[string]config.ProviderConfig),
-		Host:      DefaultHost,
-		Port:      DefaultPort,
+		Readiness: config.ReadinessConfig{
+			MaxSyncAge:        DefaultReadinessMaxSyncAge,
+			MinPricedFraction: DefaultReadinessMinPricedFraction,
+		},
+		Host: DefaultHost,
+		Port: DefaultPort,
 	}
 
 	for _, provider := range append(constants.Providers, constants.AlternativeMarketMapProviders...) {
@@ -62,8 +62,12 @@ var (
 	flagPort                     = "port"
 	flagUpdateInterval           = "update-interval"
 	flagMaxPriceAge              = "max-price-age"
+	flagAggregationMode          = "aggregation-mode"
+	flagTWAPWindow               = "twap-window"
+	flagSnapshotPath             = "snapshot-path"
 	flagMode                     = "mode"
 	flagValidationPeriod         = "validation-period"
+	flagConfigWatchInterval      = "config-watch-interval"
 
 	// flag-bound values.
 	oracleCfgPath       string
@@ -83,10 +87,14 @@ var (
 	disableRotatingLogs bool
 	mode                string
 	validationPeriod    time.Duration
+	configWatchInterval time.Duration
 )
 
 const (
 	DefaultLegacyConfigPath = "./oracle.json"
+	// DefaultConfigWatchInterval is the default interval at which the oracle config file is
+	// checked for changes.
+	DefaultConfigWatchInterval = 5 * time.Second
 )
 
 type runMode string
@@ -215,6 +223,12 @@ func init() {
 		validation.DefaultValidationPeriod,
 		"Duration to run in validation mode.  Note: this flag is only used if mode == \"validate\"",
 	)
+	rootCmd.Flags().DurationVar(
+		&configWatchInterval,
+		flagConfigWatchInterval,
+		DefaultConfigWatchInterval,
+		"Interval at which the oracle config file is checked for changes. Changes are hot-reloaded. Set to 0 to only reload on SIGHUP",
+	)
 
 	// these flags are connected to the OracleConfig.
 	rootCmd.Flags().Bool(
@@ -252,6 +266,21 @@ func init() {
 		cmdconfig.DefaultMaxPriceAge,
 		"Maximum age of a price that the oracle will consider valid",
 	)
+	rootCmd.Flags().String(
+		flagAggregationMode,
+		"",
+		"The method used to aggregate provider prices (median, twap). Defaults to median",
+	)
+	rootCmd.Flags().Duration(
+		flagTWAPWindow,
+		0,
+		"The rolling window over which the time-weighted average price is computed. Only used if the aggregation mode is twap",
+	)
+	rootCmd.Flags().String(
+		flagSnapshotPath,
+		"",
+		"Path to the file the oracle persists its state to, so that it can warm start after a restart. Snapshots are disabled if empty",
+	)
 	// bind them to viper.
 	err := errors.Join(
 		viper.BindPFlag("host", rootCmd.Flags().Lookup(flagHost)),
@@ -260,6 +289,9 @@ func init() {
 		viper.BindPFlag("metrics.prometheusServerAddress", rootCmd.Flags().Lookup(flagMetricsPrometheusAddress)),
 		viper.BindPFlag("maxPriceAge", rootCmd.Flags().Lookup(flagMaxPriceAge)),
 		viper.BindPFlag("updateInterval", rootCmd.Flags().Lookup(flagUpdateInterval)),
+		viper.BindPFlag("aggregation.mode", rootCmd.Flags().Lookup(flagAggregationMode)),
+		viper.BindPFlag("aggregation.twap.window", rootCmd.Flags().Lookup(flagTWAPWindow)),
+		viper.BindPFlag("snapshot.path", rootCmd.Flags().Lookup(flagSnapshotPath)),
 	)
 	if err != nil {
 		panic(fmt.Sprintf("failed to bind flags: %v", err))
@@ -302,28 +334,9 @@ func runOracle() error {
 	logger := log.NewLogger(logCfg)
 	defer logger.Sync()
 
-	var cfg config.OracleConfig
-	var err error
-
-	cfg, err = cmdconfig.ReadOracleConfigWithOverrides(oracleCfgPath, marketMapProvider)
+	cfg, err := readOracleConfig()
 	if err != nil {
-		return fmt.Errorf("failed to get oracle config: %w", err)
-	}
-
-	// overwrite endpoint
-	if marketMapEndPoint != "" {
-		cfg, err = overwriteMarketMapEndpoint(cfg, marketMapEndPoint)
-		if err != nil {
-			return fmt.Errorf("failed to overwrite market endpoint %s: %w", marketMapEndPoint, err)
-		}
-	}
-
-	// check that the marketmap endpoint they provided is correct.
-	if marketMapProvider == marketmap.Name {
-		mmEndpoint := cfg.Providers[marketMapProvider].API.Endpoints[0].URL
-		if err := isValidGRPCEndpoint(mmEndpoint); err != nil {
-			return err
-		}
+		return err
 	}
 
 	var marketCfg mmtypes.MarketMap
@@ -355,6 +368,8 @@ func runOracle() error {
 		logger,
 		marketCfg,
 		metrics,
+		oraclemath.WithAggregationConfig(cfg.Aggregation),
+		oraclemath.WithMaxPriceAge(cfg.MaxPriceAge),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create data aggregator: %w", err)
@@ -389,7 +404,22 @@ func runOracle() error {
 	}()
 	defer orc.Stop()
 
-	srv := oracleserver.NewOracleServer(orc, logger)
+	// hot-reload the oracle config when the config file changes or on SIGHUP.
+	if oracleCfgPath != "" {
+		sighup := make(chan os.Signal, 1)
+		signal.Notify(sighup, syscall.SIGHUP)
+		defer signal.Stop(sighup)
+
+		reloader := newConfigReloader(logger, oracleCfgPath, configWatchInterval, readOracleConfig, orc.UpdateConfig)
+		go reloader.run(ctx, sighup)
+	}
+
+	srv := oracleserver.NewOracleServer(
+		orc,
+		logger,
+		oracleserver.WithReadinessConfig(cfg.Readiness),
+		oracleserver.WithTLSConfig(cfg.TLS),
+	)
 
 	// cancel oracle on interrupt or terminate
 	go func() {
@@ -456,6 +486,33 @@ func runOracle() error {
 	return nil
 }
 
+// readOracleConfig reads the oracle config from the config file and applies the overrides set
+// via the environment and flags.
+func readOracleConfig() (config.OracleConfig, error) {
+	cfg, err := cmdconfig.ReadOracleConfigWithOverrides(oracleCfgPath, marketMapProvider)
+	if err != nil {
+		return config.OracleConfig{}, fmt.Errorf("failed to get oracle config: %w", err)
+	}
+
+	// overwrite endpoint
+	if marketMapEndPoint != "" {
+		cfg, err = overwriteMarketMapEndpoint(cfg, marketMapEndPoint)
+		if err != nil {
+			return config.OracleConfig{}, fmt.Errorf("failed to overwrite market endpoint %s: %w", marketMapEndPoint, err)
+		}
+	}
+
+	// check that the marketmap endpoint they provided is correct.
+	if marketMapProvider == marketmap.Name {
+		mmEndpoint := cfg.Providers[marketMapProvider].API.Endpoints[0].URL
+		if err := isValidGRPCEndpoint(mmEndpoint); err != nil {
+			return config.OracleConfig{}, err
+		}
+	}
+
+	return cfg, nil
+}
+
 func overwriteMarketMapEndpoint(cfg config.OracleConfig, overwrite string) (config.OracleConfig, error) {
 	for providerName, provider := range cfg.Providers {
 		if provider.Type == mmservicetypes.ConfigType {
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+// configReloader reloads the oracle config whenever the config file changes or the sidecar
+// receives a SIGHUP, and applies the reloaded config to the oracle.
+type configReloader struct {
+	logger *zap.Logger
+	// path is the path to the oracle config file.
+	path string
+	// interval is the interval at which the config file is checked for changes. The config file
+	// is not watched if the interval is zero.
+	interval time.Duration
+	// load reads the oracle config, including any overrides.
+	load func() (config.OracleConfig, error)
+	// apply applies the oracle config to the running oracle.
+	apply func(config.OracleConfig) error
+	// digest is the digest of the last config file that was read.
+	digest []byte
+}
+
+// newConfigReloader returns a new config reloader for the config file at the given path.
+func newConfigReloader(
+	logger *zap.Logger,
+	path string,
+	interval time.Duration,
+	load func() (config.OracleConfig, error),
+	apply func(config.OracleConfig) error,
+) *configReloader {
+	r := &configReloader{
+		logger:   logger.With(zap.String("oracle_config_path", path)),
+		path:     path,
+		interval: interval,
+		load:     load,
+		apply:    apply,
+	}
+
+	r.digest, _ = r.fileDigest()
+	return r
+}
+
+// run reloads the oracle config until the context is cancelled. The config is reloaded on every
+// signal received on the given channel, and whenever the contents of the config file change.
+func (r *configReloader) run(ctx context.Context, sighup <-chan os.Signal) {
+	var tick <-chan time.Time
+	if r.interval > 0 {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sighup:
+			r.logger.Info("received SIGHUP; reloading oracle config")
+			r.digest, _ = r.fileDigest()
+			r.reload()
+		case <-tick:
+			digest, err := r.fileDigest()
+			if err != nil {
+				// The file may be in the middle of being replaced, so the next tick tries again.
+				r.logger.Debug("failed to read oracle config file", zap.Error(err))
+				continue
+			}
+
+			if bytes.Equal(digest, r.digest) {
+				continue
+			}
+
+			r.logger.Info("oracle config file changed; reloading oracle config")
+			r.digest = digest
+			r.reload()
+		}
+	}
+}
+
+// reload loads the oracle config and applies it to the oracle. A config that fails to load or
+// is rejected by the oracle leaves the running config untouched.
+func (r *configReloader) reload() {
+	cfg, err := r.load()
+	if err != nil {
+		r.logger.Error("rejected oracle config reload; failed to load config, keeping the running config", zap.Error(err))
+		return
+	}
+
+	if err := r.apply(cfg); err != nil {
+		r.logger.Error("rejected oracle config reload; keeping the running config", zap.Error(err))
+		return
+	}
+
+	r.logger.Info("reloaded oracle config")
+}
+
+// fileDigest returns the digest of the contents of the config file.
+func (r *configReloader) fileDigest() ([]byte, error) {
+	bz, err := os.ReadFile(r.path)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(bz)
+	return digest[:], nil
+}
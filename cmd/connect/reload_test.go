@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+func TestConfigReloader(t *testing.T) {
+	testCases := []struct {
+		name string
+		// update triggers a reload by either writing the config file or sending a SIGHUP.
+		update  func(t *testing.T, path string, sighup chan os.Signal)
+		loadErr error
+		applied bool
+	}{
+		{
+			name:    "reloads when the config file changes",
+			update:  func(t *testing.T, path string, _ chan os.Signal) { writeFile(t, path, "updated") },
+			applied: true,
+		},
+		{
+			name:    "reloads on SIGHUP",
+			update:  func(_ *testing.T, _ string, sighup chan os.Signal) { sighup <- syscall.SIGHUP },
+			applied: true,
+		},
+		{
+			name:    "does not reload if the config file is rewritten with the same contents",
+			update:  func(t *testing.T, path string, _ chan os.Signal) { writeFile(t, path, "initial") },
+			applied: false,
+		},
+		{
+			name:    "does not apply a config that fails to load",
+			update:  func(t *testing.T, path string, _ chan os.Signal) { writeFile(t, path, "updated") },
+			loadErr: errors.New("invalid config"),
+			applied: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "oracle.json")
+			writeFile(t, path, "initial")
+
+			var (
+				mu      sync.Mutex
+				applied []config.OracleConfig
+			)
+			reloader := newConfigReloader(
+				zap.NewNop(),
+				path,
+				10*time.Millisecond,
+				func() (config.OracleConfig, error) {
+					return config.OracleConfig{Host: "reloaded"}, tc.loadErr
+				},
+				func(cfg config.OracleConfig) error {
+					mu.Lock()
+					defer mu.Unlock()
+
+					applied = append(applied, cfg)
+					return nil
+				},
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			sighup := make(chan os.Signal, 1)
+			go reloader.run(ctx, sighup)
+
+			tc.update(t, path, sighup)
+			time.Sleep(100 * time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if !tc.applied {
+				require.Empty(t, applied)
+				return
+			}
+
+			require.Equal(t, []config.OracleConfig{{Host: "reloaded"}}, applied)
+		})
+	}
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+}
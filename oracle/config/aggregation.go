@@ -0,0 +1,288 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// AggregationMode is the method used by the oracle to aggregate provider prices into the
+// prices that are served to consumers.
+type AggregationMode string
+
+const (
+	// AggregationModeMedian serves the median of the most recent converted prices. This is
+	// the default aggregation mode.
+	AggregationModeMedian AggregationMode = "median"
+	// AggregationModeTWAP serves a time-weighted average of the median prices computed over
+	// a rolling window.
+	AggregationModeTWAP AggregationMode = "twap"
+)
+
+// OutlierFilterMethod is the statistical method used to reject outlier prices before
+// the aggregated price is computed.
+type OutlierFilterMethod string
+
+const (
+	// OutlierFilterNone disables outlier filtering.
+	OutlierFilterNone OutlierFilterMethod = ""
+	// OutlierFilterMAD rejects prices whose modified z-score, computed using the median
+	// absolute deviation (MAD), is greater than the threshold.
+	OutlierFilterMAD OutlierFilterMethod = "mad"
+	// OutlierFilterZScore rejects prices whose z-score, computed using the mean and
+	// standard deviation of all prices, is greater than the threshold.
+	OutlierFilterZScore OutlierFilterMethod = "zscore"
+	// OutlierFilterPercent rejects prices that deviate from the median by more than
+	// threshold percent.
+	OutlierFilterPercent OutlierFilterMethod = "percent"
+)
+
+// AggregationConfig is the configuration for how the oracle aggregates the prices
+// reported by each provider into a single price per ticker.
+type AggregationConfig struct {
+	// Mode is the aggregation mode used by the oracle. Supported modes are "median" and
+	// "twap". An empty mode defaults to "median".
+	Mode AggregationMode `json:"mode"`
+
+	// TWAP is the configuration for the "twap" aggregation mode.
+	TWAP TWAPConfig `json:"twap"`
+
+	// WeightedMedian enables weighting each converted price when computing the median. The
+	// weight of a price is the static weight configured in the provider config's metadata,
+	// or otherwise the weight reported by the provider (e.g. 24h quote volume or pool
+	// liquidity). If any price of a ticker has no weight, the unweighted median is used.
+	WeightedMedian bool `json:"weightedMedian"`
+
+	// OutlierFilter is the default outlier filter applied to the converted prices of every
+	// ticker. This can be overridden per ticker via the ticker's metadata.
+	OutlierFilter OutlierFilterConfig `json:"outlierFilter"`
+
+	// StalenessDecay decays the influence of each converted price on the median based on the
+	// age of the provider's price. If enabled, the weighted median is always used.
+	StalenessDecay StalenessDecayConfig `json:"stalenessDecay"`
+
+	// Smoothing applies exponential smoothing to the median price of every ticker.
+	Smoothing SmoothingConfig `json:"smoothing"`
+
+	// Health tracks the health of the price of every (provider, ticker) pair and quarantines
+	// the pairs that are persistently unhealthy.
+	Health HealthConfig `json:"health"`
+
+	// History retains the recent provider and aggregated prices of every ticker in memory so
+	// that they can be queried after the fact.
+	History HistoryConfig `json:"history"`
+}
+
+// TWAPConfig is the configuration for the time-weighted average price aggregation mode.
+type TWAPConfig struct {
+	// Window is the duration of the rolling window over which the time-weighted average
+	// price is computed.
+	Window time.Duration `json:"window"`
+}
+
+// MaxStalenessDecayHalfLives is the maximum number of half-lives that fit in the oracle's max
+// price age. The weight of a price that is older than this many half-lives is too small to be
+// meaningful, and eventually underflows to zero.
+const MaxStalenessDecayHalfLives = 64
+
+// StalenessDecayConfig configures how the weight of a converted price decays with the age of
+// the provider's price. A price that is HalfLife old has half the weight of a fresh price.
+type StalenessDecayConfig struct {
+	// HalfLife is the age at which a price's weight is halved. Zero disables the decay. The
+	// oracle's max price age may be at most MaxStalenessDecayHalfLives half-lives.
+	HalfLife time.Duration `json:"halfLife"`
+}
+
+// Enabled returns true iff the staleness decay is enabled.
+func (c StalenessDecayConfig) Enabled() bool {
+	return c.HalfLife > 0
+}
+
+// SmoothingConfig configures the exponential moving average (EMA) that is applied to the
+// median price of every ticker, i.e. ema = alpha * price + (1 - alpha) * ema.
+type SmoothingConfig struct {
+	// Alpha is the smoothing factor in (0, 1]. Smaller values smooth more and an alpha of 1
+	// disables smoothing. Zero disables smoothing.
+	Alpha float64 `json:"alpha"`
+}
+
+// Enabled returns true iff smoothing is enabled.
+func (c SmoothingConfig) Enabled() bool {
+	return c.Alpha > 0 && c.Alpha < 1
+}
+
+// HealthConfig configures the health tracking of every (provider, ticker) pair. Every
+// aggregation round, the price of each pair is sampled as either healthy or unhealthy. A price
+// is unhealthy if the provider did not report it (an error), if it is older than MaxStaleness,
+// or if it deviates from the ticker's index price by more than MaxDeviation. The health score of
+// a pair is the fraction of healthy samples over the last Window rounds.
+//
+// A pair whose score drops below QuarantineThreshold is quarantined, meaning its prices are
+// excluded from aggregation, and is readmitted once its score recovers to ReadmitThreshold.
+type HealthConfig struct {
+	// Window is the number of aggregation rounds over which the health score is computed. Zero
+	// disables health tracking.
+	Window int `json:"window"`
+
+	// MaxStaleness is the age above which a price is considered stale. Zero disables the
+	// staleness check.
+	MaxStaleness time.Duration `json:"maxStaleness"`
+
+	// MaxDeviation is the relative deviation from the index price above which a price is
+	// considered deviant, e.g. 0.05 for 5%. Zero disables the deviation check.
+	MaxDeviation float64 `json:"maxDeviation"`
+
+	// QuarantineThreshold is the health score in [0, 1] below which a pair is quarantined.
+	QuarantineThreshold float64 `json:"quarantineThreshold"`
+
+	// ReadmitThreshold is the health score in [0, 1] at or above which a quarantined pair is
+	// readmitted. This must be at least the quarantine threshold.
+	ReadmitThreshold float64 `json:"readmitThreshold"`
+}
+
+// Enabled returns true iff health tracking is enabled.
+func (c HealthConfig) Enabled() bool {
+	return c.Window > 0
+}
+
+// ValidateBasic performs basic validation of the health config.
+func (c *HealthConfig) ValidateBasic() error {
+	if c.Window < 0 {
+		return fmt.Errorf("health window must be non-negative; got %d", c.Window)
+	}
+
+	if !c.Enabled() {
+		return nil
+	}
+
+	if c.MaxStaleness < 0 {
+		return fmt.Errorf("health max staleness must be non-negative; got %s", c.MaxStaleness)
+	}
+
+	if c.MaxDeviation < 0 {
+		return fmt.Errorf("health max deviation must be non-negative; got %f", c.MaxDeviation)
+	}
+
+	if c.QuarantineThreshold < 0 || c.QuarantineThreshold > 1 {
+		return fmt.Errorf("health quarantine threshold must be between 0 and 1; got %f", c.QuarantineThreshold)
+	}
+
+	if c.ReadmitThreshold < c.QuarantineThreshold || c.ReadmitThreshold > 1 {
+		return fmt.Errorf(
+			"health readmit threshold must be between the quarantine threshold (%f) and 1; got %f",
+			c.QuarantineThreshold,
+			c.ReadmitThreshold,
+		)
+	}
+
+	return nil
+}
+
+// HistoryConfig configures the in-memory price history of every ticker. Every aggregation
+// round, the aggregated price of each ticker and the converted price of each of its providers
+// are recorded. Records older than Retention are dropped, and at most MaxRecords records are
+// retained per ticker, so the memory used by the history is bounded.
+type HistoryConfig struct {
+	// Retention is how long a record is retained. Zero disables the price history.
+	Retention time.Duration `json:"retention"`
+
+	// MaxRecords is the maximum number of records retained per ticker. This must be positive
+	// if the price history is enabled.
+	MaxRecords int `json:"maxRecords"`
+}
+
+// Enabled returns true iff the price history is enabled.
+func (c HistoryConfig) Enabled() bool {
+	return c.Retention > 0
+}
+
+// ValidateBasic performs basic validation of the history config.
+func (c *HistoryConfig) ValidateBasic() error {
+	if c.Retention < 0 {
+		return fmt.Errorf("history retention must be non-negative; got %s", c.Retention)
+	}
+
+	if c.Enabled() && c.MaxRecords <= 0 {
+		return fmt.Errorf("history max records must be greater than 0; got %d", c.MaxRecords)
+	}
+
+	return nil
+}
+
+// OutlierFilterConfig configures the outlier filter that is run on the set of converted
+// prices before they are aggregated.
+type OutlierFilterConfig struct {
+	// Method is the outlier filtering method. Supported methods are "mad", "zscore" and
+	// "percent". An empty method disables outlier filtering.
+	Method OutlierFilterMethod `json:"method"`
+
+	// Threshold is the rejection threshold for the configured method. For "mad" and
+	// "zscore" this is the maximum (modified) z-score that a price may have. For "percent"
+	// this is the maximum percent a price may deviate from the median.
+	Threshold float64 `json:"threshold"`
+}
+
+// Enabled returns true iff the outlier filter is enabled.
+func (c OutlierFilterConfig) Enabled() bool {
+	return c.Method != OutlierFilterNone
+}
+
+// ValidateBasic performs basic validation of the aggregation config.
+func (c *AggregationConfig) ValidateBasic() error {
+	switch c.Mode {
+	case "", AggregationModeMedian:
+	case AggregationModeTWAP:
+		if err := c.TWAP.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid twap config: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown aggregation mode %q", c.Mode)
+	}
+
+	if err := c.OutlierFilter.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid outlier filter: %w", err)
+	}
+
+	if c.StalenessDecay.HalfLife < 0 {
+		return fmt.Errorf("staleness decay half life must be non-negative; got %s", c.StalenessDecay.HalfLife)
+	}
+
+	if c.Smoothing.Alpha < 0 || c.Smoothing.Alpha > 1 {
+		return fmt.Errorf("smoothing alpha must be between 0 and 1; got %f", c.Smoothing.Alpha)
+	}
+
+	if err := c.Health.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid health config: %w", err)
+	}
+
+	if err := c.History.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid history config: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the twap config.
+func (c *TWAPConfig) ValidateBasic() error {
+	if c.Window <= 0 {
+		return fmt.Errorf("twap window must be greater than 0; got %s", c.Window)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the outlier filter config.
+func (c *OutlierFilterConfig) ValidateBasic() error {
+	switch c.Method {
+	case OutlierFilterNone:
+		return nil
+	case OutlierFilterMAD, OutlierFilterZScore, OutlierFilterPercent:
+	default:
+		return fmt.Errorf("unknown outlier filter method %q", c.Method)
+	}
+
+	if c.Threshold <= 0 {
+		return fmt.Errorf("outlier filter threshold must be greater than 0; got %f", c.Threshold)
+	}
+
+	return nil
+}
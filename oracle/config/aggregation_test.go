@@ -0,0 +1,236 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+func TestAggregationConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.AggregationConfig
+		expectedErr bool
+	}{
+		{
+			name:        "empty config",
+			config:      config.AggregationConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "median mode",
+			config: config.AggregationConfig{
+				Mode: config.AggregationModeMedian,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "twap mode",
+			config: config.AggregationConfig{
+				Mode: config.AggregationModeTWAP,
+				TWAP: config.TWAPConfig{
+					Window: time.Minute,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "twap mode with no window",
+			config: config.AggregationConfig{
+				Mode: config.AggregationModeTWAP,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unknown mode",
+			config: config.AggregationConfig{
+				Mode: "vwap",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid mad outlier filter",
+			config: config.AggregationConfig{
+				OutlierFilter: config.OutlierFilterConfig{
+					Method:    config.OutlierFilterMAD,
+					Threshold: 3.5,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "valid zscore outlier filter",
+			config: config.AggregationConfig{
+				OutlierFilter: config.OutlierFilterConfig{
+					Method:    config.OutlierFilterZScore,
+					Threshold: 2,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "valid percent outlier filter",
+			config: config.AggregationConfig{
+				OutlierFilter: config.OutlierFilterConfig{
+					Method:    config.OutlierFilterPercent,
+					Threshold: 5,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "unknown outlier filter method",
+			config: config.AggregationConfig{
+				OutlierFilter: config.OutlierFilterConfig{
+					Method:    "unknown",
+					Threshold: 5,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "outlier filter with no threshold",
+			config: config.AggregationConfig{
+				OutlierFilter: config.OutlierFilterConfig{
+					Method: config.OutlierFilterMAD,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "outlier filter with negative threshold",
+			config: config.AggregationConfig{
+				OutlierFilter: config.OutlierFilterConfig{
+					Method:    config.OutlierFilterPercent,
+					Threshold: -1,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid staleness decay and smoothing",
+			config: config.AggregationConfig{
+				StalenessDecay: config.StalenessDecayConfig{
+					HalfLife: 5 * time.Second,
+				},
+				Smoothing: config.SmoothingConfig{
+					Alpha: 0.3,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "negative staleness decay half life",
+			config: config.AggregationConfig{
+				StalenessDecay: config.StalenessDecayConfig{
+					HalfLife: -time.Second,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "smoothing alpha greater than 1",
+			config: config.AggregationConfig{
+				Smoothing: config.SmoothingConfig{
+					Alpha: 1.5,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative smoothing alpha",
+			config: config.AggregationConfig{
+				Smoothing: config.SmoothingConfig{
+					Alpha: -0.1,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid health config",
+			config: config.AggregationConfig{
+				Health: config.HealthConfig{
+					Window:              20,
+					MaxStaleness:        10 * time.Second,
+					MaxDeviation:        0.05,
+					QuarantineThreshold: 0.5,
+					ReadmitThreshold:    0.8,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "negative health window",
+			config: config.AggregationConfig{
+				Health: config.HealthConfig{
+					Window: -1,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "health quarantine threshold greater than 1",
+			config: config.AggregationConfig{
+				Health: config.HealthConfig{
+					Window:              20,
+					QuarantineThreshold: 1.5,
+					ReadmitThreshold:    1.5,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "health readmit threshold below the quarantine threshold",
+			config: config.AggregationConfig{
+				Health: config.HealthConfig{
+					Window:              20,
+					QuarantineThreshold: 0.8,
+					ReadmitThreshold:    0.5,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid history config",
+			config: config.AggregationConfig{
+				History: config.HistoryConfig{
+					Retention:  10 * time.Minute,
+					MaxRecords: 1000,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "negative history retention",
+			config: config.AggregationConfig{
+				History: config.HistoryConfig{
+					Retention: -time.Minute,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "history enabled without max records",
+			config: config.AggregationConfig{
+				History: config.HistoryConfig{
+					Retention: 10 * time.Minute,
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
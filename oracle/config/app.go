@@ -20,6 +20,16 @@ var (
 	MaxPriceTTL = 1 * time.Minute
 )
 
+const (
+	// SidecarModeFailover queries the oracle sidecars in order, and fails over to the next
+	// sidecar when a sidecar errors or returns stale prices.
+	SidecarModeFailover = "failover"
+
+	// SidecarModeMedian queries every oracle sidecar, and returns the median price of each
+	// ticker across the sidecars that returned fresh prices.
+	SidecarModeMedian = "median"
+)
+
 const (
 	// DefaultConfigTemplate should be utilized in the app.toml file.
 	// This template configures the application to connect to the
@@ -41,6 +51,17 @@ enabled = "{{ .Oracle.Enabled }}"
 # machine or a remote machine.
 oracle_address = "{{ .Oracle.OracleAddress }}"
 
+# Fallback Addresses are the URLs of additional oracle sidecars, in order of preference
+# after the oracle address. If the preferred sidecar fails or returns prices older than the
+# price ttl, the application fails over to the next sidecar.
+fallback_addresses = [{{ range $i, $addr := .Oracle.FallbackAddresses }}{{ if $i }}, {{ end }}"{{ $addr }}"{{ end }}]
+
+# Sidecar Mode determines how the oracle sidecars are queried when fallback addresses are
+# configured. In "failover" mode, the first healthy sidecar is queried. In "median" mode,
+# every sidecar is queried and the median price of each ticker is used. If this is empty,
+# the "failover" mode is used.
+sidecar_mode = "{{ .Oracle.SidecarMode }}"
+
 # Client Timeout is the time that the client is willing to wait for responses from 
 # the oracle before timing out. The recommended timeout is 3 seconds (3000ms).
 client_timeout = "{{ .Oracle.ClientTimeout }}"
@@ -51,14 +72,49 @@ client_timeout = "{{ .Oracle.ClientTimeout }}"
 metrics_enabled = "{{ .Oracle.MetricsEnabled }}"
 
 # PriceTTL is the maximum age of the latest price response before it is considered stale. 
-# The recommended max age is 10 seconds (10s). If this is greater than 1 minute (1m), the app
-# will not start.
+# Prices that the oracle last updated longer than this ago are also considered stale, and
+# are not included in vote extensions. The recommended max age is 10 seconds (10s). If this
+# is greater than 1 minute (1m), the app will not start.
 price_ttl = "{{ .Oracle.PriceTTL }}"
 
 # Interval is the time between each price update request. The recommended interval
 # is the block time of the chain. Otherwise, 1.5 seconds (1500ms) is a good default. If this
 # is greater than 1 minute (1m), the app will not start.
 interval = "{{ .Oracle.Interval }}"
+
+# TLS Enabled indicates whether the connection to the oracle sidecar uses TLS. This must
+# be enabled if the sidecar is configured with a TLS certificate.
+tls_enabled = "{{ .Oracle.TLS.Enabled }}"
+
+# TLS CA File is the path to the PEM encoded CAs used to verify the certificate of the
+# oracle sidecar. If this is empty, the system's CAs are used.
+tls_ca_file = "{{ .Oracle.TLS.CAFile }}"
+
+# TLS Cert File and TLS Key File are the paths to the PEM encoded client certificate and
+# key presented to the oracle sidecar when it requires mutual TLS.
+tls_cert_file = "{{ .Oracle.TLS.CertFile }}"
+tls_key_file = "{{ .Oracle.TLS.KeyFile }}"
+
+# TLS Server Name overrides the name used to verify the certificate of the oracle sidecar.
+# If this is empty, the host of the oracle address is used.
+tls_server_name = "{{ .Oracle.TLS.ServerName }}"
+
+# TLS Reload Interval is the minimum time between checks for changes to the client
+# certificate and key files, which are reloaded without restarting the application. If
+# this is zero, the files are never reloaded.
+tls_reload_interval = "{{ .Oracle.TLS.ReloadInterval }}"
+
+# Max Vote Extension Size is the maximum size of a vote extension in bytes. If a vote
+# extension exceeds this size, the prices of critical markets (as set by governance) are
+# included first, followed by the prices that moved by at least the price movement
+# threshold, followed by the remaining prices in round-robin order. If this is zero, the
+# size of vote extensions is not limited.
+max_vote_extension_size = "{{ .Oracle.MaxVoteExtensionSize }}"
+
+# Price Movement Threshold is the minimum movement of a price since the latest on-chain
+# price, in basis points, for it to be prioritised when a vote extension exceeds the max
+# vote extension size. If this is zero, prices are not prioritised by their movement.
+price_movement_threshold = "{{ .Oracle.PriceMovementThreshold }}"
 `
 )
 
@@ -77,11 +133,21 @@ func NewDefaultAppConfig() AppConfig {
 const (
 	flagEnabled                 = "oracle.enabled"
 	flagOracleAddress           = "oracle.oracle_address"
+	flagFallbackAddresses       = "oracle.fallback_addresses"
+	flagSidecarMode             = "oracle.sidecar_mode"
 	flagClientTimeout           = "oracle.client_timeout"
 	flagMetricsEnabled          = "oracle.metrics_enabled"
 	flagPrometheusServerAddress = "oracle.prometheus_server_address"
 	flagPriceTTL                = "oracle.price_ttl"
 	flagInterval                = "oracle.interval"
+	flagTLSEnabled              = "oracle.tls_enabled"
+	flagTLSCAFile               = "oracle.tls_ca_file"
+	flagTLSCertFile             = "oracle.tls_cert_file"
+	flagTLSKeyFile              = "oracle.tls_key_file"
+	flagTLSServerName           = "oracle.tls_server_name"
+	flagTLSReloadInterval       = "oracle.tls_reload_interval"
+	flagMaxVoteExtensionSize    = "oracle.max_vote_extension_size"
+	flagPriceMovementThreshold  = "oracle.price_movement_threshold"
 )
 
 // AppConfig contains the application side oracle configurations that must
@@ -94,6 +160,15 @@ type AppConfig struct {
 	// used to connect to the oracle sidecar.
 	OracleAddress string `mapstructure:"oracle_address" toml:"oracle_address"`
 
+	// FallbackAddresses are the URLs of additional oracle sidecars, in order of
+	// preference after the OracleAddress.
+	FallbackAddresses []string `mapstructure:"fallback_addresses" toml:"fallback_addresses"`
+
+	// SidecarMode determines how the oracle sidecars are queried when fallback
+	// addresses are configured. This is either SidecarModeFailover or SidecarModeMedian.
+	// If this is empty, SidecarModeFailover is used.
+	SidecarMode string `mapstructure:"sidecar_mode" toml:"sidecar_mode"`
+
 	// ClientTimeout is the time that the client is willing to wait for responses
 	// from the oracle before timing out.
 	ClientTimeout time.Duration `mapstructure:"client_timeout" toml:"client_timeout"`
@@ -102,11 +177,23 @@ type AppConfig struct {
 	MetricsEnabled bool `mapstructure:"metrics_enabled" toml:"metrics_enabled"`
 
 	// PriceTTL is the maximum age of the latest price response before it is considered
-	// stale.
+	// stale. Prices that the oracle last updated longer than this ago are also stale.
 	PriceTTL time.Duration `mapstructure:"price_ttl" toml:"price_ttl"`
 
 	// Interval is the time between each price update request.
 	Interval time.Duration `mapstructure:"interval" toml:"interval"`
+
+	// TLS is the TLS configuration of the connection to the oracle sidecar.
+	TLS ClientTLSConfig `mapstructure:",squash"`
+
+	// MaxVoteExtensionSize is the maximum size of a vote extension in bytes. If this is
+	// zero, the size of vote extensions is not limited.
+	MaxVoteExtensionSize int `mapstructure:"max_vote_extension_size" toml:"max_vote_extension_size"`
+
+	// PriceMovementThreshold is the minimum movement of a price since the latest on-chain
+	// price, in basis points, for it to be prioritised when a vote extension exceeds the
+	// MaxVoteExtensionSize.
+	PriceMovementThreshold uint32 `mapstructure:"price_movement_threshold" toml:"price_movement_threshold"`
 }
 
 // ValidateBasic performs basic validation of the app config.
@@ -119,6 +206,23 @@ func (c *AppConfig) ValidateBasic() error {
 		return fmt.Errorf("poorly formatted app.toml (oracle subsection): oracle address must not be empty")
 	}
 
+	for _, addr := range c.FallbackAddresses {
+		if len(addr) == 0 {
+			return fmt.Errorf("poorly formatted app.toml (oracle subsection): oracle fallback addresses must not be empty")
+		}
+	}
+
+	switch c.SidecarMode {
+	case "", SidecarModeFailover, SidecarModeMedian:
+	default:
+		return fmt.Errorf(
+			"poorly formatted app.toml (oracle subsection): oracle sidecar mode must be %q or %q; got %q",
+			SidecarModeFailover,
+			SidecarModeMedian,
+			c.SidecarMode,
+		)
+	}
+
 	if c.ClientTimeout <= 0 {
 		return fmt.Errorf("poorly formatted app.toml (oracle subsection): oracle client timeout must be greater than 0")
 	}
@@ -135,6 +239,14 @@ func (c *AppConfig) ValidateBasic() error {
 		return fmt.Errorf("poorly formatted app.toml (oracle subsection): oracle interval must be strictly less than max age")
 	}
 
+	if err := c.TLS.ValidateBasic(); err != nil {
+		return fmt.Errorf("poorly formatted app.toml (oracle subsection): %w", err)
+	}
+
+	if c.MaxVoteExtensionSize < 0 {
+		return fmt.Errorf("poorly formatted app.toml (oracle subsection): oracle max vote extension size must be non-negative")
+	}
+
 	return nil
 }
 
@@ -169,6 +281,26 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (AppConfig, error) {
 		}
 	}
 
+	// get the fallback addresses
+	if v := opts.Get(flagFallbackAddresses); v != nil {
+		if cfg.FallbackAddresses, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, fmt.Errorf("fallback addresses must be a list of strings")
+		}
+	}
+
+	// get the sidecar mode
+	if v := opts.Get(flagSidecarMode); v != nil {
+		mode, err := cast.ToStringE(v)
+		if err != nil {
+			return cfg, fmt.Errorf("sidecar mode must be a string")
+		}
+
+		// only update the sidecar mode if it is non-empty
+		if len(mode) > 0 {
+			cfg.SidecarMode = mode
+		}
+	}
+
 	// get the client timeout
 	if v := opts.Get(flagClientTimeout); v != nil {
 		clientTimeout, err := cast.ToDurationE(v)
@@ -215,6 +347,45 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (AppConfig, error) {
 		}
 	}
 
+	// get the tls config
+	if v := opts.Get(flagTLSEnabled); v != nil {
+		if cfg.TLS.Enabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	for flag, file := range map[string]*string{
+		flagTLSCAFile:     &cfg.TLS.CAFile,
+		flagTLSCertFile:   &cfg.TLS.CertFile,
+		flagTLSKeyFile:    &cfg.TLS.KeyFile,
+		flagTLSServerName: &cfg.TLS.ServerName,
+	} {
+		if v := opts.Get(flag); v != nil {
+			if *file, err = cast.ToStringE(v); err != nil {
+				return cfg, fmt.Errorf("%s must be a string", flag)
+			}
+		}
+	}
+
+	if v := opts.Get(flagTLSReloadInterval); v != nil {
+		if cfg.TLS.ReloadInterval, err = cast.ToDurationE(v); err != nil {
+			return cfg, fmt.Errorf("tls reload interval must be a non-negative duration")
+		}
+	}
+
+	// get the vote extension size budget
+	if v := opts.Get(flagMaxVoteExtensionSize); v != nil {
+		if cfg.MaxVoteExtensionSize, err = cast.ToIntE(v); err != nil {
+			return cfg, fmt.Errorf("max vote extension size must be a non-negative integer")
+		}
+	}
+
+	if v := opts.Get(flagPriceMovementThreshold); v != nil {
+		if cfg.PriceMovementThreshold, err = cast.ToUint32E(v); err != nil {
+			return cfg, fmt.Errorf("price movement threshold must be a non-negative integer")
+		}
+	}
+
 	if err := cfg.ValidateBasic(); err != nil {
 		return cfg, err
 	}
@@ -222,14 +393,25 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (AppConfig, error) {
 	return cfg, err
 }
 
+// Addresses returns the addresses of every oracle sidecar, in order of preference.
+func (c *AppConfig) Addresses() []string {
+	return append([]string{c.OracleAddress}, c.FallbackAddresses...)
+}
+
 // String implements the stringer interface for the AppConfig.
 func (c AppConfig) String() string {
 	return fmt.Sprintf(`Oracle Config:
   Enabled: %v
   Oracle Address: %s
+  Fallback Addresses: %v
+  Sidecar Mode: %s
   Client Timeout: %s
   Metrics Enabled: %v
   Price TTL: %s
-  Interval: %s`,
-		c.Enabled, c.OracleAddress, c.ClientTimeout, c.MetricsEnabled, c.PriceTTL, c.Interval)
+  Interval: %s
+  TLS Enabled: %v
+  Max Vote Extension Size: %d
+  Price Movement Threshold: %d`,
+		c.Enabled, c.OracleAddress, c.FallbackAddresses, c.SidecarMode, c.ClientTimeout, c.MetricsEnabled, c.PriceTTL, c.Interval, c.TLS.Enabled,
+		c.MaxVoteExtensionSize, c.PriceMovementThreshold)
 }
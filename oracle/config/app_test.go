@@ -45,6 +45,99 @@ func TestValidateBasic(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "good config with tls",
+			config: config.AppConfig{
+				Enabled:       true,
+				OracleAddress: "localhost:8080",
+				ClientTimeout: time.Second,
+				Interval:      time.Second,
+				PriceTTL:      time.Second * 2,
+				TLS: config.ClientTLSConfig{
+					Enabled:  true,
+					CertFile: "client.pem",
+					KeyFile:  "client-key.pem",
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with a tls cert file but no key file",
+			config: config.AppConfig{
+				Enabled:       true,
+				OracleAddress: "localhost:8080",
+				ClientTimeout: time.Second,
+				Interval:      time.Second,
+				PriceTTL:      time.Second * 2,
+				TLS: config.ClientTLSConfig{
+					Enabled:  true,
+					CertFile: "client.pem",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with fallback addresses",
+			config: config.AppConfig{
+				Enabled:           true,
+				OracleAddress:     "localhost:8080",
+				FallbackAddresses: []string{"localhost:8081", "localhost:8082"},
+				SidecarMode:       config.SidecarModeMedian,
+				ClientTimeout:     time.Second,
+				Interval:          time.Second,
+				PriceTTL:          time.Second * 2,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with an empty fallback address",
+			config: config.AppConfig{
+				Enabled:           true,
+				OracleAddress:     "localhost:8080",
+				FallbackAddresses: []string{""},
+				ClientTimeout:     time.Second,
+				Interval:          time.Second,
+				PriceTTL:          time.Second * 2,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with a vote extension size budget",
+			config: config.AppConfig{
+				Enabled:                true,
+				OracleAddress:          "localhost:8080",
+				ClientTimeout:          time.Second,
+				Interval:               time.Second,
+				PriceTTL:               time.Second * 2,
+				MaxVoteExtensionSize:   1 << 16,
+				PriceMovementThreshold: 50,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with a negative max vote extension size",
+			config: config.AppConfig{
+				Enabled:              true,
+				OracleAddress:        "localhost:8080",
+				ClientTimeout:        time.Second,
+				Interval:             time.Second,
+				PriceTTL:             time.Second * 2,
+				MaxVoteExtensionSize: -1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with an unknown sidecar mode",
+			config: config.AppConfig{
+				Enabled:       true,
+				OracleAddress: "localhost:8080",
+				SidecarMode:   "mogged",
+				ClientTimeout: time.Second,
+				Interval:      time.Second,
+				PriceTTL:      time.Second * 2,
+			},
+			expectedErr: true,
+		},
 		{
 			name: "bad config with no oracle address",
 			config: config.AppConfig{
@@ -137,6 +230,96 @@ func TestConfigFromAppOptions(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "good config with tls",
+			config: sims.AppOptionsMap{
+				"oracle.enabled":             true,
+				"oracle.oracle_address":      "localhost:8081",
+				"oracle.client_timeout":      "5s",
+				"oracle.price_ttl":           "20s",
+				"oracle.interval":            "10s",
+				"oracle.tls_enabled":         true,
+				"oracle.tls_ca_file":         "ca.pem",
+				"oracle.tls_cert_file":       "client.pem",
+				"oracle.tls_key_file":        "client-key.pem",
+				"oracle.tls_server_name":     "oracle.example.com",
+				"oracle.tls_reload_interval": "1m",
+			},
+			res: config.AppConfig{
+				Enabled:       true,
+				OracleAddress: "localhost:8081",
+				ClientTimeout: 5 * time.Second,
+				PriceTTL:      20 * time.Second,
+				Interval:      10 * time.Second,
+				TLS: config.ClientTLSConfig{
+					Enabled:        true,
+					CAFile:         "ca.pem",
+					CertFile:       "client.pem",
+					KeyFile:        "client-key.pem",
+					ServerName:     "oracle.example.com",
+					ReloadInterval: time.Minute,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with a bad tls reload interval",
+			config: sims.AppOptionsMap{
+				"oracle.enabled":             true,
+				"oracle.oracle_address":      "localhost:8081",
+				"oracle.client_timeout":      "5s",
+				"oracle.price_ttl":           "20s",
+				"oracle.interval":            "10s",
+				"oracle.tls_enabled":         true,
+				"oracle.tls_reload_interval": "-1m",
+			},
+			res:         config.AppConfig{},
+			expectedErr: true,
+		},
+		{
+			name: "good config with fallback addresses",
+			config: sims.AppOptionsMap{
+				"oracle.enabled":            true,
+				"oracle.oracle_address":     "localhost:8081",
+				"oracle.fallback_addresses": []interface{}{"localhost:8082", "localhost:8083"},
+				"oracle.sidecar_mode":       "median",
+				"oracle.client_timeout":     "5s",
+				"oracle.price_ttl":          "20s",
+				"oracle.interval":           "10s",
+			},
+			res: config.AppConfig{
+				Enabled:           true,
+				OracleAddress:     "localhost:8081",
+				FallbackAddresses: []string{"localhost:8082", "localhost:8083"},
+				SidecarMode:       config.SidecarModeMedian,
+				ClientTimeout:     5 * time.Second,
+				PriceTTL:          20 * time.Second,
+				Interval:          10 * time.Second,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good config with a vote extension size budget",
+			config: sims.AppOptionsMap{
+				"oracle.enabled":                  true,
+				"oracle.oracle_address":           "localhost:8081",
+				"oracle.client_timeout":           "5s",
+				"oracle.price_ttl":                "20s",
+				"oracle.interval":                 "10s",
+				"oracle.max_vote_extension_size":  "65536",
+				"oracle.price_movement_threshold": 50,
+			},
+			res: config.AppConfig{
+				Enabled:                true,
+				OracleAddress:          "localhost:8081",
+				ClientTimeout:          5 * time.Second,
+				PriceTTL:               20 * time.Second,
+				Interval:               10 * time.Second,
+				MaxVoteExtensionSize:   65536,
+				PriceMovementThreshold: 50,
+			},
+			expectedErr: false,
+		},
 		{
 			name:        "good config with no fields configured",
 			config:      sims.AppOptionsMap{},
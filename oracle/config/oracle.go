@@ -26,6 +26,18 @@ type OracleConfig struct {
 	// Metrics is the metrics configurations for the oracle.
 	Metrics MetricsConfig `json:"metrics"`
 
+	// Aggregation is the configuration for how provider prices are aggregated.
+	Aggregation AggregationConfig `json:"aggregation"`
+
+	// Snapshot is the configuration for persisting the oracle's state across restarts.
+	Snapshot SnapshotConfig `json:"snapshot"`
+
+	// Readiness is the configuration for when the oracle server reports itself as ready.
+	Readiness ReadinessConfig `json:"readiness"`
+
+	// TLS is the TLS configuration of the oracle server.
+	TLS ServerTLSConfig `json:"tls"`
+
 	// Host is the host that the oracle will listen on.
 	Host string `json:"host"`
 
@@ -49,6 +61,32 @@ func (c *OracleConfig) ValidateBasic() error {
 		}
 	}
 
+	if err := c.Aggregation.ValidateBasic(); err != nil {
+		return fmt.Errorf("aggregation config is not formatted correctly: %w", err)
+	}
+
+	// Prices are at most max price age old, so bound the number of half-lives they can decay by.
+	if halfLife := c.Aggregation.StalenessDecay.HalfLife; halfLife > 0 && c.MaxPriceAge > halfLife*MaxStalenessDecayHalfLives {
+		return fmt.Errorf(
+			"staleness decay half life must be at least 1/%d of the max price age (%s); got %s",
+			MaxStalenessDecayHalfLives,
+			c.MaxPriceAge,
+			halfLife,
+		)
+	}
+
+	if err := c.Snapshot.ValidateBasic(); err != nil {
+		return fmt.Errorf("snapshot config is not formatted correctly: %w", err)
+	}
+
+	if err := c.Readiness.ValidateBasic(); err != nil {
+		return fmt.Errorf("readiness config is not formatted correctly: %w", err)
+	}
+
+	if err := c.TLS.ValidateBasic(); err != nil {
+		return fmt.Errorf("tls config is not formatted correctly: %w", err)
+	}
+
 	if len(c.Host) == 0 {
 		return fmt.Errorf("oracle host cannot be empty")
 	}
@@ -199,3 +199,55 @@ func TestOracleConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestOracleConfigStalenessDecay(t *testing.T) {
+	testCases := []struct {
+		name        string
+		halfLife    time.Duration
+		expectedErr bool
+	}{
+		{
+			name:        "disabled decay",
+			halfLife:    0,
+			expectedErr: false,
+		},
+		{
+			name:        "half life at the max number of half lives",
+			halfLife:    time.Minute / config.MaxStalenessDecayHalfLives,
+			expectedErr: false,
+		},
+		{
+			name:        "half life longer than the max price age",
+			halfLife:    time.Hour,
+			expectedErr: false,
+		},
+		{
+			name:        "half life too short for the max price age",
+			halfLife:    time.Minute/config.MaxStalenessDecayHalfLives - time.Nanosecond,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				Host:           "localhost",
+				Port:           "8080",
+				Aggregation: config.AggregationConfig{
+					StalenessDecay: config.StalenessDecayConfig{
+						HalfLife: tc.halfLife,
+					},
+				},
+			}
+
+			err := cfg.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
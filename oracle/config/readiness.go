@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReadinessConfig is the configuration for when the oracle server reports itself as ready to
+// serve prices on its readiness endpoint. The oracle is ready once it is running, has loaded a
+// market map and has recently updated its prices.
+type ReadinessConfig struct {
+	// MaxSyncAge is the maximum time since the oracle last updated its prices for it to be
+	// ready. If this is zero, the oracle is ready once it has updated its prices at least once.
+	MaxSyncAge time.Duration `json:"maxSyncAge"`
+
+	// MinPricedFraction is the minimum fraction of the enabled markets in the market map that
+	// must have a price for the oracle to be ready, e.g. 0.9 for 90%. If this is zero, the
+	// oracle may be ready without any prices.
+	MinPricedFraction float64 `json:"minPricedFraction"`
+}
+
+// ValidateBasic performs basic validation of the readiness config.
+func (c *ReadinessConfig) ValidateBasic() error {
+	if c.MaxSyncAge < 0 {
+		return fmt.Errorf("readiness max sync age must be non-negative; got %s", c.MaxSyncAge)
+	}
+
+	if c.MinPricedFraction < 0 || c.MinPricedFraction > 1 {
+		return fmt.Errorf("readiness min priced fraction must be between 0 and 1; got %f", c.MinPricedFraction)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+func TestReadinessConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.ReadinessConfig
+		expectedErr bool
+	}{
+		{
+			name:        "empty config",
+			config:      config.ReadinessConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "valid config",
+			config: config.ReadinessConfig{
+				MaxSyncAge:        10 * time.Second,
+				MinPricedFraction: 0.9,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "every market must be priced",
+			config: config.ReadinessConfig{
+				MinPricedFraction: 1,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "negative max sync age",
+			config: config.ReadinessConfig{
+				MaxSyncAge: -time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative min priced fraction",
+			config: config.ReadinessConfig{
+				MinPricedFraction: -0.1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "min priced fraction above one",
+			config: config.ReadinessConfig{
+				MinPricedFraction: 1.1,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// SnapshotConfig is the configuration for persisting the oracle's state to disk so that the
+// oracle can warm start after a restart. The snapshot includes the index prices, the latest
+// provider prices and the market map.
+type SnapshotConfig struct {
+	// Path is the file the oracle writes its snapshot to, and restores its state from on start
+	// up. Snapshots are disabled if this is empty.
+	Path string `json:"path"`
+
+	// Interval is how frequently the oracle writes its snapshot. The snapshot is always written
+	// when the oracle stops. If this is zero, the snapshot is only written when the oracle stops.
+	Interval time.Duration `json:"interval"`
+}
+
+// Enabled returns true if snapshots are enabled.
+func (c *SnapshotConfig) Enabled() bool {
+	return len(c.Path) > 0
+}
+
+// ValidateBasic performs basic validation of the snapshot config.
+func (c *SnapshotConfig) ValidateBasic() error {
+	if c.Interval < 0 {
+		return fmt.Errorf("snapshot interval must be non-negative; got %s", c.Interval)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+func TestSnapshotConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.SnapshotConfig
+		enabled     bool
+		expectedErr bool
+	}{
+		{
+			name:        "empty config",
+			config:      config.SnapshotConfig{},
+			enabled:     false,
+			expectedErr: false,
+		},
+		{
+			name: "snapshot on shutdown only",
+			config: config.SnapshotConfig{
+				Path: "snapshot.json",
+			},
+			enabled:     true,
+			expectedErr: false,
+		},
+		{
+			name: "periodic snapshots",
+			config: config.SnapshotConfig{
+				Path:     "snapshot.json",
+				Interval: time.Minute,
+			},
+			enabled:     true,
+			expectedErr: false,
+		},
+		{
+			name: "negative interval",
+			config: config.SnapshotConfig{
+				Path:     "snapshot.json",
+				Interval: -time.Minute,
+			},
+			enabled:     true,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.enabled, tc.config.Enabled())
+
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// ServerTLSConfig is the TLS configuration of the oracle server. If a certificate is configured,
+// the server only accepts TLS connections. If a client CA file is also configured, clients must
+// present a certificate signed by one of its CAs (mutual TLS), and can be further restricted to
+// an allow list of client names.
+type ServerTLSConfig struct {
+	// CertFile is the path to the PEM encoded certificate of the server. TLS is enabled if
+	// this is set.
+	CertFile string `json:"certFile"`
+
+	// KeyFile is the path to the PEM encoded private key of the server's certificate.
+	KeyFile string `json:"keyFile"`
+
+	// ClientCAFile is the path to the PEM encoded CAs used to verify client certificates. If
+	// this is set, clients must present a certificate signed by one of these CAs.
+	ClientCAFile string `json:"clientCAFile"`
+
+	// AllowedClients is the list of clients that are allowed to connect to the server, matched
+	// against the common name and DNS names of the client's certificate. If this is empty,
+	// every client with a valid certificate is allowed.
+	AllowedClients []string `json:"allowedClients"`
+
+	// ReloadInterval is the minimum time between checks for changes to the certificate, key
+	// and client CA files. Changed files are reloaded on the next handshake, so certificates
+	// can be rotated without restarting the oracle. If this is zero, the files are never
+	// reloaded.
+	ReloadInterval time.Duration `json:"reloadInterval"`
+}
+
+// Enabled returns true if the server accepts TLS connections.
+func (c *ServerTLSConfig) Enabled() bool {
+	return len(c.CertFile) > 0
+}
+
+// ValidateBasic performs basic validation of the server TLS config.
+func (c *ServerTLSConfig) ValidateBasic() error {
+	if (len(c.CertFile) == 0) != (len(c.KeyFile) == 0) {
+		return fmt.Errorf("tls cert file and key file must be set together")
+	}
+
+	if len(c.ClientCAFile) > 0 && !c.Enabled() {
+		return fmt.Errorf("tls client ca file requires a cert file and key file")
+	}
+
+	if len(c.AllowedClients) > 0 && len(c.ClientCAFile) == 0 {
+		return fmt.Errorf("tls allowed clients requires a client ca file")
+	}
+
+	for _, client := range c.AllowedClients {
+		if len(client) == 0 {
+			return fmt.Errorf("tls allowed clients cannot be empty")
+		}
+	}
+
+	if c.ReloadInterval < 0 {
+		return fmt.Errorf("tls reload interval must be non-negative; got %s", c.ReloadInterval)
+	}
+
+	return nil
+}
+
+// ClientTLSConfig is the TLS configuration of the application's connection to the oracle
+// server. It is read from the oracle subsection of the app.toml file.
+type ClientTLSConfig struct {
+	// Enabled indicates whether the connection to the oracle uses TLS.
+	Enabled bool `mapstructure:"tls_enabled" toml:"tls_enabled"`
+
+	// CAFile is the path to the PEM encoded CAs used to verify the oracle's certificate. If
+	// this is empty, the system's CAs are used.
+	CAFile string `mapstructure:"tls_ca_file" toml:"tls_ca_file"`
+
+	// CertFile is the path to the PEM encoded client certificate presented to the oracle
+	// when it requires mutual TLS.
+	CertFile string `mapstructure:"tls_cert_file" toml:"tls_cert_file"`
+
+	// KeyFile is the path to the PEM encoded private key of the client certificate.
+	KeyFile string `mapstructure:"tls_key_file" toml:"tls_key_file"`
+
+	// ServerName overrides the name used to verify the oracle's certificate. If this is
+	// empty, the host of the oracle address is used.
+	ServerName string `mapstructure:"tls_server_name" toml:"tls_server_name"`
+
+	// ReloadInterval is the minimum time between checks for changes to the client certificate
+	// and key files. If this is zero, the files are never reloaded.
+	ReloadInterval time.Duration `mapstructure:"tls_reload_interval" toml:"tls_reload_interval"`
+}
+
+// ValidateBasic performs basic validation of the client TLS config.
+func (c *ClientTLSConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if (len(c.CertFile) == 0) != (len(c.KeyFile) == 0) {
+		return fmt.Errorf("tls cert file and key file must be set together")
+	}
+
+	if c.ReloadInterval < 0 {
+		return fmt.Errorf("tls reload interval must be non-negative; got %s", c.ReloadInterval)
+	}
+
+	return nil
+}
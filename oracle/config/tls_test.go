@@ -0,0 +1,165 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+func TestServerTLSConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.ServerTLSConfig
+		expectedErr bool
+	}{
+		{
+			name:        "empty config",
+			config:      config.ServerTLSConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "valid tls config",
+			config: config.ServerTLSConfig{
+				CertFile:       "server.pem",
+				KeyFile:        "server-key.pem",
+				ReloadInterval: time.Minute,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "valid mutual tls config",
+			config: config.ServerTLSConfig{
+				CertFile:       "server.pem",
+				KeyFile:        "server-key.pem",
+				ClientCAFile:   "ca.pem",
+				AllowedClients: []string{"validator-1"},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "cert file without a key file",
+			config: config.ServerTLSConfig{
+				CertFile: "server.pem",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "key file without a cert file",
+			config: config.ServerTLSConfig{
+				KeyFile: "server-key.pem",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "client ca file without tls",
+			config: config.ServerTLSConfig{
+				ClientCAFile: "ca.pem",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "allowed clients without a client ca file",
+			config: config.ServerTLSConfig{
+				CertFile:       "server.pem",
+				KeyFile:        "server-key.pem",
+				AllowedClients: []string{"validator-1"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "empty allowed client",
+			config: config.ServerTLSConfig{
+				CertFile:       "server.pem",
+				KeyFile:        "server-key.pem",
+				ClientCAFile:   "ca.pem",
+				AllowedClients: []string{""},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative reload interval",
+			config: config.ServerTLSConfig{
+				CertFile:       "server.pem",
+				KeyFile:        "server-key.pem",
+				ReloadInterval: -time.Second,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClientTLSConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.ClientTLSConfig
+		expectedErr bool
+	}{
+		{
+			name: "disabled config is not validated",
+			config: config.ClientTLSConfig{
+				CertFile:       "client.pem",
+				ReloadInterval: -time.Second,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "tls with the system cas",
+			config: config.ClientTLSConfig{
+				Enabled: true,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "mutual tls",
+			config: config.ClientTLSConfig{
+				Enabled:        true,
+				CAFile:         "ca.pem",
+				CertFile:       "client.pem",
+				KeyFile:        "client-key.pem",
+				ReloadInterval: time.Minute,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "key file without a cert file",
+			config: config.ClientTLSConfig{
+				Enabled: true,
+				KeyFile: "client-key.pem",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative reload interval",
+			config: config.ClientTLSConfig{
+				Enabled:        true,
+				ReloadInterval: -time.Second,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -283,6 +283,12 @@ type noOpPriceAggregator struct{}
 func (n noOpPriceAggregator) SetProviderPrices(_ string, _ oracletypes.Prices) {
 }
 
+func (n noOpPriceAggregator) SetProviderWeights(_ string, _ oracletypes.Prices) {
+}
+
+func (n noOpPriceAggregator) SetProviderTimestamps(_ string, _ map[string]time.Time) {
+}
+
 func (n noOpPriceAggregator) UpdateMarketMap(_ mmtypes.MarketMap) {
 }
 
@@ -293,6 +299,26 @@ func (n noOpPriceAggregator) GetPrices() oracletypes.Prices {
 	return oracletypes.Prices{}
 }
 
+func (n noOpPriceAggregator) GetDispersions() oracletypes.Dispersions {
+	return oracletypes.Dispersions{}
+}
+
+func (n noOpPriceAggregator) GetPriorityTiers() oracletypes.PriorityTiers {
+	return oracletypes.PriorityTiers{}
+}
+
+func (n noOpPriceAggregator) GetProviderHealth() []oracletypes.ProviderHealth {
+	return nil
+}
+
+func (n noOpPriceAggregator) GetPriceHistory(_ oracletypes.PriceHistoryFilter) []oracletypes.PriceRecord {
+	return nil
+}
+
+func (n noOpPriceAggregator) GetProviderPriceDetails() oracletypes.ProviderPriceDetails {
+	return oracletypes.ProviderPriceDetails{}
+}
+
 func (n noOpPriceAggregator) Reset() {
 }
 
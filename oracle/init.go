@@ -44,13 +44,34 @@ func (o *OracleImpl) Init(ctx context.Context) error {
 	return nil
 }
 
-// createPriceProvider creates a new price provider for the given provider configuration.
+// createPriceProvider creates a new price provider for the given provider configuration and
+// adds it to the oracle.
 func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.ProviderConfig) error {
+	state, err := o.newPriceProviderState(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Add the provider to the oracle.
+	o.priceProviders[state.Provider.Name()] = state
+
+	// Add the provider name to the message here since we want these to ignore log sampling limits
+	o.logger.Info(
+		fmt.Sprintf("created %s provider state", state.Provider.Name()),
+		zap.String("provider", state.Provider.Name()),
+		zap.Int("num_tickers", len(state.Provider.GetIDs())),
+	)
+	return nil
+}
+
+// newPriceProviderState creates the state of a new price provider for the given provider
+// configuration. The provider is not added to the oracle.
+func (o *OracleImpl) newPriceProviderState(ctx context.Context, cfg config.ProviderConfig) (ProviderState, error) {
 	// Create the provider market map. This creates the tickers the provider is configured to
 	// support.
 	tickers, err := types.ProviderTickersFromMarketMap(cfg.Name, o.marketMap)
 	if err != nil {
-		return fmt.Errorf("failed to create %s's provider market map: %w", cfg.Name, err)
+		return ProviderState{}, fmt.Errorf("failed to create %s's provider market map: %w", cfg.Name, err)
 	}
 
 	// Select the query handler based on the provider's configuration.
@@ -59,7 +80,7 @@ func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.Provide
 	case cfg.API.Enabled:
 		queryHandler, err := o.createAPIQueryHandler(ctx, cfg)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's api query handler: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's api query handler: %w", cfg.Name, err)
 		}
 
 		provider, err = types.NewPriceProvider(
@@ -71,12 +92,12 @@ func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.Provide
 			base.WithMetrics[types.ProviderTicker, *big.Float](o.providerMetrics),
 		)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
 		}
 	case cfg.WebSocket.Enabled:
 		queryHandler, err := o.createWebSocketQueryHandler(ctx, cfg)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's web socket query handler: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's web socket query handler: %w", cfg.Name, err)
 		}
 
 		provider, err = types.NewPriceProvider(
@@ -88,27 +109,16 @@ func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.Provide
 			base.WithMetrics[types.ProviderTicker, *big.Float](o.providerMetrics),
 		)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
 		}
 	default:
-		return fmt.Errorf("provider %s has no enabled query handlers", cfg.Name)
+		return ProviderState{}, fmt.Errorf("provider %s has no enabled query handlers", cfg.Name)
 	}
 
-	state := ProviderState{
+	return ProviderState{
 		Provider: provider,
 		Cfg:      cfg,
-	}
-
-	// Add the provider to the oracle.
-	o.priceProviders[provider.Name()] = state
-
-	// Add the provider name to the message here since we want these to ignore log sampling limits
-	o.logger.Info(
-		fmt.Sprintf("created %s provider state", provider.Name()),
-		zap.String("provider", provider.Name()),
-		zap.Int("num_tickers", len(provider.GetIDs())),
-	)
-	return nil
+	}, nil
 }
 
 // createAPIQueryHandler creates a new API query handler for the given provider configuration.
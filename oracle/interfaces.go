@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
 	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
@@ -15,7 +16,15 @@ type Oracle interface {
 	IsRunning() bool
 	GetLastSyncTime() time.Time
 	GetPrices() types.Prices
+	GetPriceDispersions() types.Dispersions
+	GetPriceTiers() types.PriorityTiers
+	GetProviderHealth() []types.ProviderHealth
+	GetPriceHistory(filter types.PriceHistoryFilter) []types.PriceRecord
+	GetProviderPriceDetails() types.ProviderPriceDetails
+	GetProviderStatuses() []types.ProviderStatus
+	SubscribePrices() (<-chan time.Time, func())
 	GetMarketMap() mmtypes.MarketMap
+	UpdateConfig(cfg config.OracleConfig) error
 	Start(ctx context.Context) error
 	Stop()
 }
@@ -26,9 +35,16 @@ type Oracle interface {
 //go:generate mockery --name PriceAggregator
 type PriceAggregator interface {
 	SetProviderPrices(provider string, prices types.Prices)
+	SetProviderWeights(provider string, weights types.Prices)
+	SetProviderTimestamps(provider string, timestamps map[string]time.Time)
 	UpdateMarketMap(mmtypes.MarketMap)
 	AggregatePrices()
 	GetPrices() types.Prices
+	GetDispersions() types.Dispersions
+	GetPriorityTiers() types.PriorityTiers
+	GetProviderHealth() []types.ProviderHealth
+	GetPriceHistory(filter types.PriceHistoryFilter) []types.PriceRecord
+	GetProviderPriceDetails() types.ProviderPriceDetails
 	Reset()
 }
 
@@ -16,6 +16,13 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 	o.logger.Info("starting oracle")
 	o.running.Store(true)
 	defer o.running.Store(false)
+
+	// Restore the state persisted before the last shutdown, if any. This must happen before the
+	// providers are initialized as it may restore the market map.
+	if err := o.RestoreSnapshot(); err != nil {
+		o.logger.Error("failed to restore snapshot; starting with an empty state", zap.Error(err))
+	}
+
 	if err := o.Init(ctx); err != nil {
 		o.logger.Error("failed to initialize oracle", zap.Error(err))
 		return err
@@ -62,6 +69,14 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 	defer ticker.Stop()
 	o.metrics.SetConnectBuildInfo()
 
+	// Periodically snapshot the oracle's state if configured. A nil channel never fires.
+	var snapshotCh <-chan time.Time
+	if o.cfg.Snapshot.Enabled() && o.cfg.Snapshot.Interval > 0 {
+		snapshotTicker := time.NewTicker(o.cfg.Snapshot.Interval)
+		defer snapshotTicker.Stop()
+		snapshotCh = snapshotTicker.C
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -70,6 +85,13 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 			return ctx.Err()
 		case <-ticker.C:
 			o.fetchAllPrices()
+		case interval := <-o.updateIntervalCh:
+			o.logger.Info("resetting price fetch loop", zap.Duration("update_interval", interval))
+			ticker.Reset(interval)
+		case <-snapshotCh:
+			if err := o.WriteSnapshot(); err != nil {
+				o.logger.Error("failed to write snapshot", zap.Error(err))
+			}
 		}
 	}
 }
@@ -85,6 +107,10 @@ func (o *OracleImpl) Stop() {
 
 	o.logger.Info("waiting for routines to stop")
 	o.wg.Wait()
+
+	if err := o.WriteSnapshot(); err != nil {
+		o.logger.Error("failed to write snapshot", zap.Error(err))
+	}
 	o.logger.Info("oracle exited successfully")
 }
 
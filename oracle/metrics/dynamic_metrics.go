@@ -108,6 +108,26 @@ func (d *dynamicMetrics) AddProviderCountForMarket(pairID string, count int) {
 	d.impl.AddProviderCountForMarket(pairID, count)
 }
 
+func (d *dynamicMetrics) AddOutlierRejection(providerName, pairID string) {
+	d.impl.AddOutlierRejection(providerName, pairID)
+}
+
+func (d *dynamicMetrics) UpdatePriceDispersion(pairID string, stdDev, interquartileRange, minPrice, maxPrice float64) {
+	d.impl.UpdatePriceDispersion(pairID, stdDev, interquartileRange, minPrice, maxPrice)
+}
+
+func (d *dynamicMetrics) AddCircuitBreakerTrip(pairID, reason string) {
+	d.impl.AddCircuitBreakerTrip(pairID, reason)
+}
+
+func (d *dynamicMetrics) AddCircuitBreakerReset(pairID, reason string) {
+	d.impl.AddCircuitBreakerReset(pairID, reason)
+}
+
+func (d *dynamicMetrics) UpdatePriorityTier(pairID string, tier uint32) {
+	d.impl.UpdatePriorityTier(pairID, tier)
+}
+
 func (d *dynamicMetrics) SetConnectBuildInfo() {
 	d.impl.SetConnectBuildInfo()
 }
@@ -25,14 +25,33 @@ const (
 	SuccessLabel = "success"
 	// Version is a label for the Connect version.
 	Version = "version"
-
-	TicksMetricName            = "health_check_system_updates_total"
-	TickerTicksMetricName      = "health_check_ticker_updates_total"
-	PricesMetricName           = "provider_price"
-	AggregatePricesMetricName  = "aggregated_price"
-	ProviderTickMetricName     = "health_check_provider_updates_total"
-	ProviderCountMetricName    = "health_check_market_providers"
-	ConnectBuildInfoMetricName = "connect_build_info"
+	// StatisticLabel is a label for the statistic reported by a dispersion metric.
+	StatisticLabel = "statistic"
+	// ReasonLabel is a label for the reason a circuit breaker was tripped or reset.
+	ReasonLabel = "reason"
+
+	// StdDevStatistic is the standard deviation of the converted prices of a market.
+	StdDevStatistic = "std_dev"
+	// InterquartileRangeStatistic is the interquartile range of the converted prices of a market.
+	InterquartileRangeStatistic = "interquartile_range"
+	// MinStatistic is the minimum converted price of a market.
+	MinStatistic = "min"
+	// MaxStatistic is the maximum converted price of a market.
+	MaxStatistic = "max"
+
+	TicksMetricName                 = "health_check_system_updates_total"
+	TickerTicksMetricName           = "health_check_ticker_updates_total"
+	PricesMetricName                = "provider_price"
+	AggregatePricesMetricName       = "aggregated_price"
+	ProviderTickMetricName          = "health_check_provider_updates_total"
+	ProviderCountMetricName         = "health_check_market_providers"
+	OutlierRejectionMetricName      = "provider_outlier_rejections_total"
+	PriceDispersionMetricName       = "aggregated_price_dispersion"
+	CircuitBreakerTripsMetricName   = "circuit_breaker_trips_total"
+	CircuitBreakerResetsMetricName  = "circuit_breaker_resets_total"
+	CircuitBreakerTrippedMetricName = "circuit_breaker_tripped"
+	PriorityTierMetricName          = "aggregated_price_priority_tier"
+	ConnectBuildInfoMetricName      = "connect_build_info"
 )
 
 // Metrics is an interface that defines the API for oracle metrics.
@@ -62,6 +81,26 @@ type Metrics interface {
 	// to calculate the final price for a given market.
 	AddProviderCountForMarket(pairID string, count int)
 
+	// AddOutlierRejection increments the number of times a provider's price was rejected
+	// as an outlier for a given pairID.
+	AddOutlierRejection(providerName, pairID string)
+
+	// UpdatePriceDispersion updates the dispersion statistics of the converted prices that
+	// were used to calculate the aggregated price for the given pairID.
+	UpdatePriceDispersion(pairID string, stdDev, interquartileRange, minPrice, maxPrice float64)
+
+	// AddCircuitBreakerTrip increments the number of times the circuit breaker of a given
+	// pairID was tripped and marks the circuit breaker as tripped.
+	AddCircuitBreakerTrip(pairID, reason string)
+
+	// AddCircuitBreakerReset increments the number of times the circuit breaker of a given
+	// pairID was reset and marks the circuit breaker as closed.
+	AddCircuitBreakerReset(pairID, reason string)
+
+	// UpdatePriorityTier updates the lowest priority tier of the provider configs that were
+	// used to calculate the aggregated price for the given pairID.
+	UpdatePriorityTier(pairID string, tier uint32)
+
 	// SetConnectBuildInfo sets the build information for the Connect binary.
 	SetConnectBuildInfo()
 
@@ -80,6 +119,12 @@ type OracleMetricsImpl struct {
 	promAggregatePrices   *prometheus.GaugeVec
 	promProviderTick      *prometheus.CounterVec
 	promProviderCount     *prometheus.GaugeVec
+	promOutlierRejections *prometheus.CounterVec
+	promPriceDispersion   *prometheus.GaugeVec
+	promBreakerTrips      *prometheus.CounterVec
+	promBreakerResets     *prometheus.CounterVec
+	promBreakerTripped    *prometheus.GaugeVec
+	promPriorityTier      *prometheus.GaugeVec
 	promConnectBuildInfo  *prometheus.GaugeVec
 	statsdClient          statsd.ClientInterface
 	nodeIdentifier        string
@@ -155,6 +200,36 @@ func NewMetrics(statsdClient statsd.ClientInterface, nodeIdentifier string) Metr
 		Name:      ProviderCountMetricName,
 		Help:      "Number of providers that were utilized to calculate the final price for a given market.",
 	}, []string{PairIDLabel})
+	ret.promOutlierRejections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: OracleSubsystem,
+		Name:      OutlierRejectionMetricName,
+		Help:      "Number of times a provider's price was rejected as an outlier for a given currency pair.",
+	}, []string{ProviderLabel, PairIDLabel})
+	ret.promPriceDispersion = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: OracleSubsystem,
+		Name:      PriceDispersionMetricName,
+		Help:      "Dispersion of the converted prices that were used to calculate the aggregated price for a given currency pair.",
+	}, []string{PairIDLabel, StatisticLabel})
+	ret.promBreakerTrips = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: OracleSubsystem,
+		Name:      CircuitBreakerTripsMetricName,
+		Help:      "Number of times the circuit breaker of a given currency pair was tripped.",
+	}, []string{PairIDLabel, ReasonLabel})
+	ret.promBreakerResets = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: OracleSubsystem,
+		Name:      CircuitBreakerResetsMetricName,
+		Help:      "Number of times the circuit breaker of a given currency pair was reset.",
+	}, []string{PairIDLabel, ReasonLabel})
+	ret.promBreakerTripped = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: OracleSubsystem,
+		Name:      CircuitBreakerTrippedMetricName,
+		Help:      "Whether the circuit breaker of a given currency pair is currently tripped (1) or closed (0).",
+	}, []string{PairIDLabel})
+	ret.promPriorityTier = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: OracleSubsystem,
+		Name:      PriorityTierMetricName,
+		Help:      "Lowest priority tier of the provider configs that were used to calculate the aggregated price for a given currency pair.",
+	}, []string{PairIDLabel})
 	ret.promConnectBuildInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: OracleSubsystem,
 		Name:      ConnectBuildInfoMetricName,
@@ -167,6 +242,12 @@ func NewMetrics(statsdClient statsd.ClientInterface, nodeIdentifier string) Metr
 	prometheus.MustRegister(ret.promAggregatePrices)
 	prometheus.MustRegister(ret.promProviderTick)
 	prometheus.MustRegister(ret.promProviderCount)
+	prometheus.MustRegister(ret.promOutlierRejections)
+	prometheus.MustRegister(ret.promPriceDispersion)
+	prometheus.MustRegister(ret.promBreakerTrips)
+	prometheus.MustRegister(ret.promBreakerResets)
+	prometheus.MustRegister(ret.promBreakerTripped)
+	prometheus.MustRegister(ret.promPriorityTier)
 	prometheus.MustRegister(ret.promConnectBuildInfo)
 
 	return &ret
@@ -205,6 +286,26 @@ func (m *noOpOracleMetrics) AddProviderTick(_, _ string, _ bool) {}
 // to calculate the final price for a given market.
 func (m *noOpOracleMetrics) AddProviderCountForMarket(string, int) {}
 
+// AddOutlierRejection increments the number of times a provider's price was rejected
+// as an outlier for a given pairID.
+func (m *noOpOracleMetrics) AddOutlierRejection(_, _ string) {}
+
+// UpdatePriceDispersion updates the dispersion statistics of the converted prices that
+// were used to calculate the aggregated price for the given pairID.
+func (m *noOpOracleMetrics) UpdatePriceDispersion(string, float64, float64, float64, float64) {}
+
+// AddCircuitBreakerTrip increments the number of times the circuit breaker of a given
+// pairID was tripped and marks the circuit breaker as tripped.
+func (m *noOpOracleMetrics) AddCircuitBreakerTrip(_, _ string) {}
+
+// AddCircuitBreakerReset increments the number of times the circuit breaker of a given
+// pairID was reset and marks the circuit breaker as closed.
+func (m *noOpOracleMetrics) AddCircuitBreakerReset(_, _ string) {}
+
+// UpdatePriorityTier updates the lowest priority tier of the provider configs that were
+// used to calculate the aggregated price for the given pairID.
+func (m *noOpOracleMetrics) UpdatePriorityTier(string, uint32) {}
+
 // SetConnectBuildInfo sets the build information for the Connect binary.
 func (m *noOpOracleMetrics) SetConnectBuildInfo() {}
 
@@ -286,6 +387,90 @@ func (m *OracleMetricsImpl) AddProviderCountForMarket(market string, count int)
 	m.statsdClient.Gauge(metricName, float64(count), []string{}, 1)
 }
 
+// AddOutlierRejection increments the number of times a provider's price was rejected
+// as an outlier for a given pairID.
+func (m *OracleMetricsImpl) AddOutlierRejection(providerName, pairID string) {
+	m.promOutlierRejections.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+		PairIDLabel:   strings.ToLower(pairID),
+	},
+	).Add(1)
+
+	metricName := strings.Join([]string{OutlierRejectionMetricName, m.nodeIdentifier, strings.ToLower(providerName), strings.ToLower(pairID)}, ".")
+	m.statsdClient.Incr(metricName, []string{}, 1)
+}
+
+// UpdatePriceDispersion updates the dispersion statistics of the converted prices that
+// were used to calculate the aggregated price for the given pairID.
+func (m *OracleMetricsImpl) UpdatePriceDispersion(
+	pairID string,
+	stdDev, interquartileRange, minPrice, maxPrice float64,
+) {
+	statistics := map[string]float64{
+		StdDevStatistic:             stdDev,
+		InterquartileRangeStatistic: interquartileRange,
+		MinStatistic:                minPrice,
+		MaxStatistic:                maxPrice,
+	}
+
+	for statistic, value := range statistics {
+		m.promPriceDispersion.With(prometheus.Labels{
+			PairIDLabel:    strings.ToLower(pairID),
+			StatisticLabel: statistic,
+		},
+		).Set(value)
+
+		metricName := strings.Join([]string{PriceDispersionMetricName, m.nodeIdentifier, strings.ToLower(pairID)}, ".")
+		m.statsdClient.Gauge(metricName, value, []string{statistic}, 1)
+	}
+}
+
+// AddCircuitBreakerTrip increments the number of times the circuit breaker of a given
+// pairID was tripped and marks the circuit breaker as tripped.
+func (m *OracleMetricsImpl) AddCircuitBreakerTrip(pairID, reason string) {
+	m.promBreakerTrips.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+		ReasonLabel: reason,
+	},
+	).Add(1)
+	m.promBreakerTripped.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+	},
+	).Set(1)
+
+	metricName := strings.Join([]string{CircuitBreakerTripsMetricName, m.nodeIdentifier, strings.ToLower(pairID)}, ".")
+	m.statsdClient.Incr(metricName, []string{reason}, 1)
+}
+
+// AddCircuitBreakerReset increments the number of times the circuit breaker of a given
+// pairID was reset and marks the circuit breaker as closed.
+func (m *OracleMetricsImpl) AddCircuitBreakerReset(pairID, reason string) {
+	m.promBreakerResets.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+		ReasonLabel: reason,
+	},
+	).Add(1)
+	m.promBreakerTripped.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+	},
+	).Set(0)
+
+	metricName := strings.Join([]string{CircuitBreakerResetsMetricName, m.nodeIdentifier, strings.ToLower(pairID)}, ".")
+	m.statsdClient.Incr(metricName, []string{reason}, 1)
+}
+
+// UpdatePriorityTier updates the lowest priority tier of the provider configs that were
+// used to calculate the aggregated price for the given pairID.
+func (m *OracleMetricsImpl) UpdatePriorityTier(pairID string, tier uint32) {
+	m.promPriorityTier.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+	},
+	).Set(float64(tier))
+
+	metricName := strings.Join([]string{PriorityTierMetricName, m.nodeIdentifier, strings.ToLower(pairID)}, ".")
+	m.statsdClient.Gauge(metricName, float64(tier), []string{}, 1)
+}
+
 // MissingPrices updates the list of missing prices for the given tick.
 func (m *OracleMetricsImpl) MissingPrices(pairIDs []string) {
 	m.missingPricesMtx.Lock()
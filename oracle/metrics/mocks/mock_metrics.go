@@ -17,6 +17,108 @@ func (_m *Metrics) EXPECT() *Metrics_Expecter {
 	return &Metrics_Expecter{mock: &_m.Mock}
 }
 
+// AddCircuitBreakerReset provides a mock function with given fields: pairID, reason
+func (_m *Metrics) AddCircuitBreakerReset(pairID string, reason string) {
+	_m.Called(pairID, reason)
+}
+
+// Metrics_AddCircuitBreakerReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCircuitBreakerReset'
+type Metrics_AddCircuitBreakerReset_Call struct {
+	*mock.Call
+}
+
+// AddCircuitBreakerReset is a helper method to define mock.On call
+//   - pairID string
+//   - reason string
+func (_e *Metrics_Expecter) AddCircuitBreakerReset(pairID interface{}, reason interface{}) *Metrics_AddCircuitBreakerReset_Call {
+	return &Metrics_AddCircuitBreakerReset_Call{Call: _e.mock.On("AddCircuitBreakerReset", pairID, reason)}
+}
+
+func (_c *Metrics_AddCircuitBreakerReset_Call) Run(run func(pairID string, reason string)) *Metrics_AddCircuitBreakerReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Metrics_AddCircuitBreakerReset_Call) Return() *Metrics_AddCircuitBreakerReset_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_AddCircuitBreakerReset_Call) RunAndReturn(run func(string, string)) *Metrics_AddCircuitBreakerReset_Call {
+	_c.Run(run)
+	return _c
+}
+
+// AddCircuitBreakerTrip provides a mock function with given fields: pairID, reason
+func (_m *Metrics) AddCircuitBreakerTrip(pairID string, reason string) {
+	_m.Called(pairID, reason)
+}
+
+// Metrics_AddCircuitBreakerTrip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCircuitBreakerTrip'
+type Metrics_AddCircuitBreakerTrip_Call struct {
+	*mock.Call
+}
+
+// AddCircuitBreakerTrip is a helper method to define mock.On call
+//   - pairID string
+//   - reason string
+func (_e *Metrics_Expecter) AddCircuitBreakerTrip(pairID interface{}, reason interface{}) *Metrics_AddCircuitBreakerTrip_Call {
+	return &Metrics_AddCircuitBreakerTrip_Call{Call: _e.mock.On("AddCircuitBreakerTrip", pairID, reason)}
+}
+
+func (_c *Metrics_AddCircuitBreakerTrip_Call) Run(run func(pairID string, reason string)) *Metrics_AddCircuitBreakerTrip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Metrics_AddCircuitBreakerTrip_Call) Return() *Metrics_AddCircuitBreakerTrip_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_AddCircuitBreakerTrip_Call) RunAndReturn(run func(string, string)) *Metrics_AddCircuitBreakerTrip_Call {
+	_c.Run(run)
+	return _c
+}
+
+// AddOutlierRejection provides a mock function with given fields: providerName, pairID
+func (_m *Metrics) AddOutlierRejection(providerName string, pairID string) {
+	_m.Called(providerName, pairID)
+}
+
+// Metrics_AddOutlierRejection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddOutlierRejection'
+type Metrics_AddOutlierRejection_Call struct {
+	*mock.Call
+}
+
+// AddOutlierRejection is a helper method to define mock.On call
+//   - providerName string
+//   - pairID string
+func (_e *Metrics_Expecter) AddOutlierRejection(providerName interface{}, pairID interface{}) *Metrics_AddOutlierRejection_Call {
+	return &Metrics_AddOutlierRejection_Call{Call: _e.mock.On("AddOutlierRejection", providerName, pairID)}
+}
+
+func (_c *Metrics_AddOutlierRejection_Call) Run(run func(providerName string, pairID string)) *Metrics_AddOutlierRejection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Metrics_AddOutlierRejection_Call) Return() *Metrics_AddOutlierRejection_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_AddOutlierRejection_Call) RunAndReturn(run func(string, string)) *Metrics_AddOutlierRejection_Call {
+	_c.Run(run)
+	return _c
+}
+
 // AddProviderCountForMarket provides a mock function with given fields: pairID, count
 func (_m *Metrics) AddProviderCountForMarket(pairID string, count int) {
 	_m.Called(pairID, count)
@@ -334,6 +436,77 @@ func (_c *Metrics_UpdatePrice_Call) RunAndReturn(run func(string, string, uint64
 	return _c
 }
 
+// UpdatePriceDispersion provides a mock function with given fields: pairID, stdDev, interquartileRange, minPrice, maxPrice
+func (_m *Metrics) UpdatePriceDispersion(pairID string, stdDev float64, interquartileRange float64, minPrice float64, maxPrice float64) {
+	_m.Called(pairID, stdDev, interquartileRange, minPrice, maxPrice)
+}
+
+// Metrics_UpdatePriceDispersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePriceDispersion'
+type Metrics_UpdatePriceDispersion_Call struct {
+	*mock.Call
+}
+
+// UpdatePriceDispersion is a helper method to define mock.On call
+//   - pairID string
+//   - stdDev float64
+//   - interquartileRange float64
+//   - minPrice float64
+//   - maxPrice float64
+func (_e *Metrics_Expecter) UpdatePriceDispersion(pairID interface{}, stdDev interface{}, interquartileRange interface{}, minPrice interface{}, maxPrice interface{}) *Metrics_UpdatePriceDispersion_Call {
+	return &Metrics_UpdatePriceDispersion_Call{Call: _e.mock.On("UpdatePriceDispersion", pairID, stdDev, interquartileRange, minPrice, maxPrice)}
+}
+
+func (_c *Metrics_UpdatePriceDispersion_Call) Run(run func(pairID string, stdDev float64, interquartileRange float64, minPrice float64, maxPrice float64)) *Metrics_UpdatePriceDispersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(float64))
+	})
+	return _c
+}
+
+func (_c *Metrics_UpdatePriceDispersion_Call) Return() *Metrics_UpdatePriceDispersion_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_UpdatePriceDispersion_Call) RunAndReturn(run func(string, float64, float64, float64, float64)) *Metrics_UpdatePriceDispersion_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdatePriorityTier provides a mock function with given fields: pairID, tier
+func (_m *Metrics) UpdatePriorityTier(pairID string, tier uint32) {
+	_m.Called(pairID, tier)
+}
+
+// Metrics_UpdatePriorityTier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePriorityTier'
+type Metrics_UpdatePriorityTier_Call struct {
+	*mock.Call
+}
+
+// UpdatePriorityTier is a helper method to define mock.On call
+//   - pairID string
+//   - tier uint32
+func (_e *Metrics_Expecter) UpdatePriorityTier(pairID interface{}, tier interface{}) *Metrics_UpdatePriorityTier_Call {
+	return &Metrics_UpdatePriorityTier_Call{Call: _e.mock.On("UpdatePriorityTier", pairID, tier)}
+}
+
+func (_c *Metrics_UpdatePriorityTier_Call) Run(run func(pairID string, tier uint32)) *Metrics_UpdatePriorityTier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(uint32))
+	})
+	return _c
+}
+
+func (_c *Metrics_UpdatePriorityTier_Call) Return() *Metrics_UpdatePriorityTier_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_UpdatePriorityTier_Call) RunAndReturn(run func(string, uint32)) *Metrics_UpdatePriorityTier_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMetrics creates a new instance of Metrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMetrics(t interface {
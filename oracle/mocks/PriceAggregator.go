@@ -7,6 +7,10 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	oracletypes "github.com/skip-mev/connect/v2/oracle/types"
+
+	time "time"
+
 	types "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
@@ -55,6 +59,101 @@ func (_c *PriceAggregator_AggregatePrices_Call) RunAndReturn(run func()) *PriceA
 	return _c
 }
 
+// GetDispersions provides a mock function with no fields
+func (_m *PriceAggregator) GetDispersions() map[string]oracletypes.PriceDispersion {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDispersions")
+	}
+
+	var r0 map[string]oracletypes.PriceDispersion
+	if rf, ok := ret.Get(0).(func() map[string]oracletypes.PriceDispersion); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]oracletypes.PriceDispersion)
+		}
+	}
+
+	return r0
+}
+
+// PriceAggregator_GetDispersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDispersions'
+type PriceAggregator_GetDispersions_Call struct {
+	*mock.Call
+}
+
+// GetDispersions is a helper method to define mock.On call
+func (_e *PriceAggregator_Expecter) GetDispersions() *PriceAggregator_GetDispersions_Call {
+	return &PriceAggregator_GetDispersions_Call{Call: _e.mock.On("GetDispersions")}
+}
+
+func (_c *PriceAggregator_GetDispersions_Call) Run(run func()) *PriceAggregator_GetDispersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceAggregator_GetDispersions_Call) Return(_a0 map[string]oracletypes.PriceDispersion) *PriceAggregator_GetDispersions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceAggregator_GetDispersions_Call) RunAndReturn(run func() map[string]oracletypes.PriceDispersion) *PriceAggregator_GetDispersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceHistory provides a mock function with given fields: filter
+func (_m *PriceAggregator) GetPriceHistory(filter oracletypes.PriceHistoryFilter) []oracletypes.PriceRecord {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceHistory")
+	}
+
+	var r0 []oracletypes.PriceRecord
+	if rf, ok := ret.Get(0).(func(oracletypes.PriceHistoryFilter) []oracletypes.PriceRecord); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oracletypes.PriceRecord)
+		}
+	}
+
+	return r0
+}
+
+// PriceAggregator_GetPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceHistory'
+type PriceAggregator_GetPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetPriceHistory is a helper method to define mock.On call
+//   - filter oracletypes.PriceHistoryFilter
+func (_e *PriceAggregator_Expecter) GetPriceHistory(filter interface{}) *PriceAggregator_GetPriceHistory_Call {
+	return &PriceAggregator_GetPriceHistory_Call{Call: _e.mock.On("GetPriceHistory", filter)}
+}
+
+func (_c *PriceAggregator_GetPriceHistory_Call) Run(run func(filter oracletypes.PriceHistoryFilter)) *PriceAggregator_GetPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(oracletypes.PriceHistoryFilter))
+	})
+	return _c
+}
+
+func (_c *PriceAggregator_GetPriceHistory_Call) Return(_a0 []oracletypes.PriceRecord) *PriceAggregator_GetPriceHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceAggregator_GetPriceHistory_Call) RunAndReturn(run func(oracletypes.PriceHistoryFilter) []oracletypes.PriceRecord) *PriceAggregator_GetPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPrices provides a mock function with no fields
 func (_m *PriceAggregator) GetPrices() map[string]*big.Float {
 	ret := _m.Called()
@@ -102,6 +201,147 @@ func (_c *PriceAggregator_GetPrices_Call) RunAndReturn(run func() map[string]*bi
 	return _c
 }
 
+// GetPriorityTiers provides a mock function with no fields
+func (_m *PriceAggregator) GetPriorityTiers() map[string]uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriorityTiers")
+	}
+
+	var r0 map[string]uint32
+	if rf, ok := ret.Get(0).(func() map[string]uint32); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]uint32)
+		}
+	}
+
+	return r0
+}
+
+// PriceAggregator_GetPriorityTiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriorityTiers'
+type PriceAggregator_GetPriorityTiers_Call struct {
+	*mock.Call
+}
+
+// GetPriorityTiers is a helper method to define mock.On call
+func (_e *PriceAggregator_Expecter) GetPriorityTiers() *PriceAggregator_GetPriorityTiers_Call {
+	return &PriceAggregator_GetPriorityTiers_Call{Call: _e.mock.On("GetPriorityTiers")}
+}
+
+func (_c *PriceAggregator_GetPriorityTiers_Call) Run(run func()) *PriceAggregator_GetPriorityTiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceAggregator_GetPriorityTiers_Call) Return(_a0 map[string]uint32) *PriceAggregator_GetPriorityTiers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceAggregator_GetPriorityTiers_Call) RunAndReturn(run func() map[string]uint32) *PriceAggregator_GetPriorityTiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProviderHealth provides a mock function with no fields
+func (_m *PriceAggregator) GetProviderHealth() []oracletypes.ProviderHealth {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderHealth")
+	}
+
+	var r0 []oracletypes.ProviderHealth
+	if rf, ok := ret.Get(0).(func() []oracletypes.ProviderHealth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oracletypes.ProviderHealth)
+		}
+	}
+
+	return r0
+}
+
+// PriceAggregator_GetProviderHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProviderHealth'
+type PriceAggregator_GetProviderHealth_Call struct {
+	*mock.Call
+}
+
+// GetProviderHealth is a helper method to define mock.On call
+func (_e *PriceAggregator_Expecter) GetProviderHealth() *PriceAggregator_GetProviderHealth_Call {
+	return &PriceAggregator_GetProviderHealth_Call{Call: _e.mock.On("GetProviderHealth")}
+}
+
+func (_c *PriceAggregator_GetProviderHealth_Call) Run(run func()) *PriceAggregator_GetProviderHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceAggregator_GetProviderHealth_Call) Return(_a0 []oracletypes.ProviderHealth) *PriceAggregator_GetProviderHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceAggregator_GetProviderHealth_Call) RunAndReturn(run func() []oracletypes.ProviderHealth) *PriceAggregator_GetProviderHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProviderPriceDetails provides a mock function with no fields
+func (_m *PriceAggregator) GetProviderPriceDetails() map[string][]oracletypes.ProviderPriceDetail {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderPriceDetails")
+	}
+
+	var r0 map[string][]oracletypes.ProviderPriceDetail
+	if rf, ok := ret.Get(0).(func() map[string][]oracletypes.ProviderPriceDetail); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]oracletypes.ProviderPriceDetail)
+		}
+	}
+
+	return r0
+}
+
+// PriceAggregator_GetProviderPriceDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProviderPriceDetails'
+type PriceAggregator_GetProviderPriceDetails_Call struct {
+	*mock.Call
+}
+
+// GetProviderPriceDetails is a helper method to define mock.On call
+func (_e *PriceAggregator_Expecter) GetProviderPriceDetails() *PriceAggregator_GetProviderPriceDetails_Call {
+	return &PriceAggregator_GetProviderPriceDetails_Call{Call: _e.mock.On("GetProviderPriceDetails")}
+}
+
+func (_c *PriceAggregator_GetProviderPriceDetails_Call) Run(run func()) *PriceAggregator_GetProviderPriceDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceAggregator_GetProviderPriceDetails_Call) Return(_a0 map[string][]oracletypes.ProviderPriceDetail) *PriceAggregator_GetProviderPriceDetails_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceAggregator_GetProviderPriceDetails_Call) RunAndReturn(run func() map[string][]oracletypes.ProviderPriceDetail) *PriceAggregator_GetProviderPriceDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Reset provides a mock function with no fields
 func (_m *PriceAggregator) Reset() {
 	_m.Called()
@@ -168,6 +408,74 @@ func (_c *PriceAggregator_SetProviderPrices_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// SetProviderTimestamps provides a mock function with given fields: provider, timestamps
+func (_m *PriceAggregator) SetProviderTimestamps(provider string, timestamps map[string]time.Time) {
+	_m.Called(provider, timestamps)
+}
+
+// PriceAggregator_SetProviderTimestamps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProviderTimestamps'
+type PriceAggregator_SetProviderTimestamps_Call struct {
+	*mock.Call
+}
+
+// SetProviderTimestamps is a helper method to define mock.On call
+//   - provider string
+//   - timestamps map[string]time.Time
+func (_e *PriceAggregator_Expecter) SetProviderTimestamps(provider interface{}, timestamps interface{}) *PriceAggregator_SetProviderTimestamps_Call {
+	return &PriceAggregator_SetProviderTimestamps_Call{Call: _e.mock.On("SetProviderTimestamps", provider, timestamps)}
+}
+
+func (_c *PriceAggregator_SetProviderTimestamps_Call) Run(run func(provider string, timestamps map[string]time.Time)) *PriceAggregator_SetProviderTimestamps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[string]time.Time))
+	})
+	return _c
+}
+
+func (_c *PriceAggregator_SetProviderTimestamps_Call) Return() *PriceAggregator_SetProviderTimestamps_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceAggregator_SetProviderTimestamps_Call) RunAndReturn(run func(string, map[string]time.Time)) *PriceAggregator_SetProviderTimestamps_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetProviderWeights provides a mock function with given fields: provider, weights
+func (_m *PriceAggregator) SetProviderWeights(provider string, weights map[string]*big.Float) {
+	_m.Called(provider, weights)
+}
+
+// PriceAggregator_SetProviderWeights_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProviderWeights'
+type PriceAggregator_SetProviderWeights_Call struct {
+	*mock.Call
+}
+
+// SetProviderWeights is a helper method to define mock.On call
+//   - provider string
+//   - weights map[string]*big.Float
+func (_e *PriceAggregator_Expecter) SetProviderWeights(provider interface{}, weights interface{}) *PriceAggregator_SetProviderWeights_Call {
+	return &PriceAggregator_SetProviderWeights_Call{Call: _e.mock.On("SetProviderWeights", provider, weights)}
+}
+
+func (_c *PriceAggregator_SetProviderWeights_Call) Run(run func(provider string, weights map[string]*big.Float)) *PriceAggregator_SetProviderWeights_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[string]*big.Float))
+	})
+	return _c
+}
+
+func (_c *PriceAggregator_SetProviderWeights_Call) Return() *PriceAggregator_SetProviderWeights_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceAggregator_SetProviderWeights_Call) RunAndReturn(run func(string, map[string]*big.Float)) *PriceAggregator_SetProviderWeights_Call {
+	_c.Run(run)
+	return _c
+}
+
 // UpdateMarketMap provides a mock function with given fields: _a0
 func (_m *PriceAggregator) UpdateMarketMap(_a0 types.MarketMap) {
 	_m.Called(_a0)
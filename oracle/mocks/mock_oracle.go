@@ -6,8 +6,12 @@ import (
 	context "context"
 	big "math/big"
 
+	config "github.com/skip-mev/connect/v2/oracle/config"
+
 	mock "github.com/stretchr/testify/mock"
 
+	oracletypes "github.com/skip-mev/connect/v2/oracle/types"
+
 	time "time"
 
 	types "github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -116,6 +120,148 @@ func (_c *Oracle_GetMarketMap_Call) RunAndReturn(run func() types.MarketMap) *Or
 	return _c
 }
 
+// GetPriceDispersions provides a mock function with no fields
+func (_m *Oracle) GetPriceDispersions() map[string]oracletypes.PriceDispersion {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceDispersions")
+	}
+
+	var r0 map[string]oracletypes.PriceDispersion
+	if rf, ok := ret.Get(0).(func() map[string]oracletypes.PriceDispersion); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]oracletypes.PriceDispersion)
+		}
+	}
+
+	return r0
+}
+
+// Oracle_GetPriceDispersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceDispersions'
+type Oracle_GetPriceDispersions_Call struct {
+	*mock.Call
+}
+
+// GetPriceDispersions is a helper method to define mock.On call
+func (_e *Oracle_Expecter) GetPriceDispersions() *Oracle_GetPriceDispersions_Call {
+	return &Oracle_GetPriceDispersions_Call{Call: _e.mock.On("GetPriceDispersions")}
+}
+
+func (_c *Oracle_GetPriceDispersions_Call) Run(run func()) *Oracle_GetPriceDispersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Oracle_GetPriceDispersions_Call) Return(_a0 map[string]oracletypes.PriceDispersion) *Oracle_GetPriceDispersions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_GetPriceDispersions_Call) RunAndReturn(run func() map[string]oracletypes.PriceDispersion) *Oracle_GetPriceDispersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceHistory provides a mock function with given fields: filter
+func (_m *Oracle) GetPriceHistory(filter oracletypes.PriceHistoryFilter) []oracletypes.PriceRecord {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceHistory")
+	}
+
+	var r0 []oracletypes.PriceRecord
+	if rf, ok := ret.Get(0).(func(oracletypes.PriceHistoryFilter) []oracletypes.PriceRecord); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oracletypes.PriceRecord)
+		}
+	}
+
+	return r0
+}
+
+// Oracle_GetPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceHistory'
+type Oracle_GetPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetPriceHistory is a helper method to define mock.On call
+//   - filter oracletypes.PriceHistoryFilter
+func (_e *Oracle_Expecter) GetPriceHistory(filter interface{}) *Oracle_GetPriceHistory_Call {
+	return &Oracle_GetPriceHistory_Call{Call: _e.mock.On("GetPriceHistory", filter)}
+}
+
+func (_c *Oracle_GetPriceHistory_Call) Run(run func(filter oracletypes.PriceHistoryFilter)) *Oracle_GetPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(oracletypes.PriceHistoryFilter))
+	})
+	return _c
+}
+
+func (_c *Oracle_GetPriceHistory_Call) Return(_a0 []oracletypes.PriceRecord) *Oracle_GetPriceHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_GetPriceHistory_Call) RunAndReturn(run func(oracletypes.PriceHistoryFilter) []oracletypes.PriceRecord) *Oracle_GetPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceTiers provides a mock function with no fields
+func (_m *Oracle) GetPriceTiers() map[string]uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceTiers")
+	}
+
+	var r0 map[string]uint32
+	if rf, ok := ret.Get(0).(func() map[string]uint32); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]uint32)
+		}
+	}
+
+	return r0
+}
+
+// Oracle_GetPriceTiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceTiers'
+type Oracle_GetPriceTiers_Call struct {
+	*mock.Call
+}
+
+// GetPriceTiers is a helper method to define mock.On call
+func (_e *Oracle_Expecter) GetPriceTiers() *Oracle_GetPriceTiers_Call {
+	return &Oracle_GetPriceTiers_Call{Call: _e.mock.On("GetPriceTiers")}
+}
+
+func (_c *Oracle_GetPriceTiers_Call) Run(run func()) *Oracle_GetPriceTiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Oracle_GetPriceTiers_Call) Return(_a0 map[string]uint32) *Oracle_GetPriceTiers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_GetPriceTiers_Call) RunAndReturn(run func() map[string]uint32) *Oracle_GetPriceTiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPrices provides a mock function with no fields
 func (_m *Oracle) GetPrices() map[string]*big.Float {
 	ret := _m.Called()
@@ -163,6 +309,147 @@ func (_c *Oracle_GetPrices_Call) RunAndReturn(run func() map[string]*big.Float)
 	return _c
 }
 
+// GetProviderHealth provides a mock function with no fields
+func (_m *Oracle) GetProviderHealth() []oracletypes.ProviderHealth {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderHealth")
+	}
+
+	var r0 []oracletypes.ProviderHealth
+	if rf, ok := ret.Get(0).(func() []oracletypes.ProviderHealth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oracletypes.ProviderHealth)
+		}
+	}
+
+	return r0
+}
+
+// Oracle_GetProviderHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProviderHealth'
+type Oracle_GetProviderHealth_Call struct {
+	*mock.Call
+}
+
+// GetProviderHealth is a helper method to define mock.On call
+func (_e *Oracle_Expecter) GetProviderHealth() *Oracle_GetProviderHealth_Call {
+	return &Oracle_GetProviderHealth_Call{Call: _e.mock.On("GetProviderHealth")}
+}
+
+func (_c *Oracle_GetProviderHealth_Call) Run(run func()) *Oracle_GetProviderHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Oracle_GetProviderHealth_Call) Return(_a0 []oracletypes.ProviderHealth) *Oracle_GetProviderHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_GetProviderHealth_Call) RunAndReturn(run func() []oracletypes.ProviderHealth) *Oracle_GetProviderHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProviderPriceDetails provides a mock function with no fields
+func (_m *Oracle) GetProviderPriceDetails() map[string][]oracletypes.ProviderPriceDetail {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderPriceDetails")
+	}
+
+	var r0 map[string][]oracletypes.ProviderPriceDetail
+	if rf, ok := ret.Get(0).(func() map[string][]oracletypes.ProviderPriceDetail); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]oracletypes.ProviderPriceDetail)
+		}
+	}
+
+	return r0
+}
+
+// Oracle_GetProviderPriceDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProviderPriceDetails'
+type Oracle_GetProviderPriceDetails_Call struct {
+	*mock.Call
+}
+
+// GetProviderPriceDetails is a helper method to define mock.On call
+func (_e *Oracle_Expecter) GetProviderPriceDetails() *Oracle_GetProviderPriceDetails_Call {
+	return &Oracle_GetProviderPriceDetails_Call{Call: _e.mock.On("GetProviderPriceDetails")}
+}
+
+func (_c *Oracle_GetProviderPriceDetails_Call) Run(run func()) *Oracle_GetProviderPriceDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Oracle_GetProviderPriceDetails_Call) Return(_a0 map[string][]oracletypes.ProviderPriceDetail) *Oracle_GetProviderPriceDetails_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_GetProviderPriceDetails_Call) RunAndReturn(run func() map[string][]oracletypes.ProviderPriceDetail) *Oracle_GetProviderPriceDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProviderStatuses provides a mock function with no fields
+func (_m *Oracle) GetProviderStatuses() []oracletypes.ProviderStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderStatuses")
+	}
+
+	var r0 []oracletypes.ProviderStatus
+	if rf, ok := ret.Get(0).(func() []oracletypes.ProviderStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oracletypes.ProviderStatus)
+		}
+	}
+
+	return r0
+}
+
+// Oracle_GetProviderStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProviderStatuses'
+type Oracle_GetProviderStatuses_Call struct {
+	*mock.Call
+}
+
+// GetProviderStatuses is a helper method to define mock.On call
+func (_e *Oracle_Expecter) GetProviderStatuses() *Oracle_GetProviderStatuses_Call {
+	return &Oracle_GetProviderStatuses_Call{Call: _e.mock.On("GetProviderStatuses")}
+}
+
+func (_c *Oracle_GetProviderStatuses_Call) Run(run func()) *Oracle_GetProviderStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Oracle_GetProviderStatuses_Call) Return(_a0 []oracletypes.ProviderStatus) *Oracle_GetProviderStatuses_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_GetProviderStatuses_Call) RunAndReturn(run func() []oracletypes.ProviderStatus) *Oracle_GetProviderStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsRunning provides a mock function with no fields
 func (_m *Oracle) IsRunning() bool {
 	ret := _m.Called()
@@ -286,6 +573,111 @@ func (_c *Oracle_Stop_Call) RunAndReturn(run func()) *Oracle_Stop_Call {
 	return _c
 }
 
+// SubscribePrices provides a mock function with no fields
+func (_m *Oracle) SubscribePrices() (<-chan time.Time, func()) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribePrices")
+	}
+
+	var r0 <-chan time.Time
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan time.Time, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan time.Time); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// Oracle_SubscribePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribePrices'
+type Oracle_SubscribePrices_Call struct {
+	*mock.Call
+}
+
+// SubscribePrices is a helper method to define mock.On call
+func (_e *Oracle_Expecter) SubscribePrices() *Oracle_SubscribePrices_Call {
+	return &Oracle_SubscribePrices_Call{Call: _e.mock.On("SubscribePrices")}
+}
+
+func (_c *Oracle_SubscribePrices_Call) Run(run func()) *Oracle_SubscribePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Oracle_SubscribePrices_Call) Return(_a0 <-chan time.Time, _a1 func()) *Oracle_SubscribePrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Oracle_SubscribePrices_Call) RunAndReturn(run func() (<-chan time.Time, func())) *Oracle_SubscribePrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: cfg
+func (_m *Oracle) UpdateConfig(cfg config.OracleConfig) error {
+	ret := _m.Called(cfg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(config.OracleConfig) error); ok {
+		r0 = rf(cfg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Oracle_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type Oracle_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - cfg config.OracleConfig
+func (_e *Oracle_Expecter) UpdateConfig(cfg interface{}) *Oracle_UpdateConfig_Call {
+	return &Oracle_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", cfg)}
+}
+
+func (_c *Oracle_UpdateConfig_Call) Run(run func(cfg config.OracleConfig)) *Oracle_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(config.OracleConfig))
+	})
+	return _c
+}
+
+func (_c *Oracle_UpdateConfig_Call) Return(_a0 error) *Oracle_UpdateConfig_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_UpdateConfig_Call) RunAndReturn(run func(config.OracleConfig) error) *Oracle_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOracle creates a new instance of Oracle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracle(t interface {
@@ -3,6 +3,7 @@ package oracle
 import (
 	"context"
 	"errors"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -12,9 +13,11 @@ import (
 	"github.com/skip-mev/connect/v2/oracle/config"
 	oraclemetrics "github.com/skip-mev/connect/v2/oracle/metrics"
 	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/providers/base"
 	apimetrics "github.com/skip-mev/connect/v2/providers/base/api/metrics"
 	providermetrics "github.com/skip-mev/connect/v2/providers/base/metrics"
 	wsmetrics "github.com/skip-mev/connect/v2/providers/base/websocket/metrics"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
 	mmclienttypes "github.com/skip-mev/connect/v2/service/clients/marketmap/types"
 	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
@@ -35,6 +38,8 @@ type OracleImpl struct { //nolint:revive
 	mainCancel context.CancelFunc
 	// wg is the wait group for the oracle.
 	wg sync.WaitGroup
+	// updateIntervalCh is used to notify the main loop of a reloaded update interval.
+	updateIntervalCh chan time.Duration
 
 	// -------------------Stateful Fields-------------------//
 	//
@@ -47,6 +52,14 @@ type OracleImpl struct { //nolint:revive
 	aggregator PriceAggregator
 	// lastPriceSync is the last time the oracle successfully updated its prices.
 	lastPriceSync time.Time
+	// restoredPrices are the provider prices restored from a snapshot that have not yet been
+	// superseded by a live price or expired. These are indexed by provider -> offChainTicker.
+	restoredPrices map[string]map[string]SnapshotPrice
+	// lastProviderPrices are the latest prices set for each provider. These are included in
+	// snapshots and indexed by provider -> offChainTicker.
+	lastProviderPrices map[string]map[string]SnapshotPrice
+	// subscriptions are notified every time the oracle updates its prices.
+	subscriptions *priceSubscriptions
 
 	// -------------------Oracle Configuration Fields-------------------//
 	//
@@ -105,14 +118,18 @@ func New(
 	}
 
 	orc := &OracleImpl{
-		cfg:             cfg,
-		aggregator:      aggregator,
-		priceProviders:  make(map[string]ProviderState), // this will be initialized via the Init method.
-		logger:          zap.NewNop(),
-		wsMetrics:       wsmetrics.NewWebSocketMetricsFromConfig(cfg.Metrics),
-		apiMetrics:      apimetrics.NewAPIMetricsFromConfig(cfg.Metrics),
-		providerMetrics: providermetrics.NewProviderMetricsFromConfig(cfg.Metrics),
-		metrics:         oraclemetrics.NewNopMetrics(),
+		cfg:                cfg,
+		aggregator:         aggregator,
+		priceProviders:     make(map[string]ProviderState), // this will be initialized via the Init method.
+		restoredPrices:     make(map[string]map[string]SnapshotPrice),
+		lastProviderPrices: make(map[string]map[string]SnapshotPrice),
+		subscriptions:      newPriceSubscriptions(),
+		updateIntervalCh:   make(chan time.Duration, 1),
+		logger:             zap.NewNop(),
+		wsMetrics:          wsmetrics.NewWebSocketMetricsFromConfig(cfg.Metrics),
+		apiMetrics:         apimetrics.NewAPIMetricsFromConfig(cfg.Metrics),
+		providerMetrics:    providermetrics.NewProviderMetricsFromConfig(cfg.Metrics),
+		metrics:            oraclemetrics.NewNopMetrics(),
 	}
 
 	for _, opt := range opts {
@@ -151,3 +168,77 @@ func (o *OracleImpl) GetLastSyncTime() time.Time {
 func (o *OracleImpl) GetPrices() types.Prices {
 	return o.aggregator.GetPrices()
 }
+
+// GetPriceDispersions returns the dispersion of the converted prices that were used to
+// calculate each of the oracle's prices.
+func (o *OracleImpl) GetPriceDispersions() types.Dispersions {
+	return o.aggregator.GetDispersions()
+}
+
+// GetPriceTiers returns the lowest priority tier of the provider configs that were used to
+// calculate each of the oracle's prices.
+func (o *OracleImpl) GetPriceTiers() types.PriorityTiers {
+	return o.aggregator.GetPriorityTiers()
+}
+
+// GetProviderHealth returns the health of every (provider, ticker) pair tracked by the
+// aggregator, including whether the pair is quarantined.
+func (o *OracleImpl) GetProviderHealth() []types.ProviderHealth {
+	return o.aggregator.GetProviderHealth()
+}
+
+// GetPriceHistory returns the price records retained by the aggregator that match the filter.
+func (o *OracleImpl) GetPriceHistory(filter types.PriceHistoryFilter) []types.PriceRecord {
+	return o.aggregator.GetPriceHistory(filter)
+}
+
+// SubscribePrices returns a channel that receives the sync time every time the oracle updates
+// its prices, along with a function that cancels the subscription. Updates are coalesced, so
+// a subscriber that falls behind only receives the latest update.
+func (o *OracleImpl) SubscribePrices() (<-chan time.Time, func()) {
+	return o.subscriptions.Subscribe()
+}
+
+// GetProviderStatuses returns the status of every price provider and of the market map provider,
+// if any, sorted by name.
+func (o *OracleImpl) GetProviderStatuses() []types.ProviderStatus {
+	o.mut.RLock()
+	defer o.mut.RUnlock()
+
+	statuses := make([]types.ProviderStatus, 0, len(o.priceProviders)+1)
+	for _, state := range o.priceProviders {
+		statuses = append(statuses, providerStatus(state.Provider, false))
+	}
+
+	if o.mmProvider != nil {
+		statuses = append(statuses, providerStatus(o.mmProvider, true))
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	return statuses
+}
+
+// providerStatus returns the status of the provider.
+func providerStatus[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	provider *base.Provider[K, V],
+	marketMap bool,
+) types.ProviderStatus {
+	return types.ProviderStatus{
+		Name:         provider.Name(),
+		Type:         provider.Type(),
+		MarketMap:    marketMap,
+		Running:      provider.IsRunning(),
+		TickerCount:  len(provider.GetIDs()),
+		LastUpdated:  provider.LastUpdated(),
+		RecentErrors: provider.RecentErrors(),
+	}
+}
+
+// GetProviderPriceDetails returns the details of the price of each provider config of every
+// ticker in the aggregator's latest aggregation round.
+func (o *OracleImpl) GetProviderPriceDetails() types.ProviderPriceDetails {
+	return o.aggregator.GetProviderPriceDetails()
+}
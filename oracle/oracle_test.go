@@ -1,6 +1,7 @@
 package oracle_test
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 	"time"
@@ -120,3 +121,49 @@ func (s *OracleTestSuite) TestErrorsWhenNoAggregator() {
 	_, err := oracle.New(oracleCfg, nil)
 	s.Require().ErrorContains(err, "aggregator is required")
 }
+
+func (s *OracleTestSuite) TestSubscribePrices() {
+	cfg := config.OracleConfig{
+		UpdateInterval: 50 * time.Millisecond,
+		MaxPriceAge:    1 * time.Minute,
+		Metrics:        oracleCfg.Metrics,
+		Host:           oracleCfg.Host,
+		Port:           oracleCfg.Port,
+	}
+
+	o, err := oracle.New(cfg, noOpPriceAggregator{}, oracle.WithLogger(s.logger))
+	s.Require().NoError(err)
+
+	updates, unsubscribe := o.SubscribePrices()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go o.Start(ctx)
+
+	// each update notifies the subscriber of the sync time of the update
+	var last time.Time
+	for i := 0; i < 2; i++ {
+		select {
+		case syncTime := <-updates:
+			s.Require().True(syncTime.After(last))
+			last = syncTime
+		case <-time.After(10 * cfg.UpdateInterval):
+			s.T().Fatal("no price update received")
+		}
+	}
+
+	// no updates are received after unsubscribing, other than one that was already pending
+	unsubscribe()
+	select {
+	case <-updates:
+	default:
+	}
+
+	select {
+	case <-updates:
+		s.T().Fatal("price update received after unsubscribing")
+	case <-time.After(3 * cfg.UpdateInterval):
+	}
+
+	o.Stop()
+}
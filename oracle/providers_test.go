@@ -198,3 +198,50 @@ func (s *OracleTestSuite) TestProviders() {
 		})
 	}
 }
+
+func (s *OracleTestSuite) TestProviderStatuses() {
+	providerCfg := providerCfg1
+	providerCfg.Name = "api2"
+	providerCfg.API.Name = "api2"
+
+	provider1 := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+		s.T(),
+		s.logger,
+		providerCfg,
+		s.currencyPairs[:1],
+		nil,
+		200*time.Millisecond,
+	)
+	provider2 := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+		s.T(),
+		s.logger,
+		providerCfg1,
+		s.currencyPairs,
+		nil,
+		200*time.Millisecond,
+	)
+
+	testOracle, err := oracle.New(
+		oracleCfg,
+		mathtestutils.NewMedianAggregator(),
+		oracle.WithLogger(s.logger),
+		oracle.WithPriceProviders(provider1, provider2),
+	)
+	s.Require().NoError(err)
+
+	// the statuses are sorted by name
+	s.Require().Equal([]types.ProviderStatus{
+		{
+			Name:         providerCfg1.Name,
+			Type:         providertypes.API,
+			TickerCount:  len(s.currencyPairs),
+			RecentErrors: []providertypes.ProviderError{},
+		},
+		{
+			Name:         providerCfg.Name,
+			Type:         providertypes.API,
+			TickerCount:  1,
+			RecentErrors: []providertypes.ProviderError{},
+		},
+	}, testOracle.GetProviderStatuses())
+}
@@ -0,0 +1,189 @@
+package oracle
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	mmclienttypes "github.com/skip-mev/connect/v2/service/clients/marketmap/types"
+)
+
+// maxPriceAgeSetter is implemented by price aggregators that use the oracle's MaxPriceAge, so
+// that a hot-reloaded MaxPriceAge is also applied to the aggregator.
+type maxPriceAgeSetter interface {
+	SetMaxPriceAge(maxPriceAge time.Duration)
+}
+
+// UpdateConfig hot-reloads the oracle's configuration. The new configuration is validated and
+// diffed against the running one, and only the price providers whose configuration changed are
+// restarted. Providers added to the configuration are started and providers removed from it
+// are stopped. A change to the update interval takes effect on the next tick, and a change to
+// the max price age is also applied to the price aggregator.
+//
+// The server, metrics, aggregation, snapshot, readiness, tls and market map provider configurations are only
+// read when the sidecar starts, so a configuration that changes any of them is rejected. If the
+// configuration is rejected, the running configuration is left untouched.
+func (o *OracleImpl) UpdateConfig(cfg config.OracleConfig) error {
+	if err := cfg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid oracle config: %w", err)
+	}
+
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	if fields := restartRequiredFields(o.cfg, cfg); len(fields) > 0 {
+		return fmt.Errorf("changing %s requires a restart", strings.Join(fields, ", "))
+	}
+
+	added, changed, removed := diffPriceProviders(o.cfg, cfg)
+
+	// Create every new provider before touching the running ones, so that a provider that
+	// fails to be created leaves the running configuration untouched.
+	states := make(map[string]ProviderState, len(added)+len(changed))
+	for _, name := range append(added, changed...) {
+		state, err := o.newPriceProviderState(o.mainCtx, cfg.Providers[name])
+		if err != nil {
+			return fmt.Errorf("failed to create %s provider: %w", name, err)
+		}
+		states[name] = state
+	}
+
+	for _, name := range append(changed, removed...) {
+		if state, ok := o.priceProviders[name]; ok {
+			state.Provider.Stop()
+		}
+
+		delete(o.priceProviders, name)
+		delete(o.restoredPrices, name)
+		delete(o.lastProviderPrices, name)
+	}
+
+	for name, state := range states {
+		// The oracle starts its providers once it is initialized, so providers are only started
+		// here if the oracle is already running.
+		if o.mainCtx != nil {
+			tickers, err := types.ProviderTickersFromMarketMap(name, o.marketMap)
+			if err != nil {
+				o.logger.Error("failed to create provider market map", zap.String("provider", name), zap.Error(err))
+				continue
+			}
+
+			if state, err = o.UpdateProviderState(tickers, state); err != nil {
+				o.logger.Error("failed to update provider state", zap.String("provider", name), zap.Error(err))
+				continue
+			}
+		}
+
+		o.priceProviders[name] = state
+	}
+
+	if cfg.UpdateInterval != o.cfg.UpdateInterval {
+		// Replace any update interval that the main loop has not yet applied.
+		select {
+		case <-o.updateIntervalCh:
+		default:
+		}
+		o.updateIntervalCh <- cfg.UpdateInterval
+	}
+
+	if cfg.MaxPriceAge != o.cfg.MaxPriceAge {
+		if setter, ok := o.aggregator.(maxPriceAgeSetter); ok {
+			setter.SetMaxPriceAge(cfg.MaxPriceAge)
+		}
+	}
+
+	o.cfg = cfg
+	o.logger.Info(
+		"updated oracle config",
+		zap.Strings("added_providers", added),
+		zap.Strings("restarted_providers", changed),
+		zap.Strings("removed_providers", removed),
+		zap.Duration("update_interval", cfg.UpdateInterval),
+		zap.Duration("max_price_age", cfg.MaxPriceAge),
+	)
+
+	return nil
+}
+
+// restartRequiredFields returns the names of the fields that differ between the two
+// configurations and can only be applied by restarting the sidecar.
+func restartRequiredFields(current, updated config.OracleConfig) []string {
+	var fields []string
+	if current.Host != updated.Host {
+		fields = append(fields, "host")
+	}
+	if current.Port != updated.Port {
+		fields = append(fields, "port")
+	}
+	if !reflect.DeepEqual(current.Metrics, updated.Metrics) {
+		fields = append(fields, "metrics")
+	}
+	if !reflect.DeepEqual(current.Aggregation, updated.Aggregation) {
+		fields = append(fields, "aggregation")
+	}
+	if current.Snapshot != updated.Snapshot {
+		fields = append(fields, "snapshot")
+	}
+	if current.Readiness != updated.Readiness {
+		fields = append(fields, "readiness")
+	}
+	if !reflect.DeepEqual(current.TLS, updated.TLS) {
+		fields = append(fields, "tls")
+	}
+	if !reflect.DeepEqual(marketMapProviders(current), marketMapProviders(updated)) {
+		fields = append(fields, "the market map provider")
+	}
+
+	return fields
+}
+
+// marketMapProviders returns the market map provider configurations of the oracle config.
+func marketMapProviders(cfg config.OracleConfig) map[string]config.ProviderConfig {
+	providers := make(map[string]config.ProviderConfig)
+	for name, provider := range cfg.Providers {
+		if provider.Type == mmclienttypes.ConfigType {
+			providers[name] = provider
+		}
+	}
+
+	return providers
+}
+
+// diffPriceProviders returns the sorted names of the price providers that were added to,
+// changed in, and removed from the oracle config.
+func diffPriceProviders(current, updated config.OracleConfig) (added, changed, removed []string) {
+	for name, provider := range updated.Providers {
+		if provider.Type != types.ConfigType {
+			continue
+		}
+
+		existing, ok := current.Providers[name]
+		switch {
+		case !ok || existing.Type != types.ConfigType:
+			added = append(added, name)
+		case !reflect.DeepEqual(existing, provider):
+			changed = append(changed, name)
+		}
+	}
+
+	for name, provider := range current.Providers {
+		if provider.Type != types.ConfigType {
+			continue
+		}
+
+		if existing, ok := updated.Providers[name]; !ok || existing.Type != types.ConfigType {
+			removed = append(removed, name)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(changed)
+	sort.Strings(removed)
+	return added, changed, removed
+}
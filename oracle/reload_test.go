@@ -0,0 +1,158 @@
+package oracle_test
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle"
+	"github.com/skip-mev/connect/v2/oracle/config"
+	oracletypes "github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	oraclefactory "github.com/skip-mev/connect/v2/providers/factories/oracle"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+	"github.com/skip-mev/connect/v2/providers/websockets/okx"
+)
+
+func TestUpdateConfig(t *testing.T) {
+	newOracle := func(t *testing.T) *oracle.OracleImpl {
+		t.Helper()
+
+		orc, err := oracle.New(
+			oracleCfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithMarketMap(marketMap),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+		)
+		require.NoError(t, err)
+
+		o := orc.(*oracle.OracleImpl)
+		require.NoError(t, o.Init(context.TODO()))
+		return o
+	}
+
+	// changedCoinbase returns a copy of the oracle config with a changed coinbase config.
+	changedCoinbase := func() config.OracleConfig {
+		cfg := copyConfig(oracleCfg)
+		provider := cfg.Providers[coinbase.Name]
+		provider.API.Timeout += time.Second
+		cfg.Providers[coinbase.Name] = provider
+		return cfg
+	}
+
+	t.Run("rejects an invalid config", func(t *testing.T) {
+		o := newOracle(t)
+		before := maps.Clone(o.GetProviderState())
+
+		cfg := changedCoinbase()
+		cfg.UpdateInterval = 0
+		require.ErrorContains(t, o.UpdateConfig(cfg), "invalid oracle config")
+		require.Equal(t, before, o.GetProviderState())
+	})
+
+	t.Run("rejects a config that requires a restart", func(t *testing.T) {
+		o := newOracle(t)
+		before := maps.Clone(o.GetProviderState())
+
+		cfg := changedCoinbase()
+		cfg.Port = "8081"
+		cfg.Metrics.Enabled = true
+		cfg.Metrics.PrometheusServerAddress = "0.0.0.0:8002"
+		require.ErrorContains(t, o.UpdateConfig(cfg), "changing port, metrics requires a restart")
+		require.Equal(t, before, o.GetProviderState())
+	})
+
+	t.Run("only replaces the providers whose config changed", func(t *testing.T) {
+		o := newOracle(t)
+		before := maps.Clone(o.GetProviderState())
+
+		cfg := changedCoinbase()
+		require.NoError(t, o.UpdateConfig(cfg))
+
+		after := o.GetProviderState()
+		require.Len(t, after, len(oracleCfg.Providers))
+		require.NotSame(t, before[coinbase.Name].Provider, after[coinbase.Name].Provider)
+		require.Equal(t, cfg.Providers[coinbase.Name], after[coinbase.Name].Cfg)
+		require.Same(t, before[binance.Name].Provider, after[binance.Name].Provider)
+		require.Same(t, before[okx.Name].Provider, after[okx.Name].Provider)
+		checkProviderState(
+			t,
+			[]oracletypes.ProviderTicker{coinbasebtcusd, coinbaseethusd},
+			coinbase.Name,
+			providertypes.API,
+			false,
+			after[coinbase.Name],
+		)
+	})
+
+	t.Run("applies the max price age to the aggregator", func(t *testing.T) {
+		aggregator := &maxPriceAgeAggregator{}
+		orc, err := oracle.New(
+			oracleCfg,
+			aggregator,
+			oracle.WithLogger(logger),
+			oracle.WithMarketMap(marketMap),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+		)
+		require.NoError(t, err)
+		o := orc.(*oracle.OracleImpl)
+		require.NoError(t, o.Init(context.TODO()))
+
+		cfg := copyConfig(oracleCfg)
+		cfg.MaxPriceAge += time.Minute
+		require.NoError(t, o.UpdateConfig(cfg))
+		require.Equal(t, cfg.MaxPriceAge, aggregator.maxPriceAge)
+	})
+
+	t.Run("adds and removes providers", func(t *testing.T) {
+		o := newOracle(t)
+
+		cfg := copyConfig(oracleCfg)
+		delete(cfg.Providers, okx.Name)
+		require.NoError(t, o.UpdateConfig(cfg))
+		require.NotContains(t, o.GetProviderState(), okx.Name)
+
+		require.NoError(t, o.UpdateConfig(oracleCfg))
+		require.Contains(t, o.GetProviderState(), okx.Name)
+	})
+
+	t.Run("restarts the changed providers of a running oracle", func(t *testing.T) {
+		o := newOracle(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go o.Start(ctx) //nolint:errcheck
+		defer o.Stop()
+
+		require.Eventually(t, func() bool {
+			state, ok := o.GetProviderState()[coinbase.Name]
+			return ok && state.Provider.IsRunning()
+		}, 5*time.Second, 100*time.Millisecond)
+		before := maps.Clone(o.GetProviderState())[coinbase.Name]
+
+		require.NoError(t, o.UpdateConfig(changedCoinbase()))
+
+		after := o.GetProviderState()[coinbase.Name]
+		require.NotSame(t, before.Provider, after.Provider)
+		require.Eventually(t, func() bool {
+			return !before.Provider.IsRunning() && after.Provider.IsRunning()
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+}
+
+// maxPriceAgeAggregator is a price aggregator that records the max price age it is given.
+type maxPriceAgeAggregator struct {
+	noOpPriceAggregator
+	maxPriceAge time.Duration
+}
+
+func (a *maxPriceAgeAggregator) SetMaxPriceAge(maxPriceAge time.Duration) {
+	a.maxPriceAge = maxPriceAge
+}
@@ -0,0 +1,230 @@
+package oracle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// Snapshot is the state the oracle persists to disk so that it can warm start after a restart.
+type Snapshot struct {
+	// Timestamp is the time at which the snapshot was taken.
+	Timestamp time.Time `json:"timestamp"`
+	// MarketMap is the oracle's market map.
+	MarketMap mmtypes.MarketMap `json:"market_map"`
+	// IndexPrices are the aggregator's unscaled index prices, indexed by ticker. These are
+	// only restored if the snapshot is younger than the max price age.
+	IndexPrices types.Prices `json:"index_prices"`
+	// ProviderPrices are the latest prices reported by each provider, indexed by provider ->
+	// offChainTicker.
+	ProviderPrices map[string]map[string]SnapshotPrice `json:"provider_prices"`
+}
+
+// SnapshotPrice is a provider price along with the time at which the provider reported it.
+type SnapshotPrice struct {
+	// Price is the price reported by the provider.
+	Price *big.Float `json:"price"`
+	// Weight is the weight reported by the provider, if any.
+	Weight *big.Float `json:"weight,omitempty"`
+	// Timestamp is the time at which the provider reported the price.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// indexPriceCache is implemented by price aggregators that cache the index prices used to
+// convert prices between tickers. The index prices of such aggregators are included in
+// snapshots.
+type indexPriceCache interface {
+	GetIndexPrices() types.Prices
+	SetIndexPrices(prices types.Prices)
+}
+
+// ReadSnapshot reads a snapshot from the given file.
+func ReadSnapshot(path string) (Snapshot, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return Snapshot{}, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// WriteSnapshot writes the snapshot to the given file. The snapshot is first written to a
+// temporary file which then replaces the given file, so a crash while writing never leaves
+// behind a partial snapshot.
+func WriteSnapshot(path string, snapshot Snapshot) error {
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// RestoreSnapshot restores the oracle's state from the configured snapshot file, if snapshots
+// are enabled and the file exists. Specifically:
+//
+//  1. The market map is restored if the oracle was not configured with one, so that providers
+//     can start before the market map provider has fetched the latest market map.
+//  2. The index prices are restored if the snapshot is younger than the max price age, so that
+//     conversion markets are available from the first aggregation.
+//  3. Provider prices younger than the max price age fill in for the tickers each provider has
+//     not reported since the restart, until they are older than the max price age.
+//
+// This must be called before the oracle is started.
+func (o *OracleImpl) RestoreSnapshot() error {
+	if !o.cfg.Snapshot.Enabled() {
+		return nil
+	}
+
+	snapshot, err := ReadSnapshot(o.cfg.Snapshot.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		o.logger.Info("no snapshot found; starting with an empty state", zap.String("path", o.cfg.Snapshot.Path))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	now := time.Now().UTC()
+	if len(o.marketMap.Markets) == 0 && len(snapshot.MarketMap.Markets) > 0 {
+		if err := snapshot.MarketMap.ValidateBasic(); err != nil {
+			o.logger.Error("snapshot market map is invalid; ignoring it", zap.Error(err))
+		} else {
+			o.marketMap = snapshot.MarketMap
+			o.aggregator.UpdateMarketMap(o.marketMap)
+		}
+	}
+
+	if cache, ok := o.aggregator.(indexPriceCache); ok && now.Sub(snapshot.Timestamp) <= o.cfg.MaxPriceAge {
+		cache.SetIndexPrices(snapshot.IndexPrices)
+	}
+
+	restored := 0
+	o.restoredPrices = make(map[string]map[string]SnapshotPrice)
+	for provider, prices := range snapshot.ProviderPrices {
+		for ticker, price := range prices {
+			if price.Price == nil || now.Sub(price.Timestamp) > o.cfg.MaxPriceAge {
+				continue
+			}
+
+			if _, ok := o.restoredPrices[provider]; !ok {
+				o.restoredPrices[provider] = make(map[string]SnapshotPrice)
+			}
+			o.restoredPrices[provider][ticker] = price
+			restored++
+		}
+	}
+
+	o.logger.Info(
+		"restored snapshot",
+		zap.String("path", o.cfg.Snapshot.Path),
+		zap.Time("snapshot_timestamp", snapshot.Timestamp),
+		zap.Int("num_markets", len(o.marketMap.Markets)),
+		zap.Int("num_provider_prices", restored),
+	)
+
+	return nil
+}
+
+// WriteSnapshot writes the oracle's current state to the configured snapshot file. This is a
+// no-op if snapshots are disabled, or if the oracle has not aggregated any prices yet so that
+// an oracle that stops before its first update does not overwrite the previous snapshot.
+func (o *OracleImpl) WriteSnapshot() error {
+	if !o.cfg.Snapshot.Enabled() {
+		return nil
+	}
+
+	o.mut.Lock()
+	if o.lastPriceSync.IsZero() {
+		o.mut.Unlock()
+		return nil
+	}
+
+	snapshot := Snapshot{
+		Timestamp:      time.Now().UTC(),
+		MarketMap:      o.marketMap,
+		ProviderPrices: make(map[string]map[string]SnapshotPrice, len(o.lastProviderPrices)),
+	}
+	for provider, prices := range o.lastProviderPrices {
+		snapshot.ProviderPrices[provider] = prices
+	}
+	o.mut.Unlock()
+
+	if cache, ok := o.aggregator.(indexPriceCache); ok {
+		snapshot.IndexPrices = cache.GetIndexPrices()
+	}
+
+	if err := WriteSnapshot(o.cfg.Snapshot.Path, snapshot); err != nil {
+		return err
+	}
+
+	o.logger.Debug("wrote snapshot to file", zap.String("path", o.cfg.Snapshot.Path))
+	return nil
+}
+
+// setProviderPrices sets the provider's prices on the aggregator. Prices restored from a
+// snapshot fill in for the tickers the provider has not reported since the restart, until they
+// are older than the max price age. The resulting prices are recorded for the next snapshot.
+func (o *OracleImpl) setProviderPrices(
+	provider string,
+	prices types.Prices,
+	weights types.Prices,
+	timestamps map[string]time.Time,
+) {
+	now := time.Now().UTC()
+	for ticker, restored := range o.restoredPrices[provider] {
+		if _, ok := prices[ticker]; ok || now.Sub(restored.Timestamp) > o.cfg.MaxPriceAge {
+			// The provider has reported the ticker, or the restored price has expired, so the
+			// restored price is no longer needed.
+			delete(o.restoredPrices[provider], ticker)
+			continue
+		}
+
+		prices[ticker] = restored.Price
+		if restored.Weight != nil {
+			weights[ticker] = restored.Weight
+		}
+		timestamps[ticker] = restored.Timestamp
+	}
+	if len(o.restoredPrices[provider]) == 0 {
+		delete(o.restoredPrices, provider)
+	}
+
+	if o.cfg.Snapshot.Enabled() {
+		snapshotPrices := make(map[string]SnapshotPrice, len(prices))
+		for ticker, price := range prices {
+			snapshotPrices[ticker] = SnapshotPrice{
+				Price:     price,
+				Weight:    weights[ticker],
+				Timestamp: timestamps[ticker],
+			}
+		}
+		o.lastProviderPrices[provider] = snapshotPrices
+	}
+
+	o.aggregator.SetProviderPrices(provider, prices)
+	o.aggregator.SetProviderWeights(provider, weights)
+	o.aggregator.SetProviderTimestamps(provider, timestamps)
+}
@@ -0,0 +1,148 @@
+package oracle_test
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle"
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math"
+	oraclemath "github.com/skip-mev/connect/v2/pkg/math/oracle"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/providers/base/testutils"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *OracleTestSuite) TestSnapshot() {
+	btcusd := mmtypes.Ticker{
+		CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+		MinProviderCount: 1,
+		Decimals:         8,
+		Enabled:          true,
+	}
+	marketMap := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
+		btcusd.String(): {
+			Ticker: btcusd,
+			ProviderConfigs: []mmtypes.ProviderConfig{
+				{
+					Name:           providerCfg1.Name,
+					OffChainTicker: s.currencyPairs[0].GetOffChainTicker(),
+				},
+			},
+		},
+	}}
+
+	cfg := config.OracleConfig{
+		UpdateInterval: 250 * time.Millisecond,
+		MaxPriceAge:    1 * time.Minute,
+		Metrics:        oracleCfg.Metrics,
+		Host:           oracleCfg.Host,
+		Port:           oracleCfg.Port,
+		Snapshot: config.SnapshotConfig{
+			Path: filepath.Join(s.T().TempDir(), "snapshot.json"),
+		},
+	}
+
+	// run starts an oracle with the given market map and a single provider that returns the
+	// given prices, and returns the oracle's prices and market map after a few updates. The
+	// oracle is stopped before returning.
+	run := func(resolved types.ResolvedPrices, mm mmtypes.MarketMap) (types.Prices, mmtypes.MarketMap) {
+		var responses []providertypes.GetResponse[types.ProviderTicker, *big.Float]
+		if resolved != nil {
+			responses = append(responses, providertypes.NewGetResponse[types.ProviderTicker, *big.Float](resolved, nil))
+		}
+		provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+			s.T(),
+			s.logger,
+			providerCfg1,
+			s.currencyPairs,
+			responses,
+			200*time.Millisecond,
+		)
+
+		aggregator, err := oraclemath.NewIndexPriceAggregator(s.logger, mm, nil)
+		s.Require().NoError(err)
+
+		testOracle, err := oracle.New(
+			cfg,
+			aggregator,
+			oracle.WithLogger(s.logger),
+			oracle.WithPriceProviders(provider),
+			oracle.WithMarketMap(mm),
+		)
+		s.Require().NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*cfg.UpdateInterval)
+		defer cancel()
+		go testOracle.Start(ctx) //nolint:errcheck
+
+		time.Sleep(5 * cfg.UpdateInterval)
+		prices, mm := testOracle.GetPrices(), testOracle.GetMarketMap()
+		testOracle.Stop()
+
+		s.Eventually(func() bool { return !testOracle.IsRunning() }, 5*time.Second, 100*time.Millisecond)
+		return prices, mm
+	}
+
+	expectedPrice := math.ScaleBigFloat(big.NewFloat(100), btcusd.Decimals)
+
+	s.Run("no snapshot is written before the first update", func() {
+		s.Require().NoError(oracle.WriteSnapshot(cfg.Snapshot.Path+".unused", oracle.Snapshot{}))
+
+		testOracle, err := oracle.New(cfg, noOpPriceAggregator{}, oracle.WithLogger(s.logger))
+		s.Require().NoError(err)
+		s.Require().NoError(testOracle.(*oracle.OracleImpl).RestoreSnapshot())
+		s.Require().NoError(testOracle.(*oracle.OracleImpl).WriteSnapshot())
+		s.Require().NoFileExists(cfg.Snapshot.Path)
+	})
+
+	s.Run("snapshot is written on stop", func() {
+		prices, _ := run(types.ResolvedPrices{
+			s.currencyPairs[0]: {
+				Value:     big.NewFloat(100),
+				Timestamp: time.Now().UTC(),
+			},
+		}, marketMap)
+		s.Require().Len(prices, 1)
+		s.Require().Zero(expectedPrice.Cmp(prices[btcusd.String()]))
+
+		snapshot, err := oracle.ReadSnapshot(cfg.Snapshot.Path)
+		s.Require().NoError(err)
+		s.Require().Equal(marketMap, snapshot.MarketMap)
+		s.Require().Zero(big.NewFloat(100).Cmp(snapshot.IndexPrices[btcusd.String()]))
+		s.Require().Contains(snapshot.ProviderPrices, providerCfg1.Name)
+		s.Require().Zero(big.NewFloat(100).Cmp(snapshot.ProviderPrices[providerCfg1.Name][s.currencyPairs[0].GetOffChainTicker()].Price))
+	})
+
+	s.Run("oracle warm starts from the snapshot", func() {
+		// The provider does not report any prices and the oracle is not configured with a
+		// market map, so the prices can only come from the snapshot.
+		prices, mm := run(nil, mmtypes.MarketMap{})
+		s.Require().Equal(marketMap, mm)
+		s.Require().Len(prices, 1)
+		s.Require().Zero(expectedPrice.Cmp(prices[btcusd.String()]))
+	})
+
+	s.Run("expired prices are not restored", func() {
+		snapshot, err := oracle.ReadSnapshot(cfg.Snapshot.Path)
+		s.Require().NoError(err)
+
+		expired := time.Now().UTC().Add(-2 * cfg.MaxPriceAge)
+		snapshot.Timestamp = expired
+		for _, prices := range snapshot.ProviderPrices {
+			for ticker, price := range prices {
+				price.Timestamp = expired
+				prices[ticker] = price
+			}
+		}
+		s.Require().NoError(oracle.WriteSnapshot(cfg.Snapshot.Path, snapshot))
+
+		prices, mm := run(nil, mmtypes.MarketMap{})
+		s.Require().Equal(marketMap, mm)
+		s.Require().Empty(prices)
+	})
+}
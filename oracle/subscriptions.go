@@ -0,0 +1,64 @@
+package oracle
+
+import (
+	"sync"
+	"time"
+)
+
+// priceSubscriptions notifies subscribers every time the oracle updates its prices.
+// Notifications are coalesced, so a subscriber that has not received the previous notification
+// only receives the latest one. This means that slow subscribers never block the oracle.
+type priceSubscriptions struct {
+	mtx sync.Mutex
+	// subscribers are the channels of the subscribers, indexed by subscription id.
+	subscribers map[uint64]chan time.Time
+	// nextID is the id of the next subscription.
+	nextID uint64
+}
+
+// newPriceSubscriptions returns a new set of price subscriptions.
+func newPriceSubscriptions() *priceSubscriptions {
+	return &priceSubscriptions{
+		subscribers: make(map[uint64]chan time.Time),
+	}
+}
+
+// Subscribe returns a channel that receives the sync time of every price update, along with a
+// function that cancels the subscription.
+func (s *priceSubscriptions) Subscribe() (<-chan time.Time, func()) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	id := s.nextID
+	s.nextID++
+
+	ch := make(chan time.Time, 1)
+	s.subscribers[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			s.mtx.Lock()
+			defer s.mtx.Unlock()
+
+			delete(s.subscribers, id)
+		})
+	}
+}
+
+// Publish notifies every subscriber of a price update at the given sync time, replacing any
+// notification the subscriber has not yet received.
+func (s *priceSubscriptions) Publish(syncTime time.Time) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, ch := range s.subscribers {
+		// Drop the pending notification, if any, so that the send never blocks.
+		select {
+		case <-ch:
+		default:
+		}
+
+		ch <- syncTime
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -13,6 +14,7 @@ import (
 	wshandlers "github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
 	wsmetrics "github.com/skip-mev/connect/v2/providers/base/websocket/metrics"
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
 // ConfigType is the type of the API/WebSocket configuration.
@@ -80,8 +82,169 @@ type (
 
 	// Prices is a type alias for a map of ticker to a price.
 	Prices = map[string]*big.Float
+
+	// Dispersions is a type alias for a map of ticker to a price dispersion.
+	Dispersions = map[string]PriceDispersion
+
+	// PriorityTiers is a type alias for a map of ticker to the lowest priority tier of the
+	// provider configs used to calculate its price.
+	PriorityTiers = map[string]uint32
+
+	// ProviderPriceDetails is a type alias for a map of ticker to the details of the price of
+	// each of its provider configs.
+	ProviderPriceDetails = map[string][]ProviderPriceDetail
 )
 
+const (
+	// ExclusionReasonMissingPrice is the reason a provider price is excluded from aggregation
+	// if the provider did not report a price.
+	ExclusionReasonMissingPrice = "missing_price"
+	// ExclusionReasonConversionFailed is the reason a provider price is excluded from
+	// aggregation if it could not be converted to the ticker, e.g. because an index price of
+	// its normalization path is missing.
+	ExclusionReasonConversionFailed = "conversion_failed"
+	// ExclusionReasonQuarantined is the reason a provider price is excluded from aggregation if
+	// the (provider, ticker) pair is quarantined.
+	ExclusionReasonQuarantined = "quarantined"
+	// ExclusionReasonOutlier is the reason a provider price is excluded from aggregation if it
+	// was rejected by the outlier filter.
+	ExclusionReasonOutlier = "outlier"
+	// ExclusionReasonPriorityTier is the reason a provider price is excluded from aggregation
+	// if higher priority provider configs reported enough prices.
+	ExclusionReasonPriorityTier = "priority_tier"
+	// ExclusionReasonInsufficientProviders is the reason a provider price is excluded from
+	// aggregation if the ticker did not have enough prices to be aggregated.
+	ExclusionReasonInsufficientProviders = "insufficient_providers"
+)
+
+// PriceDispersion summarizes how closely the converted prices used to calculate the
+// aggregated price of a ticker agree with each other.
+type PriceDispersion struct {
+	// StdDev is the population standard deviation of the converted prices.
+	StdDev *big.Float
+	// InterquartileRange is the difference between the third and first quartiles of the
+	// converted prices.
+	InterquartileRange *big.Float
+	// Min is the minimum converted price.
+	Min *big.Float
+	// Max is the maximum converted price.
+	Max *big.Float
+	// ProviderCount is the number of converted prices.
+	ProviderCount int
+}
+
+// ProviderHealth is the health of the price of a (provider, ticker) pair over the most recent
+// aggregation rounds.
+type ProviderHealth struct {
+	// Provider is the name of the provider.
+	Provider string
+	// Ticker is the ticker the provider's price is converted to.
+	Ticker string
+	// Score is the fraction of healthy samples.
+	Score float64
+	// ErrorRate is the fraction of samples in which the provider did not report a price.
+	ErrorRate float64
+	// StaleRate is the fraction of samples in which the provider's price was stale.
+	StaleRate float64
+	// DeviationRate is the fraction of samples in which the provider's price deviated from the
+	// index price.
+	DeviationRate float64
+	// Samples is the number of samples the rates are computed over.
+	Samples int
+	// Quarantined is true if the pair is excluded from aggregation.
+	Quarantined bool
+	// QuarantinedSince is the time at which the pair was quarantined. This is zero if the pair
+	// is not quarantined.
+	QuarantinedSince time.Time
+}
+
+// ProviderStatus is the status of a provider run by the oracle.
+type ProviderStatus struct {
+	// Name is the name of the provider.
+	Name string
+	// Type is the type of the provider's data handler, i.e. api or websocket.
+	Type providertypes.ProviderType
+	// MarketMap is true if the provider fetches the market map rather than prices.
+	MarketMap bool
+	// Running is true if the provider is running.
+	Running bool
+	// TickerCount is the number of IDs the provider fetches data for, i.e. tickers for a price
+	// provider and chains for a market map provider.
+	TickerCount int
+	// LastUpdated is the time at which the provider last successfully fetched data. This is
+	// zero if it has not yet.
+	LastUpdated time.Time
+	// RecentErrors are the most recent errors the provider encountered, from oldest to newest.
+	RecentErrors []providertypes.ProviderError
+}
+
+// PriceRecord is the prices of a ticker calculated in a single aggregation round. Prices are
+// scaled by the ticker's decimals.
+type PriceRecord struct {
+	// Ticker is the ticker the prices are for.
+	Ticker string
+	// Timestamp is the time of the aggregation round.
+	Timestamp time.Time
+	// Price is the aggregated price of the ticker. This is nil if the ticker was not priced.
+	Price *big.Float
+	// ProviderPrices are the converted prices of the ticker reported by each provider config.
+	// This includes the prices that were excluded from aggregation, e.g. outliers.
+	ProviderPrices []ProviderPrice
+}
+
+// ProviderPrice is the converted price of a ticker reported by a single provider config.
+type ProviderPrice struct {
+	// Provider is the name of the provider.
+	Provider string
+	// OffChainTicker is the provider's ticker the price was converted from.
+	OffChainTicker string
+	// Price is the converted price.
+	Price *big.Float
+}
+
+// ProviderPriceDetail describes the price of a ticker reported by a single provider config in
+// the latest aggregation round, and whether it was used to calculate the ticker's price.
+type ProviderPriceDetail struct {
+	// Provider is the name of the provider.
+	Provider string
+	// OffChainTicker is the provider's ticker.
+	OffChainTicker string
+	// RawPrice is the unscaled price reported by the provider for the off-chain ticker. This
+	// is nil if the provider did not report a price.
+	RawPrice *big.Float
+	// ConvertedPrice is the raw price converted to the ticker, scaled by the ticker's decimals.
+	// This is nil if the price could not be converted.
+	ConvertedPrice *big.Float
+	// Timestamp is the time at which the provider reported the price. This is zero if the
+	// provider does not report timestamps.
+	Timestamp time.Time
+	// Invert is true if the raw price is inverted before it is normalized.
+	Invert bool
+	// NormalizationPath is the path of index prices the raw price is normalized by.
+	NormalizationPath []mmtypes.NormalizationPair
+	// Included is true if the price was used to calculate the ticker's price.
+	Included bool
+	// ExclusionReason is the reason the price was not used to calculate the ticker's price.
+	// This is empty if the price was included.
+	ExclusionReason string
+	// TWAPPrice is the time-weighted average of the converted price over the TWAP window,
+	// scaled by the ticker's decimals. This is nil unless the aggregation mode is "twap".
+	TWAPPrice *big.Float
+}
+
+// PriceHistoryFilter filters the price records returned from the price history. Empty fields
+// match every record.
+type PriceHistoryFilter struct {
+	// Ticker only matches the records of the given ticker.
+	Ticker string
+	// Provider only matches the price of the given provider in each record.
+	Provider string
+	// Start only matches the records at or after the given time.
+	Start time.Time
+	// End only matches the records at or before the given time.
+	End time.Time
+}
+
 var (
 	// NewPriceResult is a function alias for the new price result.
 	NewPriceResult = providertypes.NewResult[*big.Float]
@@ -89,6 +252,9 @@ var (
 	// NewPriceResultWithCode is a function alias for the new price result with code.
 	NewPriceResultWithCode = providertypes.NewResultWithCode[*big.Float]
 
+	// NewPriceResultWithWeight is a function alias for the new price result with weight.
+	NewPriceResultWithWeight = providertypes.NewResultWithWeight[*big.Float]
+
 	// NewPriceResponse is a function alias for the new price response.
 	NewPriceResponse = providertypes.NewGetResponse[ProviderTicker, *big.Float]
 
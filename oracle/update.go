@@ -99,7 +99,11 @@ func (o *OracleImpl) fetchAllPrices() {
 
 	// Compute aggregated prices and update the oracle.
 	o.aggregator.AggregatePrices()
-	o.setLastSyncTime(time.Now().UTC())
+	syncTime := time.Now().UTC()
+	o.setLastSyncTime(syncTime)
+
+	// notify subscribers of the updated prices
+	o.subscriptions.Publish(syncTime)
 
 	// update the last sync time
 	o.metrics.AddTick()
@@ -116,12 +120,17 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		}
 	}()
 
+	timeFilteredPrices := make(types.Prices)
+	timeFilteredWeights := make(types.Prices)
+	timeFilteredTimestamps := make(map[string]time.Time)
+
 	if !provider.IsRunning() {
 		o.logger.Debug(
 			"provider is not running",
 			zap.String("provider", provider.Name()),
 		)
 
+		o.setProviderPrices(provider.Name(), timeFilteredPrices, timeFilteredWeights, timeFilteredTimestamps)
 		return
 	}
 
@@ -141,10 +150,10 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 			zap.String("data handler type", string(provider.Type())),
 		)
 
+		o.setProviderPrices(provider.Name(), timeFilteredPrices, timeFilteredWeights, timeFilteredTimestamps)
 		return
 	}
 
-	timeFilteredPrices := make(types.Prices)
 	for pair, result := range prices {
 		// If the price is older than the maxCacheAge, skip it.
 		diff := time.Now().UTC().Sub(result.Timestamp)
@@ -169,6 +178,10 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 			zap.Duration("diff", diff),
 		)
 		timeFilteredPrices[pair.GetOffChainTicker()] = result.Value
+		if result.Weight != nil {
+			timeFilteredWeights[pair.GetOffChainTicker()] = result.Weight
+		}
+		timeFilteredTimestamps[pair.GetOffChainTicker()] = result.Timestamp
 	}
 
 	o.logger.Debug("provider returned prices",
@@ -176,7 +189,7 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		zap.String("data handler type", string(provider.Type())),
 		zap.Int("prices", len(prices)),
 	)
-	o.aggregator.SetProviderPrices(provider.Name(), timeFilteredPrices)
+	o.setProviderPrices(provider.Name(), timeFilteredPrices, timeFilteredWeights, timeFilteredTimestamps)
 }
 
 func (o *OracleImpl) setLastSyncTime(t time.Time) {
@@ -149,6 +149,57 @@ func CalculateMedian(values []*big.Float) *big.Float {
 	return median
 }
 
+// CalculateWeightedMedian calculates the weighted median from a list of big.Float values and
+// their corresponding weights. The weighted median is the value at which the cumulative weight
+// of the sorted values reaches half of the total weight. If the cumulative weight is exactly
+// half of the total weight, the average of that value and the next value with a positive
+// weight is returned, so that equal weights yield the same result as CalculateMedian. Returns
+// nil if the number of values and weights differ, if there are no values or if the total
+// weight is not positive. The inputs are not modified.
+func CalculateWeightedMedian(values, weights []*big.Float) *big.Float {
+	if len(values) == 0 || len(values) != len(weights) {
+		return nil
+	}
+
+	indices := make([]int, len(values))
+	total := new(big.Float)
+	for i := range values {
+		indices[i] = i
+		total.Add(total, weights[i])
+	}
+
+	if total.Sign() <= 0 {
+		return nil
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		return values[indices[i]].Cmp(values[indices[j]]) < 0
+	})
+
+	half := new(big.Float).Quo(total, new(big.Float).SetUint64(2))
+	cumulative := new(big.Float)
+	for i, idx := range indices {
+		cumulative.Add(cumulative, weights[idx])
+
+		switch cumulative.Cmp(half) {
+		case 0:
+			// Average with the next value that carries weight.
+			for _, next := range indices[i+1:] {
+				if weights[next].Sign() > 0 {
+					median := new(big.Float).Add(values[idx], values[next])
+					return median.Quo(median, new(big.Float).SetUint64(2))
+				}
+			}
+
+			return new(big.Float).Copy(values[idx])
+		case 1:
+			return new(big.Float).Copy(values[idx])
+		}
+	}
+
+	return new(big.Float).Copy(values[indices[len(indices)-1]])
+}
+
 // GetScalingFactor returns the scaling factor for the price based on the difference between
 // the token decimals in the erc20 token contracts or similar.
 func GetScalingFactor(
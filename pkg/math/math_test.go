@@ -406,6 +406,83 @@ func TestCalculateMedian(t *testing.T) {
 	}
 }
 
+func TestCalculateWeightedMedian(t *testing.T) {
+	floats := func(values ...float64) []*big.Float {
+		out := make([]*big.Float, len(values))
+		for i, value := range values {
+			out[i] = big.NewFloat(value)
+		}
+		return out
+	}
+
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "nil slices",
+			expected: nil,
+		},
+		{
+			name:     "mismatched lengths",
+			values:   floats(1, 2),
+			weights:  floats(1),
+			expected: nil,
+		},
+		{
+			name:     "zero total weight",
+			values:   floats(1, 2),
+			weights:  floats(0, 0),
+			expected: nil,
+		},
+		{
+			name:     "equal weights with an odd number of values matches the median",
+			values:   floats(10, -2, 100, 0, 0),
+			weights:  floats(1, 1, 1, 1, 1),
+			expected: big.NewFloat(0),
+		},
+		{
+			name:     "equal weights with an even number of values matches the median",
+			values:   floats(-2, 0, 10, 100),
+			weights:  floats(1, 1, 1, 1),
+			expected: big.NewFloat(5),
+		},
+		{
+			name:     "heavy weight dominates",
+			values:   floats(100, 101, 150),
+			weights:  floats(1, 1, 10),
+			expected: big.NewFloat(150),
+		},
+		{
+			name:     "light weight is ignored",
+			values:   floats(100, 101, 150),
+			weights:  floats(10, 10, 1),
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "zero weight values are skipped when averaging",
+			values:   floats(100, 120, 200),
+			weights:  floats(1, 0, 1),
+			expected: big.NewFloat(150),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := math.CalculateWeightedMedian(tc.values, tc.weights)
+			if tc.expected == nil {
+				require.Nil(t, result)
+				return
+			}
+
+			require.NotNil(t, result)
+			require.Zero(t, tc.expected.Cmp(result), "expected %v, got %v", tc.expected, result)
+		})
+	}
+}
+
 func TestSortBigInts(t *testing.T) {
 	testCases := []struct {
 		name     string
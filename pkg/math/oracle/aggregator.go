@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/connect/v2/oracle"
+	"github.com/skip-mev/connect/v2/oracle/config"
 	oraclemetrics "github.com/skip-mev/connect/v2/oracle/metrics"
 	"github.com/skip-mev/connect/v2/oracle/types"
 	"github.com/skip-mev/connect/v2/pkg/math"
 	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
 )
 
 var _ oracle.PriceAggregator = &IndexPriceAggregator{}
@@ -26,14 +29,64 @@ type IndexPriceAggregator struct {
 	cfg     mmtypes.MarketMap
 	metrics oraclemetrics.Metrics
 
+	// aggregationCfg is the oracle wide aggregation configuration.
+	aggregationCfg config.AggregationConfig
+	// maxPriceAge is the maximum age of a price sample that is used in the TWAP calculation.
+	maxPriceAge time.Duration
+	// twap is the rolling window of index prices used to compute time-weighted average
+	// prices. This is only set if the aggregation mode is "twap".
+	twap *twapWindow
+	// providerTWAPs are the rolling windows of the converted prices of each provider config,
+	// indexed by ticker. The windows are indexed by providerWeightKey. This is only set if
+	// the aggregation mode is "twap".
+	providerTWAPs map[string]*twapWindow
+	// smoother smooths the index prices with an exponential moving average. This is only set
+	// if smoothing is enabled.
+	smoother *emaSmoother
+	// breakers are the circuit breakers of the tickers that configure one in their metadata.
+	breakers *circuitBreakers
+	// health tracks the health of every (provider, ticker) pair and quarantines the pairs that
+	// are persistently unhealthy. This is only set if health tracking is enabled.
+	health *healthTracker
+	// history retains the recent price records of every ticker. This is only set if the price
+	// history is enabled.
+	history *priceHistory
+	// strategies are the aggregation strategies that tickers can select in their metadata,
+	// indexed by name.
+	strategies map[string]Strategy
+	// now returns the current time.
+	now func() time.Time
+	// tickerMetadata caches the aggregation metadata parsed from each ticker's metadata
+	// JSON. This is updated every time the market map is updated.
+	tickerMetadata map[string]tickermetadata.AggregationMetadata
+	// staticWeights caches the static weights configured in each provider config's metadata
+	// JSON. These are indexed by ticker -> providerWeightKey. This is updated every time the
+	// market map is updated.
+	staticWeights map[string]map[string]*big.Float
+
 	// indexPrices cache the median prices for each ticker. These are unscaled prices.
 	indexPrices types.Prices
 	// scaledPrices cache the scaled prices for each ticker. These are the prices that can be
 	// consumed by consumers.
 	scaledPrices types.Prices
+	// dispersions cache the dispersion of the converted prices used to calculate each
+	// ticker's price. These are scaled by the respective ticker's decimals.
+	dispersions types.Dispersions
+	// priorityTiers cache the lowest priority tier of the provider configs used to calculate
+	// each ticker's price.
+	priorityTiers types.PriorityTiers
+	// providerPriceDetails cache the details of the price of each provider config of each
+	// ticker, including whether it was used to calculate the ticker's price.
+	providerPriceDetails types.ProviderPriceDetails
 	// providerPrices cache the unscaled prices for each provider. These are indexed by
 	// provider -> offChainTicker -> price.
 	providerPrices map[string]types.Prices
+	// providerWeights cache the weights reported by each provider. These are indexed by
+	// provider -> offChainTicker -> weight.
+	providerWeights map[string]types.Prices
+	// providerTimestamps cache the timestamps of the prices reported by each provider. These
+	// are indexed by provider -> offChainTicker -> timestamp.
+	providerTimestamps map[string]map[string]time.Time
 }
 
 // NewIndexPriceAggregator returns a new Index Price Aggregator.
@@ -41,6 +94,7 @@ func NewIndexPriceAggregator(
 	logger *zap.Logger,
 	cfg mmtypes.MarketMap,
 	metrics oraclemetrics.Metrics,
+	opts ...Option,
 ) (*IndexPriceAggregator, error) {
 	if logger == nil {
 		return nil, fmt.Errorf("logger cannot be nil")
@@ -51,14 +105,49 @@ func NewIndexPriceAggregator(
 		metrics = oraclemetrics.NewNopMetrics()
 	}
 
-	return &IndexPriceAggregator{
-		logger:         logger.With(zap.String("process", "index_price_aggregator")),
-		cfg:            cfg,
-		metrics:        metrics,
-		indexPrices:    make(types.Prices),
-		scaledPrices:   make(types.Prices),
-		providerPrices: make(map[string]types.Prices),
-	}, nil
+	m := &IndexPriceAggregator{
+		logger:             logger.With(zap.String("process", "index_price_aggregator")),
+		cfg:                cfg,
+		metrics:            metrics,
+		indexPrices:        make(types.Prices),
+		scaledPrices:       make(types.Prices),
+		providerPrices:     make(map[string]types.Prices),
+		providerWeights:    make(map[string]types.Prices),
+		providerTimestamps: make(map[string]map[string]time.Time),
+		breakers:           newCircuitBreakers(),
+		strategies:         DefaultStrategies(),
+		now:                time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if err := m.aggregationCfg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid aggregation config: %w", err)
+	}
+
+	if m.aggregationCfg.Mode == config.AggregationModeTWAP {
+		m.twap = newTWAPWindow(m.aggregationCfg.TWAP.Window, m.maxPriceAge)
+		m.providerTWAPs = make(map[string]*twapWindow)
+	}
+
+	if m.aggregationCfg.Smoothing.Enabled() {
+		m.smoother = newEMASmoother(m.aggregationCfg.Smoothing.Alpha, m.maxPriceAge)
+	}
+
+	if m.aggregationCfg.Health.Enabled() {
+		m.health = newHealthTracker(m.aggregationCfg.Health)
+	}
+
+	if m.aggregationCfg.History.Enabled() {
+		m.history = newPriceHistory(m.aggregationCfg.History)
+	}
+
+	m.tickerMetadata = m.parseTickerMetadata(cfg)
+	m.staticWeights = m.parseStaticWeights(cfg)
+
+	return m, nil
 }
 
 // AggregatePrices implements the aggregate function for the median price calculation. Specifically, this
@@ -70,12 +159,28 @@ func NewIndexPriceAggregator(
 //     BTC/USDT to BTC/USD using the index price of USDT/USD.
 //
 // The index price cache contains the previously calculated median prices.
+//
+// If the aggregation mode is "twap", the median prices are additionally recorded in a rolling
+// window and the published (scaled) prices are the time-weighted average of the window. The
+// index prices used for conversions remain the most recent median prices.
+//
+// If health tracking is enabled, the prices of quarantined (provider, ticker) pairs are excluded
+// before the outlier filter is run, and every pair is sampled against the resulting index prices.
+//
+// If the price history is enabled, the scaled price of every enabled ticker and the scaled
+// converted price of each of its providers are recorded.
 func (m *IndexPriceAggregator) AggregatePrices() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	indexPrices := make(types.Prices)
 	scaledPrices := make(types.Prices)
+	dispersions := make(types.Dispersions)
+	priorityTiers := make(types.PriorityTiers)
+	providerPriceDetails := make(types.ProviderPriceDetails)
+	enabledTickers := make(map[string]struct{})
+	providerPrices := make(map[string][]ConvertedPrice)
+	now := m.now()
 
 	var missingPrices []string
 
@@ -84,17 +189,30 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 			m.logger.Debug("skipping disabled market", zap.Any("market", market))
 			continue
 		}
+		enabledTickers[market.Ticker.String()] = struct{}{}
 
 		// Get the converted prices for set of convertible markets.
 		// ex. BTC/USDT * Index USDT/USD = BTC/USD
 		//     BTC/USDC * Index USDC/USD = BTC/USD
 		target := market.Ticker
-		convertedPrices := m.CalculateConvertedPrices(market)
+		stages := aggregationStages{converted: m.CalculateProviderPrices(market)}
+		providerPrices[target.String()] = stages.converted
+		m.recordProviderTWAPs(market, stages.converted, now)
+		stages.admitted = m.excludeQuarantined(target, stages.converted)
+		stages.accepted = m.filterOutliers(target, stages.admitted)
+
+		// Only use the prices of lower priority provider configs if the higher priority ones
+		// do not report enough prices.
+		convertedPrices, tier := selectPriorityTiers(stages.accepted, int(target.MinProviderCount)) //nolint:gosec
 		m.metrics.AddProviderCountForMarket(target.String(), len(convertedPrices))
 
+		// Record how each provider price was used to calculate the ticker's price.
+		stages.selected = convertedPrices
+		stages.aggregated = len(convertedPrices) >= int(target.MinProviderCount) //nolint:gosec
+		providerPriceDetails[target.String()] = m.calculateProviderPriceDetails(market, stages, now)
+
 		// We need to have at least the minimum number of providers to calculate the median.
 		if len(convertedPrices) < int(target.MinProviderCount) { //nolint:gosec
-			missingPrices = append(missingPrices, ticker)
 			m.logger.Debug(
 				"insufficient amount of converted prices",
 				zap.String("target_ticker", ticker),
@@ -103,22 +221,59 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 				zap.Int("min_provider_count", int(target.MinProviderCount)), //nolint:gosec
 			)
 
+			// In TWAP mode, the ticker's TWAP continues to be served until its most recent
+			// sample is older than the max price age.
+			if price, ok := m.twapPrice(target, now); ok {
+				scaledPrices[target.String()] = math.ScaleBigFloat(price, target.Decimals)
+				continue
+			}
+
+			missingPrices = append(missingPrices, ticker)
+			continue
+		}
+
+		// Aggregate the converted prices using the ticker's strategy. By default, this takes the
+		// median of the converted prices, which is the average of the middle two prices if the
+		// number of prices is even.
+		price := m.aggregate(target, convertedPrices, now)
+
+		// Guard against sudden jumps in the price. A tripped circuit breaker either holds the
+		// last accepted price or omits the ticker.
+		price, ok := m.applyCircuitBreaker(target, now, price)
+		if !ok {
+			missingPrices = append(missingPrices, ticker)
 			continue
 		}
 
-		// Take the median of the converted prices. This takes the average of the middle two
-		// prices if the number of prices is even.
-		price := math.CalculateMedian(convertedPrices)
+		// Smooth the price with an exponential moving average if enabled. The smoothed price
+		// is also used as the index price for conversions.
+		if m.smoother != nil {
+			price = m.smoother.Smooth(target.String(), now, price)
+		}
 		indexPrices[target.String()] = new(big.Float).Copy(price)
 
+		if m.twap != nil {
+			m.twap.Add(target.String(), now, price)
+			price, _ = m.twap.Price(target.String(), now)
+		}
+
 		// Scale the price to the target ticker's decimals.
 		scaledPrices[target.String()] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
 
+		// Record how closely the converted prices agree with each other.
+		dispersion := CalculateDispersion(pricesOf(convertedPrices))
+		dispersions[target.String()] = ScaleDispersion(dispersion, target.Decimals)
+		m.updateDispersionMetrics(target, dispersion)
+
+		priorityTiers[target.String()] = tier
+		m.metrics.UpdatePriorityTier(target.String(), tier)
+
 		m.logger.Debug(
 			"calculated median price",
 			zap.String("target_ticker", ticker),
 			zap.String("unscaled_price", indexPrices[target.String()].String()),
 			zap.String("scaled_price", scaledPrices[target.String()].String()),
+			zap.Uint32("priority_tier", tier),
 			zap.Any("converted_prices", convertedPrices),
 		)
 		floatPrice, _ := price.Float64()
@@ -133,8 +288,255 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 	if len(missingPrices) > 0 {
 		m.logger.Info("failed to calculate prices for price feeds", zap.Strings("missing_prices", missingPrices))
 	}
+	if m.twap != nil {
+		m.twap.Retain(enabledTickers)
+		for ticker := range m.providerTWAPs {
+			if _, ok := enabledTickers[ticker]; !ok {
+				delete(m.providerTWAPs, ticker)
+			}
+		}
+	}
+	m.breakers.Retain(enabledTickers)
+	if m.health != nil {
+		m.recordHealth(providerPrices, indexPrices, now)
+	}
+	if m.history != nil {
+		m.recordHistory(providerPrices, scaledPrices, now)
+	}
+	if m.smoother != nil {
+		m.smoother.Retain(enabledTickers)
+	}
+
 	m.indexPrices = indexPrices
 	m.scaledPrices = scaledPrices
+	m.dispersions = dispersions
+	m.priorityTiers = priorityTiers
+	m.providerPriceDetails = providerPriceDetails
+}
+
+// applyCircuitBreaker runs the ticker's index price through its circuit breaker, if one is
+// configured, and reports any trips or resets. Returns false if the ticker should be omitted.
+func (m *IndexPriceAggregator) applyCircuitBreaker(
+	ticker mmtypes.Ticker,
+	now time.Time,
+	price *big.Float,
+) (*big.Float, bool) {
+	cfg, ok := m.circuitBreakerConfig(ticker)
+	if !ok {
+		if m.breakers.Remove(ticker.String()) {
+			m.logger.Info(
+				"circuit breaker reset",
+				zap.String("target_ticker", ticker.String()),
+				zap.String("reason", resetReasonDisabled),
+			)
+			m.metrics.AddCircuitBreakerReset(ticker.String(), resetReasonDisabled)
+		}
+
+		return price, true
+	}
+
+	served, transition := m.breakers.Apply(ticker.String(), cfg, now, price)
+	switch {
+	case transition == nil:
+	case transition.tripped:
+		m.logger.Warn(
+			"circuit breaker tripped",
+			zap.String("target_ticker", ticker.String()),
+			zap.String("reason", transition.reason),
+			zap.String("price", transition.price.String()),
+			zap.String("last_price", transition.lastPrice.String()),
+			zap.String("reference_price", transition.reference.String()),
+			zap.Float64("tick_deviation", transition.tickDeviation),
+			zap.Float64("reference_deviation", transition.referenceDeviation),
+			zap.Time("tripped_until", transition.trippedUntil),
+			zap.Bool("omitted", served == nil),
+		)
+		m.metrics.AddCircuitBreakerTrip(ticker.String(), transition.reason)
+	default:
+		m.logger.Info(
+			"circuit breaker reset",
+			zap.String("target_ticker", ticker.String()),
+			zap.String("reason", transition.reason),
+			zap.String("price", transition.price.String()),
+			zap.String("last_price", transition.lastPrice.String()),
+			zap.String("reference_price", transition.reference.String()),
+			zap.Float64("tick_deviation", transition.tickDeviation),
+			zap.Float64("reference_deviation", transition.referenceDeviation),
+		)
+		m.metrics.AddCircuitBreakerReset(ticker.String(), transition.reason)
+	}
+
+	return served, served != nil
+}
+
+// excludeQuarantined returns the converted prices of the ticker whose (provider, ticker) pair
+// is not quarantined.
+func (m *IndexPriceAggregator) excludeQuarantined(
+	ticker mmtypes.Ticker,
+	convertedPrices []ConvertedPrice,
+) []ConvertedPrice {
+	if m.health == nil {
+		return convertedPrices
+	}
+
+	admitted := make([]ConvertedPrice, 0, len(convertedPrices))
+	for _, price := range convertedPrices {
+		if m.health.Quarantined(price.Provider.Name, ticker.String()) {
+			m.logger.Debug(
+				"excluding quarantined provider price",
+				zap.String("target_ticker", ticker.String()),
+				zap.String("provider", price.Provider.Name),
+				zap.String("price", price.Price.String()),
+			)
+
+			continue
+		}
+
+		admitted = append(admitted, price)
+	}
+
+	return admitted
+}
+
+// recordHealth samples the health of every provider config of the enabled markets against the
+// ticker's index price, and reports any (provider, ticker) pair that is quarantined or
+// readmitted. The given provider prices are the converted prices of each enabled ticker,
+// including those of quarantined pairs.
+func (m *IndexPriceAggregator) recordHealth(
+	providerPrices map[string][]ConvertedPrice,
+	indexPrices types.Prices,
+	now time.Time,
+) {
+	tracked := make(map[healthKey]struct{})
+	for ticker, prices := range providerPrices {
+		market := m.cfg.Markets[ticker]
+		for _, cfg := range market.ProviderConfigs {
+			tracked[healthKey{provider: cfg.Name, ticker: ticker}] = struct{}{}
+
+			var price *ConvertedPrice
+			for i := range prices {
+				if prices[i].Provider.Name == cfg.Name && prices[i].Provider.OffChainTicker == cfg.OffChainTicker {
+					price = &prices[i]
+					break
+				}
+			}
+
+			sample := m.health.Sample(price, indexPrices[ticker], now)
+			transition := m.health.Record(cfg.Name, ticker, sample, now)
+			switch {
+			case transition == nil:
+			case transition.quarantined:
+				m.logger.Warn(
+					"quarantined provider price",
+					zap.String("target_ticker", ticker),
+					zap.String("provider", cfg.Name),
+					zap.Float64("score", transition.health.Score),
+					zap.Float64("error_rate", transition.health.ErrorRate),
+					zap.Float64("stale_rate", transition.health.StaleRate),
+					zap.Float64("deviation_rate", transition.health.DeviationRate),
+				)
+			default:
+				m.logger.Info(
+					"readmitted provider price",
+					zap.String("target_ticker", ticker),
+					zap.String("provider", cfg.Name),
+					zap.Float64("score", transition.health.Score),
+				)
+			}
+		}
+	}
+
+	m.health.Retain(tracked)
+}
+
+// recordHistory records the scaled price of every enabled ticker and the scaled converted price
+// of each of its providers in the price history. The given provider prices are the converted
+// prices of each enabled ticker, including those that were excluded from aggregation.
+func (m *IndexPriceAggregator) recordHistory(
+	providerPrices map[string][]ConvertedPrice,
+	scaledPrices types.Prices,
+	now time.Time,
+) {
+	tickers := make(map[string]struct{}, len(providerPrices))
+	for ticker, prices := range providerPrices {
+		tickers[ticker] = struct{}{}
+		decimals := m.cfg.Markets[ticker].Ticker.Decimals
+
+		record := types.PriceRecord{
+			Ticker:         ticker,
+			Timestamp:      now,
+			ProviderPrices: make([]types.ProviderPrice, 0, len(prices)),
+		}
+		if price, ok := scaledPrices[ticker]; ok {
+			record.Price = new(big.Float).Copy(price)
+		}
+		for _, price := range prices {
+			record.ProviderPrices = append(record.ProviderPrices, types.ProviderPrice{
+				Provider:       price.Provider.Name,
+				OffChainTicker: price.Provider.OffChainTicker,
+				Price:          math.ScaleBigFloat(new(big.Float).Copy(price.Price), decimals),
+			})
+		}
+
+		m.history.Add(record)
+	}
+
+	m.history.Retain(tickers)
+}
+
+// twapPrice returns the TWAP of the ticker at the given time. Returns false if the aggregator
+// is not in TWAP mode or if the ticker has no valid TWAP.
+func (m *IndexPriceAggregator) twapPrice(ticker mmtypes.Ticker, now time.Time) (*big.Float, bool) {
+	if m.twap == nil {
+		return nil, false
+	}
+
+	return m.twap.Price(ticker.String(), now)
+}
+
+// recordProviderTWAPs records the converted price of each provider config of the market in the
+// provider config's TWAP window, and evicts the windows of provider configs that were removed
+// from the market. This is a no-op if the aggregator is not in TWAP mode.
+func (m *IndexPriceAggregator) recordProviderTWAPs(
+	market mmtypes.Market,
+	convertedPrices []ConvertedPrice,
+	now time.Time,
+) {
+	if m.twap == nil {
+		return
+	}
+
+	window, ok := m.providerTWAPs[market.Ticker.String()]
+	if !ok {
+		window = newTWAPWindow(m.aggregationCfg.TWAP.Window, m.maxPriceAge)
+		m.providerTWAPs[market.Ticker.String()] = window
+	}
+
+	for _, price := range convertedPrices {
+		window.Add(providerWeightKey(price.Provider), now, price.Price)
+	}
+
+	keys := make(map[string]struct{}, len(market.ProviderConfigs))
+	for _, cfg := range market.ProviderConfigs {
+		keys[providerWeightKey(cfg)] = struct{}{}
+	}
+	window.Retain(keys)
+}
+
+// providerTWAPPrice returns the TWAP of the provider config's converted price for the ticker
+// at the given time. Returns false if the aggregator is not in TWAP mode or if the provider
+// config has no valid TWAP.
+func (m *IndexPriceAggregator) providerTWAPPrice(
+	ticker mmtypes.Ticker,
+	cfg mmtypes.ProviderConfig,
+	now time.Time,
+) (*big.Float, bool) {
+	window, ok := m.providerTWAPs[ticker.String()]
+	if !ok {
+		return nil, false
+	}
+
+	return window.Price(providerWeightKey(cfg), now)
 }
 
 // CalculateConvertedPrices calculates the converted prices for a given set of paths and target ticker.
@@ -143,6 +545,14 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	market mmtypes.Market,
 ) []*big.Float {
+	return pricesOf(m.CalculateProviderPrices(market))
+}
+
+// CalculateProviderPrices calculates the converted price for each provider config of the given
+// market. Provider configs for which a converted price cannot be calculated are omitted.
+func (m *IndexPriceAggregator) CalculateProviderPrices(
+	market mmtypes.Market,
+) []ConvertedPrice {
 	m.logger.Debug("calculating converted prices", zap.String("ticker", market.Ticker.String()))
 	if len(market.ProviderConfigs) == 0 {
 		m.logger.Error(
@@ -153,7 +563,7 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 		return nil
 	}
 
-	convertedPrices := make([]*big.Float, 0, len(market.ProviderConfigs))
+	convertedPrices := make([]ConvertedPrice, 0, len(market.ProviderConfigs))
 	for _, cfg := range market.ProviderConfigs {
 		// Calculate the converted price.
 		adjustedPrice, err := m.CalculateAdjustedPrice(cfg)
@@ -169,7 +579,12 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			continue
 		}
 
-		convertedPrices = append(convertedPrices, adjustedPrice)
+		convertedPrices = append(convertedPrices, ConvertedPrice{
+			Provider:  cfg,
+			Price:     adjustedPrice,
+			Weight:    m.providerWeight(market.Ticker, cfg, adjustedPrice),
+			Timestamp: m.providerTimestamps[cfg.Name][cfg.OffChainTicker],
+		})
 		m.logger.Debug(
 			"calculated converted price",
 			zap.String("target_ticker", market.Ticker.String()),
@@ -177,7 +592,6 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			zap.Any("provider", cfg.Name),
 		)
 
-		m.metrics.AddProviderTick(cfg.Name, market.Ticker.String(), true)
 		floatPrice, _ := adjustedPrice.Float64()
 		m.metrics.UpdatePrice(cfg.Name, market.Ticker.String(), market.Ticker.GetDecimals(), floatPrice)
 	}
@@ -185,16 +599,136 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	return convertedPrices
 }
 
+// updateDispersionMetrics reports the unscaled dispersion statistics of the ticker.
+func (m *IndexPriceAggregator) updateDispersionMetrics(ticker mmtypes.Ticker, dispersion types.PriceDispersion) {
+	stdDev, _ := dispersion.StdDev.Float64()
+	interquartileRange, _ := dispersion.InterquartileRange.Float64()
+	minPrice, _ := dispersion.Min.Float64()
+	maxPrice, _ := dispersion.Max.Float64()
+
+	m.metrics.UpdatePriceDispersion(ticker.String(), stdDev, interquartileRange, minPrice, maxPrice)
+}
+
+// aggregate aggregates the converted prices of the ticker using the strategy selected in the
+// ticker's metadata. The default median is used if the ticker does not select a strategy, or if
+// its strategy is unknown or fails.
+func (m *IndexPriceAggregator) aggregate(
+	ticker mmtypes.Ticker,
+	convertedPrices []ConvertedPrice,
+	now time.Time,
+) *big.Float {
+	md, ok := m.tickerMetadata[ticker.String()]
+	if !ok || md.Strategy == nil || len(convertedPrices) == 0 {
+		return m.medianPrice(ticker, convertedPrices, now)
+	}
+
+	strategy, ok := m.strategies[md.Strategy.Name]
+	if !ok {
+		m.logger.Debug(
+			"unknown ticker aggregation strategy; using default median",
+			zap.String("ticker", ticker.String()),
+			zap.String("strategy", md.Strategy.Name),
+		)
+
+		return m.medianPrice(ticker, convertedPrices, now)
+	}
+
+	price, err := strategy(convertedPrices, *md.Strategy)
+	if err != nil {
+		m.logger.Debug(
+			"failed to aggregate prices with ticker aggregation strategy; using default median",
+			zap.String("ticker", ticker.String()),
+			zap.String("strategy", md.Strategy.Name),
+			zap.Error(err),
+		)
+
+		return m.medianPrice(ticker, convertedPrices, now)
+	}
+
+	return price
+}
+
+// medianPrice returns the median of the converted prices. If the weighted median is enabled and
+// every converted price has a positive weight, the weighted median is returned instead. If
+// staleness decay is enabled, the weight of each price is additionally halved for every
+// half-life that has passed since the provider reported it.
+func (m *IndexPriceAggregator) medianPrice(
+	ticker mmtypes.Ticker,
+	convertedPrices []ConvertedPrice,
+	now time.Time,
+) *big.Float {
+	var weights []*big.Float
+	if m.aggregationCfg.WeightedMedian {
+		var ok bool
+		if weights, ok = weightsOf(convertedPrices); !ok {
+			m.logger.Warn(
+				"missing weights for converted prices; using unweighted median",
+				zap.String("ticker", ticker.String()),
+				zap.Any("converted_prices", convertedPrices),
+			)
+		}
+	}
+
+	if m.aggregationCfg.StalenessDecay.Enabled() {
+		weights = decayWeights(convertedPrices, weights, now, m.aggregationCfg.StalenessDecay.HalfLife)
+	}
+
+	if weights != nil {
+		if median := math.CalculateWeightedMedian(pricesOf(convertedPrices), weights); median != nil {
+			return median
+		}
+
+		// The weights of very old prices can decay to zero, in which case there is no
+		// weighted median.
+		m.logger.Warn(
+			"total weight of converted prices is not positive; using unweighted median",
+			zap.String("ticker", ticker.String()),
+			zap.Any("converted_prices", convertedPrices),
+		)
+	}
+
+	return math.CalculateMedian(pricesOf(convertedPrices))
+}
+
+// filterOutliers runs the ticker's outlier filter over the converted prices and returns the
+// prices that were accepted. Rejected prices count against the provider's tick success.
+func (m *IndexPriceAggregator) filterOutliers(
+	ticker mmtypes.Ticker,
+	convertedPrices []ConvertedPrice,
+) []ConvertedPrice {
+	accepted, rejected := FilterOutliers(m.outlierFilter(ticker), convertedPrices)
+	for _, price := range rejected {
+		m.logger.Info(
+			"rejected outlier price",
+			zap.String("target_ticker", ticker.String()),
+			zap.String("provider", price.Provider.Name),
+			zap.String("off_chain_ticker", price.Provider.OffChainTicker),
+			zap.String("price", price.Price.String()),
+		)
+
+		m.metrics.AddOutlierRejection(price.Provider.Name, ticker.String())
+		m.metrics.AddProviderTick(price.Provider.Name, ticker.String(), false)
+	}
+
+	for _, price := range accepted {
+		m.metrics.AddProviderTick(price.Provider.Name, ticker.String(), true)
+	}
+
+	return accepted
+}
+
 // CalculateAdjustedPrice calculates an adjusted price for a given set of operations (if applicable).
 // In particular, this assumes that every operation is either:
 //
 //  1. A direct conversion from the base ticker to the target ticker i.e. we want BTC/USD and
 //     we have BTC/USD from a provider (e.g. Coinbase).
-//  2. We need to convert the price of a given asset against the index price of an asset.
+//  2. We need to convert the price of a given asset against the index prices of one or more
+//     assets i.e. we want FOO/USD and we have FOO/ATOM, which is normalized by ATOM/USDT and
+//     then by USDT/USD.
 //
 // In the first case, we can simply return the price of the provider. In the second case, we need
-// to adjust the price by the index price of the asset. If the index price is not available, we
-// return an error.
+// to adjust the price by each index price in order, dividing rather than multiplying by the
+// index prices that are inverted. If any index price is not available, we return an error.
 func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 	cfg mmtypes.ProviderConfig,
 ) (*big.Float, error) {
@@ -203,15 +737,30 @@ func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 		return nil, err
 	}
 
-	if cfg.NormalizeByPair == nil {
+	path := cfg.NormalizationPath()
+	if len(path) == 0 {
 		return price, nil
 	}
 
-	normalizeByIndexPrice, err := m.GetIndexPrice(*cfg.NormalizeByPair)
-	if err != nil {
-		return nil, err
+	adjusted := new(big.Float).Copy(price)
+	for _, pair := range path {
+		normalizeByIndexPrice, err := m.GetIndexPrice(pair.CurrencyPair)
+		if err != nil {
+			return nil, err
+		}
+
+		// Make sure that the price is adjusted by the market price.
+		if pair.Invert {
+			if normalizeByIndexPrice.Sign() == 0 {
+				return nil, fmt.Errorf("cannot invert zero index price for ticker: %s", pair.CurrencyPair)
+			}
+
+			adjusted.Quo(adjusted, normalizeByIndexPrice)
+			continue
+		}
+
+		adjusted.Mul(adjusted, normalizeByIndexPrice)
 	}
 
-	// Make sure that the price is adjusted by the market price.
-	return new(big.Float).Mul(price, normalizeByIndexPrice), nil
+	return adjusted, nil
 }
@@ -4,9 +4,12 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/metrics/mocks"
 	"github.com/skip-mev/connect/v2/oracle/types"
 	"github.com/skip-mev/connect/v2/pkg/math/oracle"
 	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -164,6 +167,136 @@ func TestAggregateData(t *testing.T) {
 	}
 }
 
+func TestAggregateDataWithOutlierFilter(t *testing.T) {
+	outlierMarketMap := func(metadataJSON string) mmtypes.MarketMap {
+		ticker := BTC_USD
+		ticker.MinProviderCount = 2
+		ticker.Metadata_JSON = metadataJSON
+
+		return mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				ticker.String(): {
+					Ticker: ticker,
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{
+							Name:           coinbase.Name,
+							OffChainTicker: "BTC-USD",
+						},
+						{
+							Name:           kucoin.Name,
+							OffChainTicker: "BTC-USD",
+						},
+						{
+							Name:           binance.Name,
+							OffChainTicker: "BTCUSD",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	setPrices := func(aggregator *oracle.IndexPriceAggregator) {
+		aggregator.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+		aggregator.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(70_100)})
+		aggregator.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(90_000)})
+	}
+
+	t.Run("no outlier filter includes the spike in the median", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, outlierMarketMap(""), metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		setPrices(m)
+		m.AggregatePrices()
+
+		result := m.GetIndexPrices()
+		require.Equal(t, big.NewFloat(70_100).SetPrec(36), result[BTC_USD.String()].SetPrec(36))
+	})
+
+	t.Run("oracle wide outlier filter rejects the spike", func(t *testing.T) {
+		mockMetrics := mocks.NewMetrics(t)
+		mockMetrics.On("AddOutlierRejection", binance.Name, BTC_USD.String()).Once()
+		mockMetrics.On("AddProviderTick", binance.Name, BTC_USD.String(), false).Once()
+		mockMetrics.On("AddProviderTick", mock.Anything, BTC_USD.String(), true).Twice()
+		mockMetrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		mockMetrics.On("AddProviderCountForMarket", BTC_USD.String(), 2).Once()
+		mockMetrics.On("AddTickerTick", BTC_USD.String()).Once()
+		mockMetrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Once()
+		mockMetrics.On("UpdatePriceDispersion", BTC_USD.String(), 50.0, 50.0, 70_000.0, 70_100.0).Once()
+		mockMetrics.On("UpdatePriorityTier", BTC_USD.String(), uint32(0)).Once()
+		mockMetrics.On("MissingPrices", mock.Anything).Once()
+
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			outlierMarketMap(""),
+			mockMetrics,
+			oracle.WithAggregationConfig(config.AggregationConfig{
+				OutlierFilter: config.OutlierFilterConfig{
+					Method:    config.OutlierFilterPercent,
+					Threshold: 5,
+				},
+			}),
+		)
+		require.NoError(t, err)
+
+		setPrices(m)
+		m.AggregatePrices()
+
+		result := m.GetIndexPrices()
+		require.Equal(t, big.NewFloat(70_050).SetPrec(36), result[BTC_USD.String()].SetPrec(36))
+
+		// The dispersion only includes the prices that survived the outlier filter.
+		dispersion := m.GetDispersions()[BTC_USD.String()]
+		require.Equal(t, 2, dispersion.ProviderCount)
+	})
+
+	t.Run("ticker metadata outlier filter overrides the oracle wide filter", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			outlierMarketMap(`{"outlier_filter":{"method":"mad","threshold":3.5}}`),
+			metrics.NewNopMetrics(),
+			oracle.WithAggregationConfig(config.AggregationConfig{
+				OutlierFilter: config.OutlierFilterConfig{
+					Method:    config.OutlierFilterPercent,
+					Threshold: 50,
+				},
+			}),
+		)
+		require.NoError(t, err)
+
+		setPrices(m)
+		m.AggregatePrices()
+
+		result := m.GetIndexPrices()
+		require.Equal(t, big.NewFloat(70_050).SetPrec(36), result[BTC_USD.String()].SetPrec(36))
+	})
+
+	t.Run("ticker is missing if too many prices are rejected", func(t *testing.T) {
+		mm := outlierMarketMap(`{"outlier_filter":{"method":"percent","threshold":0.1}}`)
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		setPrices(m)
+		m.AggregatePrices()
+
+		require.Empty(t, m.GetIndexPrices())
+	})
+
+	t.Run("invalid aggregation config", func(t *testing.T) {
+		_, err := oracle.NewIndexPriceAggregator(
+			logger,
+			outlierMarketMap(""),
+			metrics.NewNopMetrics(),
+			oracle.WithAggregationConfig(config.AggregationConfig{
+				OutlierFilter: config.OutlierFilterConfig{
+					Method: config.OutlierFilterMAD,
+				},
+			}),
+		)
+		require.Error(t, err)
+	})
+}
+
 func TestCalculateConvertedPrices(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -617,6 +750,83 @@ func TestCalculateAdjustedPrice(t *testing.T) {
 			expectedPrice: big.NewFloat(0.1e-18),
 			expectedErr:   false,
 		},
+		{
+			name:   "price is adjusted by each pair in the normalization path (FOO/ATOM * ATOM/USDT * USDT/USD = FOO/USD)",
+			target: BTC_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "FOO-ATOM",
+				NormalizeByPairs: []mmtypes.NormalizationPair{
+					{CurrencyPair: pkgtypes.NewCurrencyPair("ATOM", "USDT")},
+					{CurrencyPair: usdtusdCP},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"FOO-ATOM": big.NewFloat(2),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				indexPrices := types.Prices{
+					"ATOM/USDT":        big.NewFloat(5),
+					usdtusdCP.String(): big.NewFloat(1.1),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrice: big.NewFloat(11),
+			expectedErr:   false,
+		},
+		{
+			name:   "price is divided by inverted pairs in the normalization path (FOO/ATOM * ATOM/USDT / USD/USDT = FOO/USD)",
+			target: BTC_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "FOO-ATOM",
+				NormalizeByPairs: []mmtypes.NormalizationPair{
+					{CurrencyPair: pkgtypes.NewCurrencyPair("ATOM", "USDT")},
+					{CurrencyPair: pkgtypes.NewCurrencyPair("USD", "USDT"), Invert: true},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"FOO-ATOM": big.NewFloat(2),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				indexPrices := types.Prices{
+					"ATOM/USDT": big.NewFloat(5),
+					"USD/USDT":  big.NewFloat(2),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrice: big.NewFloat(5),
+			expectedErr:   false,
+		},
+		{
+			name:   "index price for a pair in the normalization path does not exist",
+			target: BTC_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "FOO-ATOM",
+				NormalizeByPairs: []mmtypes.NormalizationPair{
+					{CurrencyPair: pkgtypes.NewCurrencyPair("ATOM", "USDT")},
+					{CurrencyPair: usdtusdCP},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"FOO-ATOM": big.NewFloat(2),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				indexPrices := types.Prices{
+					"ATOM/USDT": big.NewFloat(5),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
 	}
 
 	for _, tc := range testCases {
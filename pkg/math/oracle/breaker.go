@@ -0,0 +1,262 @@
+package oracle
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+const (
+	// circuitBreakerHold serves the last accepted price while the circuit breaker is tripped.
+	circuitBreakerHold = "hold"
+	// circuitBreakerOmit omits the ticker while the circuit breaker is tripped.
+	circuitBreakerOmit = "omit"
+	// defaultReferenceSmoothing is the smoothing factor of the reference price if none is set.
+	defaultReferenceSmoothing = 0.1
+)
+
+// The reasons a circuit breaker is tripped or reset. These are reported in metrics and logs.
+const (
+	tripReasonTickDeviation      = "tick_deviation"
+	tripReasonReferenceDeviation = "reference_deviation"
+	resetReasonRecovered         = "recovered"
+	resetReasonCooldownElapsed   = "cooldown_elapsed"
+	resetReasonDisabled          = "disabled"
+)
+
+// circuitBreakerConfig is the validated circuit breaker configuration of a ticker.
+type circuitBreakerConfig struct {
+	// maxTickDeviation is the maximum deviation from the previous index price. Zero
+	// disables the check.
+	maxTickDeviation float64
+	// maxReferenceDeviation is the maximum deviation from the reference price. Zero
+	// disables the check.
+	maxReferenceDeviation float64
+	// referenceSmoothing is the smoothing factor of the reference price.
+	referenceSmoothing *big.Float
+	// cooldown is how long the circuit breaker stays tripped.
+	cooldown time.Duration
+	// omit is true if the ticker is omitted, rather than held, while tripped.
+	omit bool
+}
+
+// newCircuitBreakerConfig validates the circuit breaker metadata of a ticker and applies the
+// defaults for the fields that are not set.
+func newCircuitBreakerConfig(md tickermetadata.CircuitBreaker) (circuitBreakerConfig, error) {
+	if md.MaxTickDeviation < 0 || md.MaxReferenceDeviation < 0 {
+		return circuitBreakerConfig{}, fmt.Errorf("circuit breaker deviations must be non-negative")
+	}
+
+	if md.MaxTickDeviation == 0 && md.MaxReferenceDeviation == 0 {
+		return circuitBreakerConfig{}, fmt.Errorf("circuit breaker must set a max tick or max reference deviation")
+	}
+
+	smoothing := md.ReferenceSmoothing
+	if smoothing == 0 {
+		smoothing = defaultReferenceSmoothing
+	}
+	if smoothing < 0 || smoothing > 1 {
+		return circuitBreakerConfig{}, fmt.Errorf("circuit breaker reference smoothing must be in (0, 1]; got %v", smoothing)
+	}
+
+	cooldown, err := time.ParseDuration(md.Cooldown)
+	if err != nil {
+		return circuitBreakerConfig{}, fmt.Errorf("invalid circuit breaker cooldown: %w", err)
+	}
+	if cooldown <= 0 {
+		return circuitBreakerConfig{}, fmt.Errorf("circuit breaker cooldown must be positive; got %s", cooldown)
+	}
+
+	switch md.Action {
+	case "", circuitBreakerHold, circuitBreakerOmit:
+	default:
+		return circuitBreakerConfig{}, fmt.Errorf("unknown circuit breaker action: %s", md.Action)
+	}
+
+	return circuitBreakerConfig{
+		maxTickDeviation:      md.MaxTickDeviation,
+		maxReferenceDeviation: md.MaxReferenceDeviation,
+		referenceSmoothing:    big.NewFloat(smoothing),
+		cooldown:              cooldown,
+		omit:                  md.Action == circuitBreakerOmit,
+	}, nil
+}
+
+// breach returns the reason the given deviations breach the configured limits, or an empty
+// string if they do not.
+func (c circuitBreakerConfig) breach(tickDeviation, referenceDeviation float64) string {
+	switch {
+	case c.maxTickDeviation > 0 && tickDeviation > c.maxTickDeviation:
+		return tripReasonTickDeviation
+	case c.maxReferenceDeviation > 0 && referenceDeviation > c.maxReferenceDeviation:
+		return tripReasonReferenceDeviation
+	default:
+		return ""
+	}
+}
+
+// circuitBreakerState is the state of a single ticker's circuit breaker.
+type circuitBreakerState struct {
+	// lastPrice is the last accepted index price.
+	lastPrice *big.Float
+	// reference is the exponential moving average of the accepted index prices.
+	reference *big.Float
+	// tripped is true if the circuit breaker is currently tripped.
+	tripped bool
+	// trippedUntil is the time at which the cooldown of a tripped circuit breaker ends.
+	trippedUntil time.Time
+}
+
+// breakerTransition describes a circuit breaker being tripped or reset.
+type breakerTransition struct {
+	// tripped is true if the circuit breaker was tripped and false if it was reset.
+	tripped bool
+	// reason is the reason the circuit breaker was tripped or reset.
+	reason string
+	// price is the index price that caused the transition.
+	price *big.Float
+	// lastPrice and reference are the circuit breaker's prices before the transition.
+	lastPrice *big.Float
+	reference *big.Float
+	// tickDeviation and referenceDeviation are the deviations of the index price.
+	tickDeviation      float64
+	referenceDeviation float64
+	// trippedUntil is the end of the cooldown if the circuit breaker was tripped.
+	trippedUntil time.Time
+}
+
+// circuitBreakers tracks the circuit breaker of every ticker that has one configured. Each
+// index price is compared against the last accepted price and a slower moving reference
+// price. If either deviation is breached, the circuit breaker trips and the last accepted
+// price is held (or the ticker omitted) until either an index price within the limits is
+// seen, or the cooldown elapses, after which the latest index price is accepted as the new
+// baseline. A persistent move is therefore only delayed by the cooldown, whereas a bad feed
+// that recovers within the cooldown is never served.
+//
+// circuitBreakers is not thread-safe; callers must hold the aggregator's lock.
+type circuitBreakers struct {
+	states map[string]*circuitBreakerState
+}
+
+// newCircuitBreakers returns a new set of circuit breakers.
+func newCircuitBreakers() *circuitBreakers {
+	return &circuitBreakers{
+		states: make(map[string]*circuitBreakerState),
+	}
+}
+
+// Apply runs the ticker's index price through its circuit breaker. It returns the price that
+// should be served, which is nil if the ticker should be omitted, along with the transition
+// of the circuit breaker if it was tripped or reset.
+func (b *circuitBreakers) Apply(
+	ticker string,
+	cfg circuitBreakerConfig,
+	now time.Time,
+	price *big.Float,
+) (*big.Float, *breakerTransition) {
+	state, ok := b.states[ticker]
+	if !ok {
+		b.states[ticker] = &circuitBreakerState{
+			lastPrice: new(big.Float).Copy(price),
+			reference: new(big.Float).Copy(price),
+		}
+
+		return price, nil
+	}
+
+	transition := &breakerTransition{
+		price:              price,
+		lastPrice:          state.lastPrice,
+		reference:          state.reference,
+		tickDeviation:      relativeDeviation(price, state.lastPrice),
+		referenceDeviation: relativeDeviation(price, state.reference),
+	}
+	reason := cfg.breach(transition.tickDeviation, transition.referenceDeviation)
+
+	switch {
+	case state.tripped && reason == "":
+		state.tripped = false
+		state.accept(price, cfg.referenceSmoothing)
+		transition.reason = resetReasonRecovered
+
+		return price, transition
+	case state.tripped && !now.Before(state.trippedUntil):
+		// The move persisted for the entire cooldown, so it is accepted as the new baseline.
+		state.tripped = false
+		state.lastPrice = new(big.Float).Copy(price)
+		state.reference = new(big.Float).Copy(price)
+		transition.reason = resetReasonCooldownElapsed
+
+		return price, transition
+	case state.tripped:
+		return state.held(cfg), nil
+	case reason != "":
+		state.tripped = true
+		state.trippedUntil = now.Add(cfg.cooldown)
+		transition.tripped = true
+		transition.reason = reason
+		transition.trippedUntil = state.trippedUntil
+
+		return state.held(cfg), transition
+	default:
+		state.accept(price, cfg.referenceSmoothing)
+		return price, nil
+	}
+}
+
+// Remove removes the circuit breaker of the ticker. Returns true if it was tripped.
+func (b *circuitBreakers) Remove(ticker string) bool {
+	state, ok := b.states[ticker]
+	if !ok {
+		return false
+	}
+
+	delete(b.states, ticker)
+	return state.tripped
+}
+
+// Retain removes the circuit breaker of every ticker that is not in the given set.
+func (b *circuitBreakers) Retain(tickers map[string]struct{}) {
+	for ticker := range b.states {
+		if _, ok := tickers[ticker]; !ok {
+			delete(b.states, ticker)
+		}
+	}
+}
+
+// accept records the price as the last accepted price and moves the reference towards it.
+func (s *circuitBreakerState) accept(price, smoothing *big.Float) {
+	s.lastPrice = new(big.Float).Copy(price)
+
+	// reference = reference + smoothing * (price - reference)
+	delta := new(big.Float).Sub(price, s.reference)
+	s.reference = delta.Mul(delta, smoothing).Add(delta, s.reference)
+}
+
+// held returns the price served while the circuit breaker is tripped.
+func (s *circuitBreakerState) held(cfg circuitBreakerConfig) *big.Float {
+	if cfg.omit {
+		return nil
+	}
+
+	return new(big.Float).Copy(s.lastPrice)
+}
+
+// relativeDeviation returns |price - base| / |base|. Returns +Inf if the base is zero and the
+// price is not.
+func relativeDeviation(price, base *big.Float) float64 {
+	diff := new(big.Float).Sub(price, base)
+	if diff.Sign() == 0 {
+		return 0
+	}
+
+	if base.Sign() == 0 {
+		return math.Inf(1)
+	}
+
+	deviation, _ := diff.Quo(diff.Abs(diff), new(big.Float).Abs(base)).Float64()
+	return deviation
+}
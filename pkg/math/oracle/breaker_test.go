@@ -0,0 +1,214 @@
+package oracle_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+// breakerMetrics records the circuit breaker trips and resets reported by the aggregator.
+type breakerMetrics struct {
+	metrics.Metrics
+	events []string
+}
+
+func (m *breakerMetrics) AddCircuitBreakerTrip(_, reason string) {
+	m.events = append(m.events, "trip:"+reason)
+}
+
+func (m *breakerMetrics) AddCircuitBreakerReset(_, reason string) {
+	m.events = append(m.events, "reset:"+reason)
+}
+
+func TestAggregateDataWithCircuitBreaker(t *testing.T) {
+	// step is a single aggregation round.
+	type step struct {
+		elapsed  time.Duration
+		price    float64
+		expected float64
+		missing  bool
+		event    string
+	}
+
+	testCases := []struct {
+		name    string
+		breaker *tickermetadata.CircuitBreaker
+		steps   []step
+	}{
+		{
+			name:    "no circuit breaker",
+			breaker: nil,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 180, expected: 180},
+			},
+		},
+		{
+			name: "invalid circuit breaker is disabled",
+			breaker: &tickermetadata.CircuitBreaker{
+				MaxTickDeviation: 0.1,
+				Cooldown:         "soon",
+			},
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 180, expected: 180},
+			},
+		},
+		{
+			name: "moves within the tick deviation are served",
+			breaker: &tickermetadata.CircuitBreaker{
+				MaxTickDeviation: 0.1,
+				Cooldown:         "1m",
+			},
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 109, expected: 109},
+				{elapsed: 20 * time.Second, price: 100, expected: 100},
+			},
+		},
+		{
+			name: "last good price is held until the price recovers",
+			breaker: &tickermetadata.CircuitBreaker{
+				MaxTickDeviation: 0.1,
+				Cooldown:         "1m",
+			},
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 180, expected: 100, event: "trip:tick_deviation"},
+				{elapsed: 20 * time.Second, price: 180, expected: 100},
+				{elapsed: 30 * time.Second, price: 101, expected: 101, event: "reset:recovered"},
+			},
+		},
+		{
+			name: "ticker is omitted while tripped",
+			breaker: &tickermetadata.CircuitBreaker{
+				MaxTickDeviation: 0.1,
+				Cooldown:         "1m",
+				Action:           "omit",
+			},
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 20, missing: true, event: "trip:tick_deviation"},
+				{elapsed: 20 * time.Second, price: 100, expected: 100, event: "reset:recovered"},
+			},
+		},
+		{
+			name: "persistent move is accepted once the cooldown elapses",
+			breaker: &tickermetadata.CircuitBreaker{
+				MaxTickDeviation: 0.1,
+				Cooldown:         "30s",
+			},
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 20, expected: 100, event: "trip:tick_deviation"},
+				{elapsed: 30 * time.Second, price: 20, expected: 100},
+				{elapsed: 40 * time.Second, price: 21, expected: 21, event: "reset:cooldown_elapsed"},
+				{elapsed: 50 * time.Second, price: 22, expected: 22},
+			},
+		},
+		{
+			name: "steady drift away from the reference price trips the circuit breaker",
+			breaker: &tickermetadata.CircuitBreaker{
+				MaxTickDeviation:      0.15,
+				MaxReferenceDeviation: 0.2,
+				ReferenceSmoothing:    0.1,
+				Cooldown:              "1m",
+			},
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				// reference = 101
+				{elapsed: 10 * time.Second, price: 110, expected: 110},
+				// reference = 103.0
+				{elapsed: 20 * time.Second, price: 121, expected: 121},
+				{elapsed: 30 * time.Second, price: 133.1, expected: 121, event: "trip:reference_deviation"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ticker := BTC_USD
+			ticker.MinProviderCount = 1
+			if tc.breaker != nil {
+				bz, err := json.Marshal(tickermetadata.NewAggregationMetadata(nil, tc.breaker, nil))
+				require.NoError(t, err)
+				ticker.Metadata_JSON = string(bz)
+			}
+
+			marketMap := mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					ticker.String(): {
+						Ticker: ticker,
+						ProviderConfigs: []mmtypes.ProviderConfig{
+							{
+								Name:           coinbase.Name,
+								OffChainTicker: "BTC-USD",
+							},
+						},
+					},
+				},
+			}
+
+			start := time.Now()
+			now := start
+			recorder := &breakerMetrics{Metrics: metrics.NewNopMetrics()}
+
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				marketMap,
+				recorder,
+				oracle.WithClock(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			for _, s := range tc.steps {
+				now = start.Add(s.elapsed)
+				recorder.events = nil
+
+				m.SetProviderPrices(coinbase.Name, types.Prices{
+					"BTC-USD": big.NewFloat(s.price),
+				})
+				m.AggregatePrices()
+
+				if s.event == "" {
+					require.Empty(t, recorder.events, "elapsed %s", s.elapsed)
+				} else {
+					require.Equal(t, []string{s.event}, recorder.events, "elapsed %s", s.elapsed)
+				}
+
+				result := m.GetPrices()
+				if s.missing {
+					require.NotContains(t, result, ticker.String())
+					require.NotContains(t, m.GetIndexPrices(), ticker.String())
+					continue
+				}
+
+				// The held price is also used as the index price for conversions.
+				expected := big.NewFloat(s.expected)
+				require.Zero(
+					t,
+					expected.Cmp(m.GetIndexPrices()[ticker.String()]),
+					"elapsed %s: expected index price %v, got %v", s.elapsed, expected, m.GetIndexPrices()[ticker.String()],
+				)
+
+				scaled := math.ScaleBigFloat(expected, ticker.Decimals)
+				require.Zero(
+					t,
+					scaled.Cmp(result[ticker.String()]),
+					"elapsed %s: expected %v, got %v", s.elapsed, scaled, result[ticker.String()],
+				)
+			}
+		})
+	}
+}
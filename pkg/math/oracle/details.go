@@ -0,0 +1,110 @@
+package oracle
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// priceKey identifies the price of a provider config.
+type priceKey struct {
+	provider       string
+	offChainTicker string
+}
+
+// priceKeysOf returns the set of provider configs of the converted prices.
+func priceKeysOf(prices []ConvertedPrice) map[priceKey]struct{} {
+	keys := make(map[priceKey]struct{}, len(prices))
+	for _, price := range prices {
+		keys[priceKey{provider: price.Provider.Name, offChainTicker: price.Provider.OffChainTicker}] = struct{}{}
+	}
+
+	return keys
+}
+
+// aggregationStages are the converted prices of a ticker after each stage of aggregation. Each
+// stage is a subset of the previous stage.
+type aggregationStages struct {
+	// converted are the prices that could be converted to the ticker.
+	converted []ConvertedPrice
+	// admitted are the converted prices whose (provider, ticker) pair is not quarantined.
+	admitted []ConvertedPrice
+	// accepted are the admitted prices that were accepted by the outlier filter.
+	accepted []ConvertedPrice
+	// selected are the accepted prices of the selected priority tiers.
+	selected []ConvertedPrice
+	// aggregated is true if the ticker had enough selected prices to be aggregated.
+	aggregated bool
+}
+
+// calculateProviderPriceDetails returns the details of the price of each provider config of the market,
+// given the converted prices of the market after each stage of aggregation.
+func (m *IndexPriceAggregator) calculateProviderPriceDetails(
+	market mmtypes.Market,
+	stages aggregationStages,
+	now time.Time,
+) []types.ProviderPriceDetail {
+	converted := make(map[priceKey]ConvertedPrice, len(stages.converted))
+	for _, price := range stages.converted {
+		converted[priceKey{provider: price.Provider.Name, offChainTicker: price.Provider.OffChainTicker}] = price
+	}
+	admitted := priceKeysOf(stages.admitted)
+	accepted := priceKeysOf(stages.accepted)
+	selected := priceKeysOf(stages.selected)
+
+	// exclusionReason returns the reason the price of the provider config was not used to
+	// calculate the ticker's price, or an empty string if it was used.
+	exclusionReason := func(key priceKey) string {
+		if _, ok := converted[key]; !ok {
+			return types.ExclusionReasonConversionFailed
+		}
+		if _, ok := admitted[key]; !ok {
+			return types.ExclusionReasonQuarantined
+		}
+		if _, ok := accepted[key]; !ok {
+			return types.ExclusionReasonOutlier
+		}
+		if _, ok := selected[key]; !ok {
+			return types.ExclusionReasonPriorityTier
+		}
+		if !stages.aggregated {
+			return types.ExclusionReasonInsufficientProviders
+		}
+		return ""
+	}
+
+	details := make([]types.ProviderPriceDetail, 0, len(market.ProviderConfigs))
+	for _, cfg := range market.ProviderConfigs {
+		key := priceKey{provider: cfg.Name, offChainTicker: cfg.OffChainTicker}
+		detail := types.ProviderPriceDetail{
+			Provider:          cfg.Name,
+			OffChainTicker:    cfg.OffChainTicker,
+			Timestamp:         m.providerTimestamps[cfg.Name][cfg.OffChainTicker],
+			Invert:            cfg.Invert,
+			NormalizationPath: cfg.NormalizationPath(),
+		}
+
+		if price, ok := converted[key]; ok {
+			detail.ConvertedPrice = math.ScaleBigFloat(new(big.Float).Copy(price.Price), market.Ticker.Decimals)
+		}
+
+		if price, ok := m.providerTWAPPrice(market.Ticker, cfg, now); ok {
+			detail.TWAPPrice = math.ScaleBigFloat(price, market.Ticker.Decimals)
+		}
+
+		if raw := m.providerPrices[cfg.Name][cfg.OffChainTicker]; raw != nil {
+			detail.RawPrice = new(big.Float).Copy(raw)
+			detail.ExclusionReason = exclusionReason(key)
+		} else {
+			detail.ExclusionReason = types.ExclusionReasonMissingPrice
+		}
+
+		detail.Included = detail.ExclusionReason == ""
+		details = append(details, detail)
+	}
+
+	return details
+}
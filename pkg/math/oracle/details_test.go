@@ -0,0 +1,263 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	"github.com/skip-mev/connect/v2/providers/websockets/kucoin"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestGetProviderPriceDetails(t *testing.T) {
+	usdtusd := pkgtypes.NewCurrencyPair("USDT", "USD")
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// detailsMarketMap returns a market map with a single BTC/USD market whose provider configs
+	// are each excluded from aggregation for a different reason.
+	detailsMarketMap := func(minProviderCount uint64) mmtypes.MarketMap {
+		ticker := BTC_USD
+		ticker.MinProviderCount = minProviderCount
+
+		return mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				ticker.String(): {
+					Ticker: ticker,
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{Name: coinbase.Name, OffChainTicker: "BTC-USD"},
+						{Name: binance.Name, OffChainTicker: "BTCUSD"},
+						// kucoin's price deviates from the other prices.
+						{Name: kucoin.Name, OffChainTicker: "BTC-USD"},
+						// USDT/USD has no index price, so this price cannot be converted.
+						{Name: coinbase.Name, OffChainTicker: "BTC-USDT", NormalizeByPair: &usdtusd},
+						// binance does not report this ticker.
+						{Name: binance.Name, OffChainTicker: "BTCUSDC"},
+						// kucoin's BTC-USDT price has a lower priority.
+						{Name: kucoin.Name, OffChainTicker: "BTC-USDT", Priority: 1},
+					},
+				},
+			},
+		}
+	}
+
+	setPrices := func(m *oracle.IndexPriceAggregator) {
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USD":  big.NewFloat(100),
+			"BTC-USDT": big.NewFloat(100.25),
+		})
+		m.SetProviderTimestamps(coinbase.Name, map[string]time.Time{"BTC-USD": timestamp})
+		m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(101)})
+		m.SetProviderPrices(kucoin.Name, types.Prices{
+			"BTC-USD":  big.NewFloat(150),
+			"BTC-USDT": big.NewFloat(100.5),
+		})
+	}
+
+	// scaled returns the price scaled by the decimals of BTC/USD.
+	scaled := func(price float64) *big.Float {
+		return new(big.Float).Mul(big.NewFloat(price), big.NewFloat(1e8))
+	}
+
+	outlierFilter := config.OutlierFilterConfig{
+		Method:    config.OutlierFilterPercent,
+		Threshold: 5,
+	}
+
+	testCases := []struct {
+		name             string
+		minProviderCount uint64
+		expected         []types.ProviderPriceDetail
+	}{
+		{
+			name:             "reports why each price was excluded",
+			minProviderCount: 2,
+			expected: []types.ProviderPriceDetail{
+				{
+					Provider:       coinbase.Name,
+					OffChainTicker: "BTC-USD",
+					RawPrice:       big.NewFloat(100),
+					ConvertedPrice: scaled(100),
+					Timestamp:      timestamp,
+					Included:       true,
+				},
+				{
+					Provider:       binance.Name,
+					OffChainTicker: "BTCUSD",
+					RawPrice:       big.NewFloat(101),
+					ConvertedPrice: scaled(101),
+					Included:       true,
+				},
+				{
+					Provider:        kucoin.Name,
+					OffChainTicker:  "BTC-USD",
+					RawPrice:        big.NewFloat(150),
+					ConvertedPrice:  scaled(150),
+					ExclusionReason: types.ExclusionReasonOutlier,
+				},
+				{
+					Provider:          coinbase.Name,
+					OffChainTicker:    "BTC-USDT",
+					RawPrice:          big.NewFloat(100.25),
+					NormalizationPath: []mmtypes.NormalizationPair{{CurrencyPair: usdtusd}},
+					ExclusionReason:   types.ExclusionReasonConversionFailed,
+				},
+				{
+					Provider:        binance.Name,
+					OffChainTicker:  "BTCUSDC",
+					ExclusionReason: types.ExclusionReasonMissingPrice,
+				},
+				{
+					Provider:        kucoin.Name,
+					OffChainTicker:  "BTC-USDT",
+					RawPrice:        big.NewFloat(100.5),
+					ConvertedPrice:  scaled(100.5),
+					ExclusionReason: types.ExclusionReasonPriorityTier,
+				},
+			},
+		},
+		{
+			name:             "reports prices of a ticker without enough prices as excluded",
+			minProviderCount: 4,
+			expected: []types.ProviderPriceDetail{
+				{
+					Provider:        coinbase.Name,
+					OffChainTicker:  "BTC-USD",
+					RawPrice:        big.NewFloat(100),
+					ConvertedPrice:  scaled(100),
+					Timestamp:       timestamp,
+					ExclusionReason: types.ExclusionReasonInsufficientProviders,
+				},
+				{
+					Provider:        binance.Name,
+					OffChainTicker:  "BTCUSD",
+					RawPrice:        big.NewFloat(101),
+					ConvertedPrice:  scaled(101),
+					ExclusionReason: types.ExclusionReasonInsufficientProviders,
+				},
+				{
+					Provider:        kucoin.Name,
+					OffChainTicker:  "BTC-USD",
+					RawPrice:        big.NewFloat(150),
+					ConvertedPrice:  scaled(150),
+					ExclusionReason: types.ExclusionReasonOutlier,
+				},
+				{
+					Provider:          coinbase.Name,
+					OffChainTicker:    "BTC-USDT",
+					RawPrice:          big.NewFloat(100.25),
+					NormalizationPath: []mmtypes.NormalizationPair{{CurrencyPair: usdtusd}},
+					ExclusionReason:   types.ExclusionReasonConversionFailed,
+				},
+				{
+					Provider:        binance.Name,
+					OffChainTicker:  "BTCUSDC",
+					ExclusionReason: types.ExclusionReasonMissingPrice,
+				},
+				{
+					Provider:        kucoin.Name,
+					OffChainTicker:  "BTC-USDT",
+					RawPrice:        big.NewFloat(100.5),
+					ConvertedPrice:  scaled(100.5),
+					ExclusionReason: types.ExclusionReasonInsufficientProviders,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				detailsMarketMap(tc.minProviderCount),
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationConfig(config.AggregationConfig{OutlierFilter: outlierFilter}),
+			)
+			require.NoError(t, err)
+
+			setPrices(m)
+			m.AggregatePrices()
+
+			details := m.GetProviderPriceDetails()
+			require.Len(t, details, 1)
+			requireDetailsEqual(t, tc.expected, details[BTC_USD.String()])
+		})
+	}
+
+	t.Run("reports quarantined prices", func(t *testing.T) {
+		// Health is tracked per (provider, ticker) pair, so kucoin's BTC-USDT price is dropped
+		// to only sample its BTC-USD price.
+		marketMap := detailsMarketMap(2)
+		market := marketMap.Markets[BTC_USD.String()]
+		market.ProviderConfigs = market.ProviderConfigs[:5]
+		marketMap.Markets[BTC_USD.String()] = market
+
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			marketMap,
+			metrics.NewNopMetrics(),
+			oracle.WithAggregationConfig(config.AggregationConfig{
+				Health: config.HealthConfig{
+					Window:              1,
+					MaxDeviation:        0.05,
+					QuarantineThreshold: 0.5,
+					ReadmitThreshold:    1,
+				},
+			}),
+		)
+		require.NoError(t, err)
+
+		// kucoin's BTC-USD price is quarantined after the first round.
+		for i := 0; i < 2; i++ {
+			setPrices(m)
+			m.AggregatePrices()
+		}
+
+		details := m.GetProviderPriceDetails()[BTC_USD.String()]
+		require.Len(t, details, 5)
+		require.Equal(t, kucoin.Name, details[2].Provider)
+		require.Equal(t, "BTC-USD", details[2].OffChainTicker)
+		require.False(t, details[2].Included)
+		require.Equal(t, types.ExclusionReasonQuarantined, details[2].ExclusionReason)
+	})
+
+	t.Run("omits disabled markets", func(t *testing.T) {
+		marketMap := detailsMarketMap(2)
+		market := marketMap.Markets[BTC_USD.String()]
+		market.Ticker.Enabled = false
+		marketMap.Markets[BTC_USD.String()] = market
+
+		m, err := oracle.NewIndexPriceAggregator(logger, marketMap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		setPrices(m)
+		m.AggregatePrices()
+		require.Empty(t, m.GetProviderPriceDetails())
+	})
+}
+
+// requireDetailsEqual asserts that the provider price details are equal, comparing prices by
+// value.
+func requireDetailsEqual(t *testing.T, expected, actual []types.ProviderPriceDetail) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		requireFloatEqual(t, expected[i].RawPrice, actual[i].RawPrice, "detail %d", i)
+		requireFloatEqual(t, expected[i].ConvertedPrice, actual[i].ConvertedPrice, "detail %d", i)
+		requireFloatEqual(t, expected[i].TWAPPrice, actual[i].TWAPPrice, "detail %d", i)
+
+		expected[i].RawPrice, actual[i].RawPrice = nil, nil
+		expected[i].ConvertedPrice, actual[i].ConvertedPrice = nil, nil
+		expected[i].TWAPPrice, actual[i].TWAPPrice = nil, nil
+		require.Equal(t, expected[i], actual[i], "detail %d", i)
+	}
+}
@@ -0,0 +1,75 @@
+package oracle
+
+import (
+	"math/big"
+
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math"
+)
+
+// CalculateDispersion calculates the dispersion statistics of the given prices. Quartiles are
+// computed by linear interpolation between the closest ranks. The input is not re-ordered.
+// Returns a zero-valued dispersion if there are no prices.
+func CalculateDispersion(prices []*big.Float) types.PriceDispersion {
+	if len(prices) == 0 {
+		return types.PriceDispersion{}
+	}
+
+	sorted := make([]*big.Float, len(prices))
+	copy(sorted, prices)
+	math.SortBigFloats(sorted)
+
+	variance := new(big.Float)
+	for _, deviation := range absDeviations(sorted, mean(sorted)) {
+		variance.Add(variance, new(big.Float).Mul(deviation, deviation))
+	}
+	variance.Quo(variance, new(big.Float).SetInt64(int64(len(sorted))))
+
+	return types.PriceDispersion{
+		StdDev:             new(big.Float).Sqrt(variance),
+		InterquartileRange: new(big.Float).Sub(quantile(sorted, 3, 4), quantile(sorted, 1, 4)),
+		Min:                new(big.Float).Copy(sorted[0]),
+		Max:                new(big.Float).Copy(sorted[len(sorted)-1]),
+		ProviderCount:      len(sorted),
+	}
+}
+
+// ScaleDispersion scales each statistic of the dispersion by the given number of decimals.
+func ScaleDispersion(dispersion types.PriceDispersion, decimals uint64) types.PriceDispersion {
+	scale := func(value *big.Float) *big.Float {
+		if value == nil {
+			return nil
+		}
+
+		return math.ScaleBigFloat(new(big.Float).Copy(value), decimals)
+	}
+
+	return types.PriceDispersion{
+		StdDev:             scale(dispersion.StdDev),
+		InterquartileRange: scale(dispersion.InterquartileRange),
+		Min:                scale(dispersion.Min),
+		Max:                scale(dispersion.Max),
+		ProviderCount:      dispersion.ProviderCount,
+	}
+}
+
+// quantile returns the numerator/denominator quantile of the sorted values, interpolating
+// linearly between the closest ranks.
+func quantile(sorted []*big.Float, numerator, denominator int) *big.Float {
+	// The fractional rank is (n-1) * numerator / denominator.
+	scaled := (len(sorted) - 1) * numerator
+	lower := scaled / denominator
+	remainder := scaled % denominator
+
+	if remainder == 0 {
+		return new(big.Float).Copy(sorted[lower])
+	}
+
+	// lower + (upper - lower) * remainder / denominator
+	fraction := new(big.Float).Quo(
+		new(big.Float).SetInt64(int64(remainder)),
+		new(big.Float).SetInt64(int64(denominator)),
+	)
+	diff := new(big.Float).Sub(sorted[lower+1], sorted[lower])
+	return diff.Mul(diff, fraction).Add(diff, sorted[lower])
+}
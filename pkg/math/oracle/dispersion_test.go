@@ -0,0 +1,95 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+)
+
+func TestCalculateDispersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		prices   []*big.Float
+		expected types.PriceDispersion
+	}{
+		{
+			name:     "no prices",
+			prices:   nil,
+			expected: types.PriceDispersion{},
+		},
+		{
+			name:   "single price",
+			prices: []*big.Float{big.NewFloat(100)},
+			expected: types.PriceDispersion{
+				StdDev:             big.NewFloat(0),
+				InterquartileRange: big.NewFloat(0),
+				Min:                big.NewFloat(100),
+				Max:                big.NewFloat(100),
+				ProviderCount:      1,
+			},
+		},
+		{
+			name: "unsorted odd number of prices",
+			prices: []*big.Float{
+				big.NewFloat(5),
+				big.NewFloat(1),
+				big.NewFloat(4),
+				big.NewFloat(2),
+				big.NewFloat(3),
+			},
+			expected: types.PriceDispersion{
+				StdDev:             new(big.Float).Sqrt(big.NewFloat(2)),
+				InterquartileRange: big.NewFloat(2),
+				Min:                big.NewFloat(1),
+				Max:                big.NewFloat(5),
+				ProviderCount:      5,
+			},
+		},
+		{
+			name: "even number of prices interpolates the quartiles",
+			prices: []*big.Float{
+				big.NewFloat(2),
+				big.NewFloat(4),
+				big.NewFloat(4),
+				big.NewFloat(4),
+				big.NewFloat(5),
+				big.NewFloat(5),
+				big.NewFloat(7),
+				big.NewFloat(9),
+			},
+			expected: types.PriceDispersion{
+				StdDev:             big.NewFloat(2),
+				InterquartileRange: big.NewFloat(1.5),
+				Min:                big.NewFloat(2),
+				Max:                big.NewFloat(9),
+				ProviderCount:      8,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dispersion := oracle.CalculateDispersion(tc.prices)
+			require.Equal(t, tc.expected.ProviderCount, dispersion.ProviderCount)
+			if tc.expected.ProviderCount == 0 {
+				require.Equal(t, tc.expected, dispersion)
+				return
+			}
+
+			for _, pair := range [][2]*big.Float{
+				{tc.expected.StdDev, dispersion.StdDev},
+				{tc.expected.InterquartileRange, dispersion.InterquartileRange},
+				{tc.expected.Min, dispersion.Min},
+				{tc.expected.Max, dispersion.Max},
+			} {
+				expected, _ := pair[0].Float64()
+				actual, _ := pair[1].Float64()
+				require.InDelta(t, expected, actual, 1e-9)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package oracle
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+)
+
+// healthSample is the outcome of a single aggregation round for a (provider, ticker) pair. A
+// sample with no flags set is healthy.
+type healthSample uint8
+
+const (
+	// sampleError is set if the provider did not report a price, or if the price could not be
+	// converted to the ticker.
+	sampleError healthSample = 1 << iota
+	// sampleStale is set if the provider's price is older than the max staleness.
+	sampleStale
+	// sampleDeviation is set if the provider's price deviates from the index price by more than
+	// the max deviation.
+	sampleDeviation
+)
+
+// healthKey identifies a (provider, ticker) pair.
+type healthKey struct {
+	provider string
+	ticker   string
+}
+
+// healthState is the health of a single (provider, ticker) pair.
+type healthState struct {
+	// samples is a ring buffer of the most recent samples.
+	samples []healthSample
+	// next is the index of the next sample in the ring buffer.
+	next int
+	// count is the number of samples in the ring buffer.
+	count int
+	// quarantined is true if the pair is excluded from aggregation.
+	quarantined bool
+	// quarantinedSince is the time at which the pair was quarantined.
+	quarantinedSince time.Time
+}
+
+// healthTransition describes a (provider, ticker) pair being quarantined or readmitted.
+type healthTransition struct {
+	// quarantined is true if the pair was quarantined and false if it was readmitted.
+	quarantined bool
+	// health is the health of the pair after the transition.
+	health types.ProviderHealth
+}
+
+// healthTracker scores the health of every (provider, ticker) pair over a sliding window of
+// aggregation rounds. A pair whose score drops below the quarantine threshold is quarantined
+// once the window is full, and readmitted once its score recovers to the readmit threshold.
+// Quarantined pairs continue to be sampled so that they can recover.
+//
+// healthTracker is not thread-safe; callers must hold the aggregator's lock.
+type healthTracker struct {
+	cfg    config.HealthConfig
+	states map[healthKey]*healthState
+}
+
+// newHealthTracker returns a new health tracker.
+func newHealthTracker(cfg config.HealthConfig) *healthTracker {
+	return &healthTracker{
+		cfg:    cfg,
+		states: make(map[healthKey]*healthState),
+	}
+}
+
+// Quarantined returns true if the (provider, ticker) pair is quarantined.
+func (h *healthTracker) Quarantined(provider, ticker string) bool {
+	state, ok := h.states[healthKey{provider: provider, ticker: ticker}]
+	return ok && state.quarantined
+}
+
+// Sample classifies the converted price of a provider. The price is nil if the provider did not
+// report one, and the index price is nil if the ticker has no index price this round, in which
+// case the deviation is not checked.
+func (h *healthTracker) Sample(price *ConvertedPrice, index *big.Float, now time.Time) healthSample {
+	if price == nil {
+		return sampleError
+	}
+
+	var sample healthSample
+	if h.cfg.MaxStaleness > 0 && !price.Timestamp.IsZero() && now.Sub(price.Timestamp) > h.cfg.MaxStaleness {
+		sample |= sampleStale
+	}
+
+	if h.cfg.MaxDeviation > 0 && index != nil && relativeDeviation(price.Price, index) > h.cfg.MaxDeviation {
+		sample |= sampleDeviation
+	}
+
+	return sample
+}
+
+// Record records a sample for the (provider, ticker) pair. It returns the transition of the
+// pair if it was quarantined or readmitted.
+func (h *healthTracker) Record(provider, ticker string, sample healthSample, now time.Time) *healthTransition {
+	key := healthKey{provider: provider, ticker: ticker}
+	state, ok := h.states[key]
+	if !ok {
+		state = &healthState{samples: make([]healthSample, h.cfg.Window)}
+		h.states[key] = state
+	}
+
+	state.samples[state.next] = sample
+	state.next = (state.next + 1) % len(state.samples)
+	if state.count < len(state.samples) {
+		state.count++
+	}
+
+	health := state.health(key)
+	switch {
+	case !state.quarantined && state.count == len(state.samples) && health.Score < h.cfg.QuarantineThreshold:
+		state.quarantined = true
+		state.quarantinedSince = now
+	case state.quarantined && health.Score >= h.cfg.ReadmitThreshold:
+		state.quarantined = false
+		state.quarantinedSince = time.Time{}
+	default:
+		return nil
+	}
+
+	return &healthTransition{
+		quarantined: state.quarantined,
+		health:      state.health(key),
+	}
+}
+
+// Retain removes the state of every (provider, ticker) pair that is not in the given set.
+func (h *healthTracker) Retain(keys map[healthKey]struct{}) {
+	for key := range h.states {
+		if _, ok := keys[key]; !ok {
+			delete(h.states, key)
+		}
+	}
+}
+
+// Health returns the health of every tracked (provider, ticker) pair, sorted by provider and
+// then ticker.
+func (h *healthTracker) Health() []types.ProviderHealth {
+	health := make([]types.ProviderHealth, 0, len(h.states))
+	for key, state := range h.states {
+		health = append(health, state.health(key))
+	}
+
+	sort.Slice(health, func(i, j int) bool {
+		if health[i].Provider != health[j].Provider {
+			return health[i].Provider < health[j].Provider
+		}
+		return health[i].Ticker < health[j].Ticker
+	})
+
+	return health
+}
+
+// health returns the health of the pair computed over the samples in the ring buffer.
+func (s *healthState) health(key healthKey) types.ProviderHealth {
+	health := types.ProviderHealth{
+		Provider:         key.provider,
+		Ticker:           key.ticker,
+		Samples:          s.count,
+		Quarantined:      s.quarantined,
+		QuarantinedSince: s.quarantinedSince,
+	}
+	if s.count == 0 {
+		return health
+	}
+
+	var healthy, errors, stale, deviant int
+	for i := 0; i < s.count; i++ {
+		sample := s.samples[i]
+		if sample == 0 {
+			healthy++
+		}
+		if sample&sampleError != 0 {
+			errors++
+		}
+		if sample&sampleStale != 0 {
+			stale++
+		}
+		if sample&sampleDeviation != 0 {
+			deviant++
+		}
+	}
+
+	total := float64(s.count)
+	health.Score = float64(healthy) / total
+	health.ErrorRate = float64(errors) / total
+	health.StaleRate = float64(stale) / total
+	health.DeviationRate = float64(deviant) / total
+	return health
+}
@@ -0,0 +1,192 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	"github.com/skip-mev/connect/v2/providers/websockets/kucoin"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestAggregateDataWithHealthTracking(t *testing.T) {
+	healthCfg := config.HealthConfig{
+		Window:              4,
+		MaxStaleness:        30 * time.Second,
+		MaxDeviation:        0.05,
+		QuarantineThreshold: 0.5,
+		ReadmitThreshold:    0.75,
+	}
+
+	// step is a single aggregation round. Coinbase and binance always report 100 and 101.
+	type step struct {
+		// kucoin is kucoin's price, or zero if kucoin does not report a price.
+		kucoin float64
+		// kucoinAge is the age of kucoin's price.
+		kucoinAge   time.Duration
+		expected    float64
+		quarantined bool
+	}
+
+	testCases := []struct {
+		name   string
+		steps  []step
+		health types.ProviderHealth
+	}{
+		{
+			name: "healthy provider is never quarantined",
+			steps: []step{
+				{kucoin: 102, expected: 101},
+				{kucoin: 102, expected: 101},
+				{kucoin: 102, expected: 101},
+				{kucoin: 102, expected: 101},
+				{kucoin: 102, expected: 101},
+			},
+			health: types.ProviderHealth{Score: 1, Samples: 4},
+		},
+		{
+			name: "deviant provider is quarantined and readmitted once it recovers",
+			steps: []step{
+				{kucoin: 150, expected: 101},
+				{kucoin: 150, expected: 101},
+				{kucoin: 150, expected: 101},
+				{kucoin: 150, expected: 101, quarantined: true},
+				// kucoin is excluded from the median.
+				{kucoin: 100, expected: 100.5, quarantined: true},
+				{kucoin: 100, expected: 100.5, quarantined: true},
+				{kucoin: 100, expected: 100.5},
+				// kucoin is readmitted.
+				{kucoin: 100, expected: 100},
+			},
+			health: types.ProviderHealth{Score: 1, Samples: 4},
+		},
+		{
+			name: "provider that does not report prices is quarantined",
+			steps: []step{
+				{expected: 100.5},
+				{expected: 100.5},
+				{kucoin: 102, expected: 101},
+				{expected: 100.5, quarantined: true},
+				{kucoin: 102, expected: 100.5, quarantined: true},
+			},
+			health: types.ProviderHealth{Score: 0.5, ErrorRate: 0.5, Samples: 4, Quarantined: true},
+		},
+		{
+			name: "stale provider is quarantined",
+			steps: []step{
+				{kucoin: 102, kucoinAge: time.Minute, expected: 101},
+				{kucoin: 102, kucoinAge: time.Minute, expected: 101},
+				{kucoin: 102, kucoinAge: time.Minute, expected: 101},
+				{kucoin: 102, kucoinAge: time.Minute, expected: 101, quarantined: true},
+			},
+			health: types.ProviderHealth{StaleRate: 1, Samples: 4, Quarantined: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ticker := BTC_USD
+			ticker.MinProviderCount = 1
+
+			marketMap := mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					ticker.String(): {
+						Ticker: ticker,
+						ProviderConfigs: []mmtypes.ProviderConfig{
+							{
+								Name:           coinbase.Name,
+								OffChainTicker: "BTC-USD",
+							},
+							{
+								Name:           binance.Name,
+								OffChainTicker: "BTCUSD",
+							},
+							{
+								Name:           kucoin.Name,
+								OffChainTicker: "BTC-USD",
+							},
+						},
+					},
+				},
+			}
+
+			start := time.Now()
+			now := start
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				marketMap,
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationConfig(config.AggregationConfig{Health: healthCfg}),
+				oracle.WithClock(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			for i, s := range tc.steps {
+				now = start.Add(time.Duration(i) * time.Second)
+
+				m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(100)})
+				m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(101)})
+				if s.kucoin == 0 {
+					m.SetProviderPrices(kucoin.Name, types.Prices{})
+				} else {
+					m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(s.kucoin)})
+					m.SetProviderTimestamps(kucoin.Name, map[string]time.Time{"BTC-USD": now.Add(-s.kucoinAge)})
+				}
+				m.AggregatePrices()
+
+				result := m.GetIndexPrices()
+				require.Contains(t, result, ticker.String())
+				require.Zero(
+					t,
+					big.NewFloat(s.expected).Cmp(result[ticker.String()]),
+					"step %d: expected %v, got %v", i, s.expected, result[ticker.String()],
+				)
+
+				health := kucoinHealth(t, m.GetProviderHealth())
+				require.Equal(t, s.quarantined, health.Quarantined, "step %d", i)
+			}
+
+			// Every provider config of the market is tracked.
+			require.Len(t, m.GetProviderHealth(), 3)
+
+			health := kucoinHealth(t, m.GetProviderHealth())
+			require.Equal(t, tc.health.Score, health.Score)
+			require.Equal(t, tc.health.ErrorRate, health.ErrorRate)
+			require.Equal(t, tc.health.StaleRate, health.StaleRate)
+			require.Equal(t, tc.health.DeviationRate, health.DeviationRate)
+			require.Equal(t, tc.health.Samples, health.Samples)
+			require.Equal(t, tc.health.Quarantined, health.Quarantined)
+			require.Equal(t, tc.health.Quarantined, !health.QuarantinedSince.IsZero())
+		})
+	}
+
+	t.Run("health is not tracked if disabled", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		m.AggregatePrices()
+		require.Nil(t, m.GetProviderHealth())
+	})
+}
+
+// kucoinHealth returns the health of kucoin's BTC/USD price.
+func kucoinHealth(t *testing.T, health []types.ProviderHealth) types.ProviderHealth {
+	t.Helper()
+
+	for _, h := range health {
+		if h.Provider == kucoin.Name && h.Ticker == BTC_USD.String() {
+			return h
+		}
+	}
+
+	require.Fail(t, "kucoin health not found")
+	return types.ProviderHealth{}
+}
@@ -0,0 +1,125 @@
+package oracle
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+)
+
+// priceHistory retains the most recent price records of every ticker in a fixed size ring
+// buffer per ticker. Records older than the retention are not returned, and are overwritten as
+// new records are added.
+//
+// priceHistory is not thread-safe; callers must hold the aggregator's lock.
+type priceHistory struct {
+	cfg     config.HistoryConfig
+	records map[string]*recordBuffer
+}
+
+// recordBuffer is a ring buffer of the price records of a single ticker.
+type recordBuffer struct {
+	// records is the ring buffer of records.
+	records []types.PriceRecord
+	// next is the index of the next record in the ring buffer.
+	next int
+	// count is the number of records in the ring buffer.
+	count int
+}
+
+// newPriceHistory returns a new price history.
+func newPriceHistory(cfg config.HistoryConfig) *priceHistory {
+	return &priceHistory{
+		cfg:     cfg,
+		records: make(map[string]*recordBuffer),
+	}
+}
+
+// Add adds a record to the history of its ticker, overwriting the oldest record if the ticker's
+// history is full.
+func (h *priceHistory) Add(record types.PriceRecord) {
+	buffer, ok := h.records[record.Ticker]
+	if !ok {
+		buffer = &recordBuffer{records: make([]types.PriceRecord, h.cfg.MaxRecords)}
+		h.records[record.Ticker] = buffer
+	}
+
+	buffer.records[buffer.next] = record
+	buffer.next = (buffer.next + 1) % len(buffer.records)
+	if buffer.count < len(buffer.records) {
+		buffer.count++
+	}
+}
+
+// Retain removes the history of every ticker that is not in the given set.
+func (h *priceHistory) Retain(tickers map[string]struct{}) {
+	for ticker := range h.records {
+		if _, ok := tickers[ticker]; !ok {
+			delete(h.records, ticker)
+		}
+	}
+}
+
+// Query returns the records that match the filter and that are within the retention at the
+// given time, sorted by ticker and then timestamp. If the filter selects a provider, only that
+// provider's price is included in each record. The returned records are copies.
+func (h *priceHistory) Query(filter types.PriceHistoryFilter, now time.Time) []types.PriceRecord {
+	start := now.Add(-h.cfg.Retention)
+	if filter.Start.After(start) {
+		start = filter.Start
+	}
+
+	records := make([]types.PriceRecord, 0)
+	for ticker, buffer := range h.records {
+		if filter.Ticker != "" && filter.Ticker != ticker {
+			continue
+		}
+
+		// Iterate from the oldest to the newest record.
+		oldest := (buffer.next - buffer.count + len(buffer.records)) % len(buffer.records)
+		for i := 0; i < buffer.count; i++ {
+			record := buffer.records[(oldest+i)%len(buffer.records)]
+			if record.Timestamp.Before(start) || (!filter.End.IsZero() && record.Timestamp.After(filter.End)) {
+				continue
+			}
+
+			records = append(records, copyRecord(record, filter.Provider))
+		}
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Ticker != records[j].Ticker {
+			return records[i].Ticker < records[j].Ticker
+		}
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+
+	return records
+}
+
+// copyRecord returns a deep copy of the record. If a provider is given, only that provider's
+// price is copied.
+func copyRecord(record types.PriceRecord, provider string) types.PriceRecord {
+	cpy := types.PriceRecord{
+		Ticker:         record.Ticker,
+		Timestamp:      record.Timestamp,
+		ProviderPrices: make([]types.ProviderPrice, 0, len(record.ProviderPrices)),
+	}
+
+	if record.Price != nil {
+		cpy.Price = new(big.Float).Copy(record.Price)
+	}
+
+	for _, price := range record.ProviderPrices {
+		if provider != "" && provider != price.Provider {
+			continue
+		}
+
+		price.Price = new(big.Float).Copy(price.Price)
+		cpy.ProviderPrices = append(cpy.ProviderPrices, price)
+	}
+
+	return cpy
+}
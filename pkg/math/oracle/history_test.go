@@ -0,0 +1,242 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestGetPriceHistory(t *testing.T) {
+	btcusd := BTC_USD
+	btcusd.MinProviderCount = 2
+	ethusd := ETH_USD
+	ethusd.MinProviderCount = 2
+
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			btcusd.String(): {
+				Ticker: btcusd,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: coinbase.Name, OffChainTicker: "BTC-USD"},
+					{Name: binance.Name, OffChainTicker: "BTCUSD"},
+				},
+			},
+			ethusd.String(): {
+				Ticker: ethusd,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: coinbase.Name, OffChainTicker: "ETH-USD"},
+					{Name: binance.Name, OffChainTicker: "ETHUSD"},
+				},
+			},
+		},
+	}
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	historyCfg := config.HistoryConfig{
+		Retention:  time.Minute,
+		MaxRecords: 3,
+	}
+
+	// newAggregator returns an aggregator that has aggregated prices once a second for the
+	// given number of rounds. Coinbase reports BTC/USD at 100 + round and binance at 101 +
+	// round. ETH/USD is only reported by coinbase, so it is never priced.
+	newAggregator := func(t *testing.T, cfg config.HistoryConfig, rounds int) (*oracle.IndexPriceAggregator, *time.Time) {
+		t.Helper()
+
+		now := start
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			marketMap,
+			metrics.NewNopMetrics(),
+			oracle.WithAggregationConfig(config.AggregationConfig{History: cfg}),
+			oracle.WithClock(func() time.Time { return now }),
+		)
+		require.NoError(t, err)
+
+		for i := 0; i < rounds; i++ {
+			now = start.Add(time.Duration(i) * time.Second)
+			m.Reset()
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD": big.NewFloat(float64(100 + i)),
+				"ETH-USD": big.NewFloat(10),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(float64(101 + i))})
+			m.AggregatePrices()
+		}
+
+		return m, &now
+	}
+
+	// scaled returns the price scaled by the decimals of the ticker.
+	scaled := func(price float64, ticker mmtypes.Ticker) *big.Float {
+		scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(ticker.Decimals)), nil))
+		return new(big.Float).Mul(big.NewFloat(price), scale)
+	}
+
+	// btcRecord returns the expected BTC/USD record of the given round.
+	btcRecord := func(round int) types.PriceRecord {
+		return types.PriceRecord{
+			Ticker:    btcusd.String(),
+			Timestamp: start.Add(time.Duration(round) * time.Second),
+			Price:     scaled(100.5+float64(round), btcusd),
+			ProviderPrices: []types.ProviderPrice{
+				{Provider: coinbase.Name, OffChainTicker: "BTC-USD", Price: scaled(float64(100+round), btcusd)},
+				{Provider: binance.Name, OffChainTicker: "BTCUSD", Price: scaled(float64(101+round), btcusd)},
+			},
+		}
+	}
+
+	// ethRecord returns the expected ETH/USD record of the given round.
+	ethRecord := func(round int) types.PriceRecord {
+		return types.PriceRecord{
+			Ticker:    ethusd.String(),
+			Timestamp: start.Add(time.Duration(round) * time.Second),
+			ProviderPrices: []types.ProviderPrice{
+				{Provider: coinbase.Name, OffChainTicker: "ETH-USD", Price: scaled(10, ethusd)},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		rounds   int
+		filter   types.PriceHistoryFilter
+		expected []types.PriceRecord
+	}{
+		{
+			name:   "returns every record sorted by ticker and timestamp",
+			rounds: 2,
+			expected: []types.PriceRecord{
+				btcRecord(0), btcRecord(1),
+				ethRecord(0), ethRecord(1),
+			},
+		},
+		{
+			name:   "retains at most max records per ticker",
+			rounds: 5,
+			filter: types.PriceHistoryFilter{Ticker: btcusd.String()},
+			expected: []types.PriceRecord{
+				btcRecord(2), btcRecord(3), btcRecord(4),
+			},
+		},
+		{
+			name:   "filters by ticker",
+			rounds: 2,
+			filter: types.PriceHistoryFilter{Ticker: ethusd.String()},
+			expected: []types.PriceRecord{
+				ethRecord(0), ethRecord(1),
+			},
+		},
+		{
+			name:   "filters by provider",
+			rounds: 1,
+			filter: types.PriceHistoryFilter{Provider: binance.Name},
+			expected: []types.PriceRecord{
+				{
+					Ticker:    btcusd.String(),
+					Timestamp: start,
+					Price:     scaled(100.5, btcusd),
+					ProviderPrices: []types.ProviderPrice{
+						{Provider: binance.Name, OffChainTicker: "BTCUSD", Price: scaled(101, btcusd)},
+					},
+				},
+				{
+					Ticker:         ethusd.String(),
+					Timestamp:      start,
+					ProviderPrices: []types.ProviderPrice{},
+				},
+			},
+		},
+		{
+			name:   "filters by time range",
+			rounds: 3,
+			filter: types.PriceHistoryFilter{
+				Ticker: btcusd.String(),
+				Start:  start.Add(time.Second),
+				End:    start.Add(time.Second),
+			},
+			expected: []types.PriceRecord{
+				btcRecord(1),
+			},
+		},
+		{
+			name:     "returns no records for an unknown ticker",
+			rounds:   1,
+			filter:   types.PriceHistoryFilter{Ticker: "FOO/USD"},
+			expected: []types.PriceRecord{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, _ := newAggregator(t, historyCfg, tc.rounds)
+			requireRecordsEqual(t, tc.expected, m.GetPriceHistory(tc.filter))
+		})
+	}
+
+	t.Run("drops records older than the retention", func(t *testing.T) {
+		m, now := newAggregator(t, historyCfg, 2)
+
+		*now = start.Add(historyCfg.Retention).Add(500 * time.Millisecond)
+		requireRecordsEqual(t, []types.PriceRecord{btcRecord(1)}, m.GetPriceHistory(types.PriceHistoryFilter{
+			Ticker: btcusd.String(),
+		}))
+	})
+
+	t.Run("drops the records of removed tickers", func(t *testing.T) {
+		m, _ := newAggregator(t, historyCfg, 1)
+
+		m.UpdateMarketMap(mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{btcusd.String(): marketMap.Markets[btcusd.String()]},
+		})
+		m.AggregatePrices()
+		require.Empty(t, m.GetPriceHistory(types.PriceHistoryFilter{Ticker: ethusd.String()}))
+	})
+
+	t.Run("history is not retained if disabled", func(t *testing.T) {
+		m, _ := newAggregator(t, config.HistoryConfig{}, 1)
+		require.Nil(t, m.GetPriceHistory(types.PriceHistoryFilter{}))
+	})
+}
+
+// requireRecordsEqual asserts that the price records are equal, comparing prices by value.
+func requireRecordsEqual(t *testing.T, expected, actual []types.PriceRecord) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		require.Equal(t, expected[i].Ticker, actual[i].Ticker, "record %d", i)
+		require.Equal(t, expected[i].Timestamp, actual[i].Timestamp, "record %d", i)
+		requireFloatEqual(t, expected[i].Price, actual[i].Price, "record %d", i)
+
+		require.Len(t, actual[i].ProviderPrices, len(expected[i].ProviderPrices), "record %d", i)
+		for j := range expected[i].ProviderPrices {
+			require.Equal(t, expected[i].ProviderPrices[j].Provider, actual[i].ProviderPrices[j].Provider, "record %d", i)
+			require.Equal(t, expected[i].ProviderPrices[j].OffChainTicker, actual[i].ProviderPrices[j].OffChainTicker, "record %d", i)
+			requireFloatEqual(t, expected[i].ProviderPrices[j].Price, actual[i].ProviderPrices[j].Price, "record %d", i)
+		}
+	}
+}
+
+// requireFloatEqual asserts that two, possibly nil, big floats are equal.
+func requireFloatEqual(t *testing.T, expected, actual *big.Float, msgAndArgs ...interface{}) {
+	t.Helper()
+
+	if expected == nil {
+		require.Nil(t, actual, msgAndArgs...)
+		return
+	}
+
+	require.NotNil(t, actual, msgAndArgs...)
+	require.Zero(t, expected.Cmp(actual), msgAndArgs...)
+}
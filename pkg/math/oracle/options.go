@@ -0,0 +1,41 @@
+package oracle
+
+import (
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+// Option is a functional option for the index price aggregator.
+type Option func(*IndexPriceAggregator)
+
+// WithAggregationConfig sets the oracle wide aggregation configuration for the aggregator.
+func WithAggregationConfig(cfg config.AggregationConfig) Option {
+	return func(m *IndexPriceAggregator) {
+		m.aggregationCfg = cfg
+	}
+}
+
+// WithMaxPriceAge sets the maximum age of a price sample that is used when computing the
+// time-weighted average price. This should be set to the oracle's MaxPriceAge.
+func WithMaxPriceAge(maxPriceAge time.Duration) Option {
+	return func(m *IndexPriceAggregator) {
+		m.maxPriceAge = maxPriceAge
+	}
+}
+
+// WithStrategy registers an aggregation strategy under the given name, which tickers can then
+// select in their metadata. This overrides any built-in strategy with the same name.
+func WithStrategy(name string, strategy Strategy) Option {
+	return func(m *IndexPriceAggregator) {
+		m.strategies[name] = strategy
+	}
+}
+
+// WithClock sets the function used by the aggregator to retrieve the current time. This is
+// primarily used for testing.
+func WithClock(now func() time.Time) Option {
+	return func(m *IndexPriceAggregator) {
+		m.now = now
+	}
+}
@@ -0,0 +1,174 @@
+package oracle
+
+import (
+	gomath "math"
+	"math/big"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/pkg/math"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+const (
+	// MinOutlierFilterSampleSize is the minimum number of prices required to run the outlier
+	// filter. With fewer prices there is no majority to compare against.
+	MinOutlierFilterSampleSize = 3
+
+	// madScaleFactor is the constant used to compute the modified z-score from the median
+	// absolute deviation. See Iglewicz and Hoaglin (1993).
+	madScaleFactor = 0.6745
+)
+
+// ConvertedPrice is a price reported by a provider that has been converted to the target
+// ticker of a market.
+type ConvertedPrice struct {
+	// Provider is the provider config that was used to resolve the price.
+	Provider mmtypes.ProviderConfig
+	// Price is the converted price.
+	Price *big.Float
+	// Weight is the weight of the price used when computing the weighted median. This is nil
+	// if neither the provider config nor the provider specify a weight.
+	Weight *big.Float
+	// Timestamp is the time at which the provider reported the price. This is the zero time
+	// if the provider's timestamps are unknown.
+	Timestamp time.Time
+}
+
+// FilterOutliers runs the given outlier filter over the set of converted prices and returns
+// the prices that were accepted and the prices that were rejected. The relative order of
+// the prices is preserved. If the filter is disabled or there are fewer than
+// MinOutlierFilterSampleSize prices, every price is accepted.
+//
+// Note that the maximum z-score attainable by a sample of n prices is (n-1)/sqrt(n), so the
+// zscore method can only reject prices when the threshold is below that bound.
+func FilterOutliers(
+	cfg config.OutlierFilterConfig,
+	prices []ConvertedPrice,
+) (accepted []ConvertedPrice, rejected []ConvertedPrice) {
+	if !cfg.Enabled() || len(prices) < MinOutlierFilterSampleSize {
+		return prices, nil
+	}
+
+	values := pricesOf(prices)
+
+	var scores []float64
+	switch cfg.Method {
+	case config.OutlierFilterMAD:
+		scores = madScores(values)
+	case config.OutlierFilterZScore:
+		scores = zScores(values)
+	case config.OutlierFilterPercent:
+		scores = percentDeviations(values)
+	default:
+		return prices, nil
+	}
+
+	accepted = make([]ConvertedPrice, 0, len(prices))
+	for i, price := range prices {
+		if scores != nil && scores[i] > cfg.Threshold {
+			rejected = append(rejected, price)
+			continue
+		}
+
+		accepted = append(accepted, price)
+	}
+
+	return accepted, rejected
+}
+
+// madScores returns the modified z-score of each value. If the median absolute deviation
+// is zero, i.e. most values are equal, every value that deviates from the median is scored
+// as an outlier with an infinite score. Returns nil if all values are equal.
+func madScores(values []*big.Float) []float64 {
+	deviations := absDeviations(values, median(values))
+
+	if mad := median(deviations); mad.Sign() > 0 {
+		return scale(deviations, new(big.Float).Quo(big.NewFloat(madScaleFactor), mad))
+	}
+
+	scores := make([]float64, len(deviations))
+	outliers := false
+	for i, deviation := range deviations {
+		if deviation.Sign() > 0 {
+			scores[i] = gomath.Inf(1)
+			outliers = true
+		}
+	}
+
+	if !outliers {
+		return nil
+	}
+
+	return scores
+}
+
+// zScores returns the z-score of each value using the population standard deviation.
+// Returns nil if all values are equal.
+func zScores(values []*big.Float) []float64 {
+	deviations := absDeviations(values, mean(values))
+
+	variance := new(big.Float)
+	for _, deviation := range deviations {
+		variance.Add(variance, new(big.Float).Mul(deviation, deviation))
+	}
+	variance.Quo(variance, new(big.Float).SetInt64(int64(len(values))))
+
+	if variance.Sign() == 0 {
+		return nil
+	}
+
+	stdDev := new(big.Float).Sqrt(variance)
+	return scale(deviations, new(big.Float).Quo(big.NewFloat(1), stdDev))
+}
+
+// percentDeviations returns the percent deviation of each value from the median. Returns
+// nil if the median is zero.
+func percentDeviations(values []*big.Float) []float64 {
+	center := median(values)
+	if center.Sign() == 0 {
+		return nil
+	}
+
+	deviations := absDeviations(values, center)
+	factor := new(big.Float).Quo(big.NewFloat(100), new(big.Float).Abs(center))
+	return scale(deviations, factor)
+}
+
+// median returns the median of the values without re-ordering the input.
+func median(values []*big.Float) *big.Float {
+	cpy := make([]*big.Float, len(values))
+	copy(cpy, values)
+
+	return new(big.Float).Copy(math.CalculateMedian(cpy))
+}
+
+// mean returns the arithmetic mean of the values.
+func mean(values []*big.Float) *big.Float {
+	sum := new(big.Float)
+	for _, value := range values {
+		sum.Add(sum, value)
+	}
+
+	return sum.Quo(sum, new(big.Float).SetInt64(int64(len(values))))
+}
+
+// absDeviations returns the absolute deviation of each value from the center.
+func absDeviations(values []*big.Float, center *big.Float) []*big.Float {
+	deviations := make([]*big.Float, len(values))
+	for i, value := range values {
+		deviations[i] = new(big.Float).Abs(new(big.Float).Sub(value, center))
+	}
+
+	return deviations
+}
+
+// scale multiplies each value by the factor and returns the results as float64s.
+func scale(values []*big.Float, factor *big.Float) []float64 {
+	scaled := make([]float64, len(values))
+	for i, value := range values {
+		scaled[i], _ = new(big.Float).Mul(value, factor).Float64()
+	}
+
+	return scaled
+}
@@ -0,0 +1,139 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func convertedPrices(prices ...float64) []oracle.ConvertedPrice {
+	converted := make([]oracle.ConvertedPrice, len(prices))
+	for i, price := range prices {
+		converted[i] = oracle.ConvertedPrice{
+			Provider: mmtypes.ProviderConfig{
+				Name:           "provider",
+				OffChainTicker: big.NewFloat(float64(i)).String(),
+			},
+			Price: big.NewFloat(price),
+		}
+	}
+
+	return converted
+}
+
+func TestFilterOutliers(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      config.OutlierFilterConfig
+		prices   []oracle.ConvertedPrice
+		accepted []float64
+		rejected []float64
+	}{
+		{
+			name:     "disabled filter accepts every price",
+			cfg:      config.OutlierFilterConfig{},
+			prices:   convertedPrices(100, 101, 500),
+			accepted: []float64{100, 101, 500},
+		},
+		{
+			name: "too few prices to filter",
+			cfg: config.OutlierFilterConfig{
+				Method:    config.OutlierFilterPercent,
+				Threshold: 1,
+			},
+			prices:   convertedPrices(100, 500),
+			accepted: []float64{100, 500},
+		},
+		{
+			name: "mad rejects a single spike",
+			cfg: config.OutlierFilterConfig{
+				Method:    config.OutlierFilterMAD,
+				Threshold: 3.5,
+			},
+			prices:   convertedPrices(100, 101, 99, 180),
+			accepted: []float64{100, 101, 99},
+			rejected: []float64{180},
+		},
+		{
+			name: "mad rejects any deviation if the median absolute deviation is zero",
+			cfg: config.OutlierFilterConfig{
+				Method:    config.OutlierFilterMAD,
+				Threshold: 3.5,
+			},
+			prices:   convertedPrices(100, 100, 100, 100, 180),
+			accepted: []float64{100, 100, 100, 100},
+			rejected: []float64{180},
+		},
+		{
+			name: "mad rejects a spike among three prices",
+			cfg: config.OutlierFilterConfig{
+				Method:    config.OutlierFilterMAD,
+				Threshold: 3.5,
+			},
+			prices:   convertedPrices(100, 100, 200),
+			accepted: []float64{100, 100},
+			rejected: []float64{200},
+		},
+		{
+			name: "mad accepts identical prices",
+			cfg: config.OutlierFilterConfig{
+				Method:    config.OutlierFilterMAD,
+				Threshold: 3.5,
+			},
+			prices:   convertedPrices(100, 100, 100),
+			accepted: []float64{100, 100, 100},
+		},
+		{
+			name: "zscore rejects a single spike",
+			cfg: config.OutlierFilterConfig{
+				Method:    config.OutlierFilterZScore,
+				Threshold: 1.5,
+			},
+			prices:   convertedPrices(100, 101, 99, 100, 180),
+			accepted: []float64{100, 101, 99, 100},
+			rejected: []float64{180},
+		},
+		{
+			name: "zscore accepts prices within the threshold",
+			cfg: config.OutlierFilterConfig{
+				Method:    config.OutlierFilterZScore,
+				Threshold: 3,
+			},
+			prices:   convertedPrices(100, 101, 99),
+			accepted: []float64{100, 101, 99},
+		},
+		{
+			name: "percent rejects prices far from the median",
+			cfg: config.OutlierFilterConfig{
+				Method:    config.OutlierFilterPercent,
+				Threshold: 5,
+			},
+			prices:   convertedPrices(100, 103, 99, 93, 80),
+			accepted: []float64{100, 103, 99},
+			rejected: []float64{93, 80},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			accepted, rejected := oracle.FilterOutliers(tc.cfg, tc.prices)
+
+			require.Len(t, accepted, len(tc.accepted))
+			for i, price := range accepted {
+				expected := big.NewFloat(tc.accepted[i])
+				require.Zero(t, expected.Cmp(price.Price), "expected %v, got %v", expected, price.Price)
+			}
+
+			require.Len(t, rejected, len(tc.rejected))
+			for i, price := range rejected {
+				expected := big.NewFloat(tc.rejected[i])
+				require.Zero(t, expected.Cmp(price.Price), "expected %v, got %v", expected, price.Price)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	"github.com/skip-mev/connect/v2/providers/websockets/kucoin"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// tierMetrics records the priority tiers reported by the aggregator.
+type tierMetrics struct {
+	metrics.Metrics
+	tiers map[string]uint32
+}
+
+func (m *tierMetrics) UpdatePriorityTier(pairID string, tier uint32) {
+	m.tiers[pairID] = tier
+}
+
+func TestAggregateDataWithPriorityTiers(t *testing.T) {
+	testCases := []struct {
+		name             string
+		minProviderCount uint64
+		// priorities are the priority tiers of the coinbase, binance and kucoin provider
+		// configs respectively.
+		priorities [3]uint32
+		prices     map[string]types.Prices
+		expected   *big.Float
+		tier       uint32
+	}{
+		{
+			name:             "every provider config in the same tier",
+			minProviderCount: 1,
+			priorities:       [3]uint32{0, 0, 0},
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(100)},
+				binance.Name:  {"BTCUSD": big.NewFloat(101)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(150)},
+			},
+			expected: big.NewFloat(101),
+			tier:     0,
+		},
+		{
+			name:             "primary provider config is used on its own",
+			minProviderCount: 1,
+			priorities:       [3]uint32{1, 1, 0},
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(100)},
+				binance.Name:  {"BTCUSD": big.NewFloat(101)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(150)},
+			},
+			expected: big.NewFloat(150),
+			tier:     0,
+		},
+		{
+			name:             "fallback tier is used if the primary provider is missing",
+			minProviderCount: 1,
+			priorities:       [3]uint32{1, 1, 0},
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(100)},
+				binance.Name:  {"BTCUSD": big.NewFloat(101)},
+			},
+			expected: big.NewFloat(100.5),
+			tier:     1,
+		},
+		{
+			name:             "lower tiers are added until the min provider count is met",
+			minProviderCount: 2,
+			priorities:       [3]uint32{1, 2, 0},
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(100)},
+				binance.Name:  {"BTCUSD": big.NewFloat(101)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(150)},
+			},
+			expected: big.NewFloat(125),
+			tier:     1,
+		},
+		{
+			name:             "no price if every tier combined does not meet the min provider count",
+			minProviderCount: 3,
+			priorities:       [3]uint32{1, 2, 0},
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(100)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(150)},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ticker := BTC_USD
+			ticker.MinProviderCount = tc.minProviderCount
+
+			marketMap := mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					ticker.String(): {
+						Ticker: ticker,
+						ProviderConfigs: []mmtypes.ProviderConfig{
+							{
+								Name:           coinbase.Name,
+								OffChainTicker: "BTC-USD",
+								Priority:       tc.priorities[0],
+							},
+							{
+								Name:           binance.Name,
+								OffChainTicker: "BTCUSD",
+								Priority:       tc.priorities[1],
+							},
+							{
+								Name:           kucoin.Name,
+								OffChainTicker: "BTC-USD",
+								Priority:       tc.priorities[2],
+							},
+						},
+					},
+				},
+			}
+
+			recorder := &tierMetrics{
+				Metrics: metrics.NewNopMetrics(),
+				tiers:   make(map[string]uint32),
+			}
+			m, err := oracle.NewIndexPriceAggregator(logger, marketMap, recorder)
+			require.NoError(t, err)
+
+			for provider, prices := range tc.prices {
+				m.SetProviderPrices(provider, prices)
+			}
+			m.AggregatePrices()
+
+			result := m.GetIndexPrices()
+			if tc.expected == nil {
+				require.NotContains(t, result, ticker.String())
+				require.NotContains(t, m.GetPriorityTiers(), ticker.String())
+				require.NotContains(t, recorder.tiers, ticker.String())
+				return
+			}
+
+			require.Contains(t, result, ticker.String())
+			require.Zero(
+				t,
+				tc.expected.Cmp(result[ticker.String()]),
+				"expected %v, got %v", tc.expected, result[ticker.String()],
+			)
+			require.Equal(t, types.PriorityTiers{ticker.String(): tc.tier}, m.GetPriorityTiers())
+			require.Equal(t, tc.tier, recorder.tiers[ticker.String()])
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package oracle
+
+import (
+	"math/big"
+	"time"
+)
+
+// emaValue is the smoothed price of a ticker and the time it was last updated.
+type emaValue struct {
+	timestamp time.Time
+	price     *big.Float
+}
+
+// emaSmoother maintains the exponential moving average (EMA) of the index price of each
+// ticker, i.e. ema = alpha * price + (1 - alpha) * ema. If a ticker has not been updated for
+// longer than the maximum price age, its EMA is restarted from the next price so that prices
+// from before the gap are not blended in.
+//
+// emaSmoother is not thread-safe; callers must hold the aggregator's lock.
+type emaSmoother struct {
+	// alpha is the smoothing factor.
+	alpha *big.Float
+	// maxPriceAge is the maximum amount of time the EMA of a ticker is considered valid. A
+	// value of zero disables the check.
+	maxPriceAge time.Duration
+	// values are the EMAs of each ticker.
+	values map[string]emaValue
+}
+
+// newEMASmoother returns a new EMA smoother with the given smoothing factor and max price age.
+func newEMASmoother(alpha float64, maxPriceAge time.Duration) *emaSmoother {
+	return &emaSmoother{
+		alpha:       big.NewFloat(alpha),
+		maxPriceAge: maxPriceAge,
+		values:      make(map[string]emaValue),
+	}
+}
+
+// Smooth records the price of the ticker and returns the ticker's updated EMA.
+func (s *emaSmoother) Smooth(ticker string, now time.Time, price *big.Float) *big.Float {
+	previous, ok := s.values[ticker]
+	if !ok || (s.maxPriceAge > 0 && now.Sub(previous.timestamp) > s.maxPriceAge) {
+		s.values[ticker] = emaValue{
+			timestamp: now,
+			price:     new(big.Float).Copy(price),
+		}
+
+		return new(big.Float).Copy(price)
+	}
+
+	// ema = ema + alpha * (price - ema)
+	delta := new(big.Float).Sub(price, previous.price)
+	ema := delta.Mul(delta, s.alpha).Add(delta, previous.price)
+	s.values[ticker] = emaValue{
+		timestamp: now,
+		price:     ema,
+	}
+
+	return new(big.Float).Copy(ema)
+}
+
+// Retain removes the EMA of every ticker that is not in the given set.
+func (s *emaSmoother) Retain(tickers map[string]struct{}) {
+	for ticker := range s.values {
+		if _, ok := tickers[ticker]; !ok {
+			delete(s.values, ticker)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	"github.com/skip-mev/connect/v2/providers/websockets/kucoin"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestAggregateDataWithStalenessDecay(t *testing.T) {
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			BTC_USD.String(): {
+				Ticker: BTC_USD,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "BTC-USD",
+					},
+					{
+						Name:           binance.Name,
+						OffChainTicker: "BTCUSD",
+					},
+					{
+						Name:           kucoin.Name,
+						OffChainTicker: "BTC-USD",
+					},
+				},
+			},
+		},
+	}
+
+	now := time.Now()
+	offChainTickers := map[string]string{
+		coinbase.Name: "BTC-USD",
+		binance.Name:  "BTCUSD",
+		kucoin.Name:   "BTC-USD",
+	}
+
+	testCases := []struct {
+		name           string
+		halfLife       time.Duration
+		weightedMedian bool
+		// ages are the ages of each provider's price. Providers without an age do not report
+		// a timestamp.
+		ages     map[string]time.Duration
+		weights  map[string]types.Prices
+		expected *big.Float
+	}{
+		{
+			name:     "stale prices are not decayed if staleness decay is disabled",
+			halfLife: 0,
+			ages: map[string]time.Duration{
+				coinbase.Name: 30 * time.Second,
+				binance.Name:  30 * time.Second,
+				kucoin.Name:   0,
+			},
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "fresh prices are weighted equally",
+			halfLife: 10 * time.Second,
+			ages: map[string]time.Duration{
+				coinbase.Name: 0,
+				binance.Name:  0,
+				kucoin.Name:   0,
+			},
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "stale prices lose weight to a fresh price",
+			halfLife: 10 * time.Second,
+			ages: map[string]time.Duration{
+				coinbase.Name: 30 * time.Second,
+				binance.Name:  30 * time.Second,
+				kucoin.Name:   0,
+			},
+			expected: big.NewFloat(150),
+		},
+		{
+			name:     "prices without timestamps are not decayed",
+			halfLife: 10 * time.Second,
+			ages: map[string]time.Duration{
+				kucoin.Name: 30 * time.Second,
+			},
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "unweighted median is used if every weight decays to zero",
+			halfLife: time.Millisecond,
+			ages: map[string]time.Duration{
+				coinbase.Name: time.Hour,
+				binance.Name:  time.Hour,
+				kucoin.Name:   time.Hour,
+			},
+			expected: big.NewFloat(101),
+		},
+		{
+			name:           "decay is applied on top of the provider weights",
+			halfLife:       10 * time.Second,
+			weightedMedian: true,
+			ages: map[string]time.Duration{
+				coinbase.Name: 0,
+				binance.Name:  0,
+				kucoin.Name:   40 * time.Second,
+			},
+			weights: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(1)},
+				binance.Name:  {"BTCUSD": big.NewFloat(1)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(10)},
+			},
+			expected: big.NewFloat(101),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				marketMap,
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationConfig(config.AggregationConfig{
+					WeightedMedian: tc.weightedMedian,
+					StalenessDecay: config.StalenessDecayConfig{
+						HalfLife: tc.halfLife,
+					},
+				}),
+				oracle.WithClock(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(100)})
+			m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(101)})
+			m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(150)})
+			for provider, age := range tc.ages {
+				m.SetProviderTimestamps(provider, map[string]time.Time{
+					offChainTickers[provider]: now.Add(-age),
+				})
+			}
+			for provider, weights := range tc.weights {
+				m.SetProviderWeights(provider, weights)
+			}
+			m.AggregatePrices()
+
+			result := m.GetIndexPrices()
+			require.Contains(t, result, BTC_USD.String())
+			require.Zero(
+				t,
+				tc.expected.Cmp(result[BTC_USD.String()]),
+				"expected %v, got %v", tc.expected, result[BTC_USD.String()],
+			)
+		})
+	}
+}
+
+func TestAggregateDataWithSmoothing(t *testing.T) {
+	ticker := BTC_USD
+	ticker.MinProviderCount = 1
+
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "BTC-USD",
+					},
+				},
+			},
+		},
+	}
+
+	// step is a single aggregation round.
+	type step struct {
+		elapsed  time.Duration
+		price    float64
+		expected float64
+	}
+
+	testCases := []struct {
+		name  string
+		alpha float64
+		steps []step
+	}{
+		{
+			name:  "smoothing disabled",
+			alpha: 0,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 200, expected: 200},
+			},
+		},
+		{
+			name:  "first price seeds the moving average",
+			alpha: 0.5,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+			},
+		},
+		{
+			name:  "prices move towards the latest price",
+			alpha: 0.5,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 200, expected: 150},
+				{elapsed: 20 * time.Second, price: 200, expected: 175},
+				{elapsed: 30 * time.Second, price: 100, expected: 137.5},
+			},
+		},
+		{
+			name:  "moving average is restarted after a gap longer than the max price age",
+			alpha: 0.5,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 200, expected: 150},
+				{elapsed: 2 * time.Minute, price: 50, expected: 50},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start := time.Now()
+			now := start
+
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				marketMap,
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationConfig(config.AggregationConfig{
+					Smoothing: config.SmoothingConfig{
+						Alpha: tc.alpha,
+					},
+				}),
+				oracle.WithMaxPriceAge(time.Minute),
+				oracle.WithClock(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			for _, s := range tc.steps {
+				now = start.Add(s.elapsed)
+
+				m.SetProviderPrices(coinbase.Name, types.Prices{
+					"BTC-USD": big.NewFloat(s.price),
+				})
+				m.AggregatePrices()
+
+				expected := big.NewFloat(s.expected)
+				result := m.GetIndexPrices()
+				require.Contains(t, result, ticker.String())
+				require.Zero(
+					t,
+					expected.Cmp(result[ticker.String()]),
+					"elapsed %s: expected %v, got %v", s.elapsed, expected, result[ticker.String()],
+				)
+			}
+		})
+	}
+}
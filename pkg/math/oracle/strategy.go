@@ -0,0 +1,126 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/skip-mev/connect/v2/pkg/math"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+// The names of the built-in aggregation strategies.
+const (
+	// StrategyMedian is the median of the converted prices.
+	StrategyMedian = "median"
+	// StrategyMean is the arithmetic mean of the converted prices.
+	StrategyMean = "mean"
+	// StrategyTrimmedMean is the arithmetic mean of the converted prices after dropping a
+	// fraction of the lowest and highest prices.
+	StrategyTrimmedMean = "trimmed_mean"
+	// StrategyWeightedMedian is the weighted median of the converted prices.
+	StrategyWeightedMedian = "weighted_median"
+	// StrategyPrimary is the price of the highest priority provider that reported one.
+	StrategyPrimary = "primary"
+	// StrategyMin is the lowest converted price.
+	StrategyMin = "min"
+	// StrategyMax is the highest converted price.
+	StrategyMax = "max"
+
+	// defaultTrimFraction is the fraction of prices dropped from each end by the trimmed
+	// mean if none is set.
+	defaultTrimFraction = 0.1
+)
+
+// Strategy aggregates the converted prices of a ticker into a single price. The ticker's
+// strategy metadata is passed to the strategy so that it can read its parameters. The converted
+// prices are never empty. If a strategy returns an error, the ticker falls back to the default
+// median.
+type Strategy func(prices []ConvertedPrice, params tickermetadata.Strategy) (*big.Float, error)
+
+// DefaultStrategies returns the built-in aggregation strategies indexed by name.
+func DefaultStrategies() map[string]Strategy {
+	return map[string]Strategy{
+		StrategyMedian:         MedianStrategy,
+		StrategyMean:           MeanStrategy,
+		StrategyTrimmedMean:    TrimmedMeanStrategy,
+		StrategyWeightedMedian: WeightedMedianStrategy,
+		StrategyPrimary:        PrimaryStrategy,
+		StrategyMin:            MinStrategy,
+		StrategyMax:            MaxStrategy,
+	}
+}
+
+// MedianStrategy returns the median of the converted prices.
+func MedianStrategy(prices []ConvertedPrice, _ tickermetadata.Strategy) (*big.Float, error) {
+	return math.CalculateMedian(pricesOf(prices)), nil
+}
+
+// MeanStrategy returns the arithmetic mean of the converted prices.
+func MeanStrategy(prices []ConvertedPrice, _ tickermetadata.Strategy) (*big.Float, error) {
+	return mean(pricesOf(prices)), nil
+}
+
+// TrimmedMeanStrategy returns the arithmetic mean of the converted prices after dropping the
+// configured fraction of prices from each end, or the default fraction if none is configured.
+// The number of prices dropped from each end is rounded down, so small sets of prices may not
+// be trimmed at all.
+func TrimmedMeanStrategy(prices []ConvertedPrice, params tickermetadata.Strategy) (*big.Float, error) {
+	fraction := defaultTrimFraction
+	if params.TrimFraction != nil {
+		fraction = *params.TrimFraction
+	}
+	if fraction < 0 || fraction >= 0.5 {
+		return nil, fmt.Errorf("trim fraction must be in [0, 0.5); got %v", fraction)
+	}
+
+	values := pricesOf(prices)
+	math.SortBigFloats(values)
+
+	trim := int(float64(len(values)) * fraction)
+	return mean(values[trim : len(values)-trim]), nil
+}
+
+// WeightedMedianStrategy returns the weighted median of the converted prices. Returns an error
+// if any converted price does not have a positive weight.
+func WeightedMedianStrategy(prices []ConvertedPrice, _ tickermetadata.Strategy) (*big.Float, error) {
+	weights, ok := weightsOf(prices)
+	if !ok {
+		return nil, fmt.Errorf("missing weights for converted prices")
+	}
+
+	return math.CalculateWeightedMedian(pricesOf(prices), weights), nil
+}
+
+// PrimaryStrategy returns the price of the first configured provider that reported one. If none
+// of the configured providers reported a price, the median of the converted prices is returned.
+func PrimaryStrategy(prices []ConvertedPrice, params tickermetadata.Strategy) (*big.Float, error) {
+	if len(params.Providers) == 0 {
+		return nil, fmt.Errorf("primary strategy must set at least one provider")
+	}
+
+	for _, provider := range params.Providers {
+		for _, price := range prices {
+			if price.Provider.Name == provider {
+				return new(big.Float).Copy(price.Price), nil
+			}
+		}
+	}
+
+	return math.CalculateMedian(pricesOf(prices)), nil
+}
+
+// MinStrategy returns the lowest converted price.
+func MinStrategy(prices []ConvertedPrice, _ tickermetadata.Strategy) (*big.Float, error) {
+	values := pricesOf(prices)
+	math.SortBigFloats(values)
+
+	return new(big.Float).Copy(values[0]), nil
+}
+
+// MaxStrategy returns the highest converted price.
+func MaxStrategy(prices []ConvertedPrice, _ tickermetadata.Strategy) (*big.Float, error) {
+	values := pricesOf(prices)
+	math.SortBigFloats(values)
+
+	return new(big.Float).Copy(values[len(values)-1]), nil
+}
@@ -0,0 +1,241 @@
+package oracle_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	"github.com/skip-mev/connect/v2/providers/websockets/kucoin"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func TestAggregateDataWithStrategy(t *testing.T) {
+	// lastPrice is a custom strategy that returns the last converted price.
+	lastPrice := func(prices []oracle.ConvertedPrice, _ tickermetadata.Strategy) (*big.Float, error) {
+		return prices[len(prices)-1].Price, nil
+	}
+
+	testCases := []struct {
+		name     string
+		strategy *tickermetadata.Strategy
+		weights  map[string]types.Prices
+		expected *big.Float
+	}{
+		{
+			name:     "no strategy uses the median",
+			strategy: nil,
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "median",
+			strategy: &tickermetadata.Strategy{Name: oracle.StrategyMedian},
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "mean",
+			strategy: &tickermetadata.Strategy{Name: oracle.StrategyMean},
+			expected: big.NewFloat(117),
+		},
+		{
+			name: "trimmed mean drops the lowest and highest prices",
+			strategy: &tickermetadata.Strategy{
+				Name:         oracle.StrategyTrimmedMean,
+				TrimFraction: trimFraction(0.34),
+			},
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "trimmed mean with the default fraction does not trim small sets of prices",
+			strategy: &tickermetadata.Strategy{Name: oracle.StrategyTrimmedMean},
+			expected: big.NewFloat(117),
+		},
+		{
+			name: "invalid trim fraction falls back to the median",
+			strategy: &tickermetadata.Strategy{
+				Name:         oracle.StrategyTrimmedMean,
+				TrimFraction: trimFraction(0.5),
+			},
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "weighted median",
+			strategy: &tickermetadata.Strategy{Name: oracle.StrategyWeightedMedian},
+			weights: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(1)},
+				binance.Name:  {"BTCUSD": big.NewFloat(1)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(10)},
+			},
+			expected: big.NewFloat(150),
+		},
+		{
+			name:     "weighted median without weights falls back to the median",
+			strategy: &tickermetadata.Strategy{Name: oracle.StrategyWeightedMedian},
+			expected: big.NewFloat(101),
+		},
+		{
+			name: "primary uses the first provider",
+			strategy: &tickermetadata.Strategy{
+				Name:      oracle.StrategyPrimary,
+				Providers: []string{kucoin.Name, coinbase.Name},
+			},
+			expected: big.NewFloat(150),
+		},
+		{
+			name: "primary falls back to the next provider",
+			strategy: &tickermetadata.Strategy{
+				Name:      oracle.StrategyPrimary,
+				Providers: []string{"unknown", coinbase.Name},
+			},
+			expected: big.NewFloat(100),
+		},
+		{
+			name: "primary falls back to the median if no provider reports a price",
+			strategy: &tickermetadata.Strategy{
+				Name:      oracle.StrategyPrimary,
+				Providers: []string{"unknown"},
+			},
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "min",
+			strategy: &tickermetadata.Strategy{Name: oracle.StrategyMin},
+			expected: big.NewFloat(100),
+		},
+		{
+			name:     "max",
+			strategy: &tickermetadata.Strategy{Name: oracle.StrategyMax},
+			expected: big.NewFloat(150),
+		},
+		{
+			name:     "custom strategy",
+			strategy: &tickermetadata.Strategy{Name: "last"},
+			expected: big.NewFloat(150),
+		},
+		{
+			name:     "unknown strategy falls back to the median",
+			strategy: &tickermetadata.Strategy{Name: "unknown"},
+			expected: big.NewFloat(101),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ticker := BTC_USD
+			if tc.strategy != nil {
+				bz, err := json.Marshal(tickermetadata.NewAggregationMetadata(nil, nil, tc.strategy))
+				require.NoError(t, err)
+				ticker.Metadata_JSON = string(bz)
+			}
+
+			marketMap := mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					ticker.String(): {
+						Ticker: ticker,
+						ProviderConfigs: []mmtypes.ProviderConfig{
+							{
+								Name:           coinbase.Name,
+								OffChainTicker: "BTC-USD",
+							},
+							{
+								Name:           binance.Name,
+								OffChainTicker: "BTCUSD",
+							},
+							{
+								Name:           kucoin.Name,
+								OffChainTicker: "BTC-USD",
+							},
+						},
+					},
+				},
+			}
+
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				marketMap,
+				metrics.NewNopMetrics(),
+				oracle.WithStrategy("last", lastPrice),
+			)
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(100)})
+			m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(101)})
+			m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(150)})
+			for provider, weights := range tc.weights {
+				m.SetProviderWeights(provider, weights)
+			}
+			m.AggregatePrices()
+
+			result := m.GetIndexPrices()
+			require.Contains(t, result, ticker.String())
+			require.Zero(
+				t,
+				tc.expected.Cmp(result[ticker.String()]),
+				"expected %v, got %v", tc.expected, result[ticker.String()],
+			)
+		})
+	}
+}
+
+func TestTrimmedMeanStrategy(t *testing.T) {
+	// The prices are 1, ..., 9 and 100, so the default fraction drops 1 and 100.
+	prices := make([]oracle.ConvertedPrice, 0, 10)
+	for i := 1; i < 10; i++ {
+		prices = append(prices, oracle.ConvertedPrice{Price: big.NewFloat(float64(i))})
+	}
+	prices = append(prices, oracle.ConvertedPrice{Price: big.NewFloat(100)})
+
+	testCases := []struct {
+		name         string
+		trimFraction *float64
+		expected     *big.Float
+		expErr       bool
+	}{
+		{
+			name:     "unset fraction uses the default",
+			expected: big.NewFloat(5.5),
+		},
+		{
+			name:         "zero fraction does not trim",
+			trimFraction: trimFraction(0),
+			expected:     big.NewFloat(14.5),
+		},
+		{
+			name:         "fraction drops prices from each end",
+			trimFraction: trimFraction(0.2),
+			expected:     big.NewFloat(5.5),
+		},
+		{
+			name:         "negative fraction",
+			trimFraction: trimFraction(-0.1),
+			expErr:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := oracle.TrimmedMeanStrategy(prices, tickermetadata.Strategy{
+				Name:         oracle.StrategyTrimmedMean,
+				TrimFraction: tc.trimFraction,
+			})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Zero(t, tc.expected.Cmp(price), "expected %v, got %v", tc.expected, price)
+		})
+	}
+}
+
+func trimFraction(fraction float64) *float64 {
+	return &fraction
+}
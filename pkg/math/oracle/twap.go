@@ -0,0 +1,113 @@
+package oracle
+
+import (
+	"math/big"
+	"time"
+)
+
+// priceSample is an index price that was calculated at a given time.
+type priceSample struct {
+	timestamp time.Time
+	price     *big.Float
+}
+
+// twapWindow maintains a rolling window of index price samples for each ticker and computes
+// the time-weighted average price (TWAP) over the window. Each sample is weighted by the
+// amount of time it was the most recent price, capped at the maximum price age. This means
+// that a sample can never contribute more than maxPriceAge worth of weight, so gaps where
+// the ticker had insufficient providers do not extend stale prices indefinitely.
+//
+// twapWindow is not thread-safe; callers must hold the aggregator's lock.
+type twapWindow struct {
+	// window is the duration over which the TWAP is computed.
+	window time.Duration
+	// maxPriceAge is the maximum amount of time a sample is considered valid. A value of
+	// zero disables the check.
+	maxPriceAge time.Duration
+	// samples are the price samples for each ticker, ordered by timestamp.
+	samples map[string][]priceSample
+}
+
+// newTWAPWindow returns a new TWAP window with the given window and max price age.
+func newTWAPWindow(window, maxPriceAge time.Duration) *twapWindow {
+	return &twapWindow{
+		window:      window,
+		maxPriceAge: maxPriceAge,
+		samples:     make(map[string][]priceSample),
+	}
+}
+
+// Add records a price sample for the ticker and evicts the samples that no longer fall in
+// the window. The most recent sample at or before the start of the window is retained as
+// it determines the price at the start of the window.
+func (w *twapWindow) Add(ticker string, now time.Time, price *big.Float) {
+	samples := append(w.samples[ticker], priceSample{
+		timestamp: now,
+		price:     new(big.Float).Copy(price),
+	})
+
+	start := now.Add(-w.window)
+	evict := 0
+	for evict+1 < len(samples) && !samples[evict+1].timestamp.After(start) {
+		evict++
+	}
+
+	w.samples[ticker] = samples[evict:]
+}
+
+// Price returns the TWAP of the ticker at the given time. Returns false if the ticker has no
+// samples or if the most recent sample is older than the max price age.
+func (w *twapWindow) Price(ticker string, now time.Time) (*big.Float, bool) {
+	samples := w.samples[ticker]
+	if len(samples) == 0 {
+		return nil, false
+	}
+
+	latest := samples[len(samples)-1]
+	if w.maxPriceAge > 0 && now.Sub(latest.timestamp) > w.maxPriceAge {
+		return nil, false
+	}
+
+	start := now.Add(-w.window)
+	weightedSum := new(big.Float)
+	totalWeight := new(big.Float)
+	for i, sample := range samples {
+		// The sample is the most recent price from its timestamp until the next sample.
+		from := sample.timestamp
+		if from.Before(start) {
+			from = start
+		}
+
+		to := now
+		if i+1 < len(samples) {
+			to = samples[i+1].timestamp
+		}
+		if w.maxPriceAge > 0 && to.Sub(sample.timestamp) > w.maxPriceAge {
+			to = sample.timestamp.Add(w.maxPriceAge)
+		}
+
+		if !to.After(from) {
+			continue
+		}
+
+		weight := new(big.Float).SetInt64(int64(to.Sub(from)))
+		weightedSum.Add(weightedSum, new(big.Float).Mul(sample.price, weight))
+		totalWeight.Add(totalWeight, weight)
+	}
+
+	// If no time has elapsed since the only valid sample, the TWAP is the sample itself.
+	if totalWeight.Sign() == 0 {
+		return new(big.Float).Copy(latest.price), true
+	}
+
+	return weightedSum.Quo(weightedSum, totalWeight), true
+}
+
+// Retain removes the samples of every ticker that is not in the given set.
+func (w *twapWindow) Retain(tickers map[string]struct{}) {
+	for ticker := range w.samples {
+		if _, ok := tickers[ticker]; !ok {
+			delete(w.samples, ticker)
+		}
+	}
+}
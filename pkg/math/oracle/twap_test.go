@@ -0,0 +1,297 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestAggregateDataWithTWAP(t *testing.T) {
+	ticker := BTC_USD
+	ticker.MinProviderCount = 1
+
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "BTC-USD",
+					},
+				},
+			},
+		},
+	}
+
+	// step is a single aggregation round. A price of zero indicates that the provider
+	// did not report a price for the round. A non-zero maxPriceAge is set on the aggregator
+	// before the round.
+	type step struct {
+		elapsed     time.Duration
+		price       float64
+		expected    float64
+		missing     bool
+		maxPriceAge time.Duration
+	}
+
+	testCases := []struct {
+		name        string
+		window      time.Duration
+		maxPriceAge time.Duration
+		steps       []step
+	}{
+		{
+			name:        "first sample is the twap",
+			window:      time.Minute,
+			maxPriceAge: time.Minute,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+			},
+		},
+		{
+			name:        "a one tick spike is diluted by the window",
+			window:      time.Minute,
+			maxPriceAge: time.Minute,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 100, expected: 100},
+				{elapsed: 20 * time.Second, price: 1_000, expected: 100},
+				{elapsed: 30 * time.Second, price: 100, expected: 400},
+			},
+		},
+		{
+			name:        "prices are weighted by time",
+			window:      time.Minute,
+			maxPriceAge: time.Minute,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 200, expected: 100},
+				{elapsed: 40 * time.Second, price: 200, expected: 175},
+			},
+		},
+		{
+			name:        "samples outside of the window are evicted",
+			window:      30 * time.Second,
+			maxPriceAge: time.Minute,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 200, expected: 100},
+				{elapsed: 20 * time.Second, price: 200, expected: 150},
+				{elapsed: 50 * time.Second, price: 200, expected: 200},
+			},
+		},
+		{
+			name:        "sample straddling the window start is partially weighted",
+			window:      30 * time.Second,
+			maxPriceAge: time.Minute,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 20 * time.Second, price: 400, expected: 100},
+				{elapsed: 40 * time.Second, price: 400, expected: 300},
+			},
+		},
+		{
+			name:        "twap is served during a provider gap until the max price age",
+			window:      time.Minute,
+			maxPriceAge: 15 * time.Second,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 10 * time.Second, price: 200, expected: 100},
+				{elapsed: 20 * time.Second, expected: 150},
+				{elapsed: 30 * time.Second, missing: true},
+				{elapsed: 40 * time.Second, price: 300, expected: 160},
+			},
+		},
+		{
+			name:        "an updated max price age is applied to the twap",
+			window:      time.Minute,
+			maxPriceAge: 15 * time.Second,
+			steps: []step{
+				{elapsed: 0, price: 100, expected: 100},
+				{elapsed: 30 * time.Second, expected: 100, maxPriceAge: time.Minute},
+				{elapsed: 90 * time.Second, missing: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start := time.Now()
+			now := start
+
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				marketMap,
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationConfig(config.AggregationConfig{
+					Mode: config.AggregationModeTWAP,
+					TWAP: config.TWAPConfig{
+						Window: tc.window,
+					},
+				}),
+				oracle.WithMaxPriceAge(tc.maxPriceAge),
+				oracle.WithClock(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			for _, s := range tc.steps {
+				now = start.Add(s.elapsed)
+				if s.maxPriceAge != 0 {
+					m.SetMaxPriceAge(s.maxPriceAge)
+				}
+
+				prices := make(types.Prices)
+				if s.price != 0 {
+					prices["BTC-USD"] = big.NewFloat(s.price)
+				}
+				m.SetProviderPrices(coinbase.Name, prices)
+				m.AggregatePrices()
+
+				// The index prices used for conversions are always the most recent median prices.
+				if s.price != 0 {
+					indexPrice := m.GetIndexPrices()[ticker.String()]
+					require.Equal(t, big.NewFloat(s.price).SetPrec(36), indexPrice.SetPrec(36))
+				}
+
+				result := m.GetPrices()
+				if s.missing {
+					require.NotContains(t, result, ticker.String())
+					continue
+				}
+
+				expected := math.ScaleBigFloat(big.NewFloat(s.expected), ticker.Decimals)
+				require.Contains(t, result, ticker.String())
+				require.Zero(
+					t,
+					expected.Cmp(result[ticker.String()]),
+					"elapsed %s: expected %v, got %v", s.elapsed, expected, result[ticker.String()],
+				)
+			}
+		})
+	}
+}
+
+func TestProviderTWAP(t *testing.T) {
+	ticker := BTC_USD
+	ticker.MinProviderCount = 1
+
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: coinbase.Name, OffChainTicker: "BTC-USD"},
+					{Name: binance.Name, OffChainTicker: "BTCUSD"},
+				},
+			},
+		},
+	}
+
+	// step is a single aggregation round. A price of zero indicates that the provider did not
+	// report a price for the round, and an expected price of zero that the provider has no TWAP.
+	type step struct {
+		elapsed          time.Duration
+		coinbasePrice    float64
+		binancePrice     float64
+		expectedCoinbase float64
+		expectedBinance  float64
+	}
+
+	testCases := []struct {
+		name        string
+		mode        config.AggregationMode
+		maxPriceAge time.Duration
+		steps       []step
+	}{
+		{
+			name:        "provider twaps are not tracked in median mode",
+			mode:        config.AggregationModeMedian,
+			maxPriceAge: time.Minute,
+			steps: []step{
+				{elapsed: 0, coinbasePrice: 100, binancePrice: 200},
+			},
+		},
+		{
+			name:        "provider twaps are tracked independently",
+			mode:        config.AggregationModeTWAP,
+			maxPriceAge: 15 * time.Second,
+			steps: []step{
+				{elapsed: 0, coinbasePrice: 100, binancePrice: 200, expectedCoinbase: 100, expectedBinance: 200},
+				{elapsed: 10 * time.Second, coinbasePrice: 200, expectedCoinbase: 100, expectedBinance: 200},
+				{elapsed: 20 * time.Second, coinbasePrice: 200, expectedCoinbase: 150},
+			},
+		},
+	}
+
+	// twapOf returns the provider's scaled TWAP from the provider price details.
+	twapOf := func(details []types.ProviderPriceDetail, provider string) *big.Float {
+		for _, detail := range details {
+			if detail.Provider == provider {
+				return detail.TWAPPrice
+			}
+		}
+		return nil
+	}
+
+	// scaled returns the price scaled by the decimals of the ticker, or nil if it is zero.
+	scaled := func(price float64) *big.Float {
+		if price == 0 {
+			return nil
+		}
+		return math.ScaleBigFloat(big.NewFloat(price), ticker.Decimals)
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start := time.Now()
+			now := start
+
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				marketMap,
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationConfig(config.AggregationConfig{
+					Mode: tc.mode,
+					TWAP: config.TWAPConfig{
+						Window: time.Minute,
+					},
+				}),
+				oracle.WithMaxPriceAge(tc.maxPriceAge),
+				oracle.WithClock(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			for _, s := range tc.steps {
+				now = start.Add(s.elapsed)
+
+				coinbasePrices := make(types.Prices)
+				if s.coinbasePrice != 0 {
+					coinbasePrices["BTC-USD"] = big.NewFloat(s.coinbasePrice)
+				}
+				binancePrices := make(types.Prices)
+				if s.binancePrice != 0 {
+					binancePrices["BTCUSD"] = big.NewFloat(s.binancePrice)
+				}
+				m.SetProviderPrices(coinbase.Name, coinbasePrices)
+				m.SetProviderPrices(binance.Name, binancePrices)
+				m.AggregatePrices()
+
+				details := m.GetProviderPriceDetails()[ticker.String()]
+				requireFloatEqual(t, scaled(s.expectedCoinbase), twapOf(details, coinbase.Name), "elapsed %s", s.elapsed)
+				requireFloatEqual(t, scaled(s.expectedBinance), twapOf(details, binance.Name), "elapsed %s", s.elapsed)
+			}
+		})
+	}
+}
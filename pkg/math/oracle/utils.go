@@ -3,11 +3,18 @@ package oracle
 import (
 	"fmt"
 	"maps"
+	"math"
 	"math/big"
+	"slices"
+	"time"
 
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
 	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
 	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
 )
 
 // GetProviderPrice returns the relevant provider price. Note that the aggregator's
@@ -80,6 +87,27 @@ func (m *IndexPriceAggregator) UpdateMarketMap(marketMap mmtypes.MarketMap) {
 	defer m.mtx.Unlock()
 
 	m.cfg = marketMap
+	m.tickerMetadata = m.parseTickerMetadata(marketMap)
+	m.staticWeights = m.parseStaticWeights(marketMap)
+}
+
+// SetMaxPriceAge updates the maximum age of a price sample that is used when computing the
+// time-weighted average price and the smoothed price. This should be called whenever the
+// oracle's MaxPriceAge is updated.
+func (m *IndexPriceAggregator) SetMaxPriceAge(maxPriceAge time.Duration) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.maxPriceAge = maxPriceAge
+	if m.twap != nil {
+		m.twap.maxPriceAge = maxPriceAge
+	}
+	for _, window := range m.providerTWAPs {
+		window.maxPriceAge = maxPriceAge
+	}
+	if m.smoother != nil {
+		m.smoother.maxPriceAge = maxPriceAge
+	}
 }
 
 // GetMarketMap returns the market map for the oracle.
@@ -102,12 +130,40 @@ func (m *IndexPriceAggregator) SetProviderPrices(provider string, data types.Pri
 	m.providerPrices[provider] = data
 }
 
+// SetProviderWeights updates the data aggregator with the weights reported by the given
+// provider. Weights are indexed by off-chain ticker.
+func (m *IndexPriceAggregator) SetProviderWeights(provider string, weights types.Prices) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if weights == nil {
+		weights = make(types.Prices)
+	}
+
+	m.providerWeights[provider] = weights
+}
+
+// SetProviderTimestamps updates the data aggregator with the timestamps of the prices reported
+// by the given provider. Timestamps are indexed by off-chain ticker.
+func (m *IndexPriceAggregator) SetProviderTimestamps(provider string, timestamps map[string]time.Time) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if timestamps == nil {
+		timestamps = make(map[string]time.Time)
+	}
+
+	m.providerTimestamps[provider] = timestamps
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *IndexPriceAggregator) Reset() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	m.providerPrices = make(map[string]types.Prices)
+	m.providerWeights = make(map[string]types.Prices)
+	m.providerTimestamps = make(map[string]map[string]time.Time)
 }
 
 // GetPrices returns the aggregated data the aggregator has. Specifically, the
@@ -122,3 +178,320 @@ func (m *IndexPriceAggregator) GetPrices() types.Prices {
 
 	return cpy
 }
+
+// GetDispersions returns the dispersion of the converted prices used to calculate each
+// aggregated price. Each statistic is scaled by the respective ticker's decimals.
+func (m *IndexPriceAggregator) GetDispersions() types.Dispersions {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.Dispersions)
+	maps.Copy(cpy, m.dispersions)
+
+	return cpy
+}
+
+// GetPriorityTiers returns the lowest priority tier of the provider configs used to calculate
+// each aggregated price.
+func (m *IndexPriceAggregator) GetPriorityTiers() types.PriorityTiers {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.PriorityTiers)
+	maps.Copy(cpy, m.priorityTiers)
+
+	return cpy
+}
+
+// GetProviderHealth returns the health of every (provider, ticker) pair, sorted by provider and
+// then ticker. Returns nil if health tracking is disabled.
+func (m *IndexPriceAggregator) GetProviderHealth() []types.ProviderHealth {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.health == nil {
+		return nil
+	}
+
+	return m.health.Health()
+}
+
+// GetProviderPriceDetails returns the details of the price of each provider config of every
+// enabled ticker in the latest aggregation round.
+func (m *IndexPriceAggregator) GetProviderPriceDetails() types.ProviderPriceDetails {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.ProviderPriceDetails, len(m.providerPriceDetails))
+	maps.Copy(cpy, m.providerPriceDetails)
+
+	return cpy
+}
+
+// GetPriceHistory returns the price records that match the filter, sorted by ticker and then
+// timestamp. Returns nil if the price history is disabled.
+func (m *IndexPriceAggregator) GetPriceHistory(filter types.PriceHistoryFilter) []types.PriceRecord {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.history == nil {
+		return nil
+	}
+
+	return m.history.Query(filter, m.now())
+}
+
+// parseTickerMetadata parses the aggregation metadata of every ticker in the market map. Tickers
+// with empty or invalid metadata are omitted, in which case the oracle wide configuration is used.
+func (m *IndexPriceAggregator) parseTickerMetadata(
+	marketMap mmtypes.MarketMap,
+) map[string]tickermetadata.AggregationMetadata {
+	metadata := make(map[string]tickermetadata.AggregationMetadata)
+	for ticker, market := range marketMap.Markets {
+		if len(market.Ticker.Metadata_JSON) == 0 {
+			continue
+		}
+
+		md, err := tickermetadata.AggregationMetadataFromJSONString(market.Ticker.Metadata_JSON)
+		if err != nil {
+			m.logger.Warn(
+				"failed to parse ticker aggregation metadata; using default aggregation config",
+				zap.String("ticker", ticker),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		metadata[ticker] = md
+	}
+
+	return metadata
+}
+
+// parseStaticWeights parses the static weight configured in the metadata of every provider config
+// in the market map. Provider configs with no weight or invalid metadata are omitted.
+func (m *IndexPriceAggregator) parseStaticWeights(
+	marketMap mmtypes.MarketMap,
+) map[string]map[string]*big.Float {
+	weights := make(map[string]map[string]*big.Float)
+	for _, market := range marketMap.Markets {
+		for _, cfg := range market.ProviderConfigs {
+			if len(cfg.Metadata_JSON) == 0 {
+				continue
+			}
+
+			md, err := tickermetadata.ProviderAggregationMetadataFromJSONString(cfg.Metadata_JSON)
+			if err != nil {
+				m.logger.Warn(
+					"failed to parse provider aggregation metadata; ignoring static weight",
+					zap.String("ticker", market.Ticker.String()),
+					zap.String("provider", cfg.Name),
+					zap.String("off_chain_ticker", cfg.OffChainTicker),
+					zap.Error(err),
+				)
+
+				continue
+			}
+
+			switch {
+			case md.Weight > 0:
+				if _, ok := weights[market.Ticker.String()]; !ok {
+					weights[market.Ticker.String()] = make(map[string]*big.Float)
+				}
+				weights[market.Ticker.String()][providerWeightKey(cfg)] = big.NewFloat(md.Weight)
+			case md.Weight < 0:
+				m.logger.Warn(
+					"negative static weight in provider aggregation metadata; ignoring static weight",
+					zap.String("ticker", market.Ticker.String()),
+					zap.String("provider", cfg.Name),
+					zap.String("off_chain_ticker", cfg.OffChainTicker),
+					zap.Float64("weight", md.Weight),
+				)
+			}
+		}
+	}
+
+	return weights
+}
+
+// providerWeight returns the weight of the provider config's converted price for the given ticker.
+// If any provider config of the ticker has a static weight in its metadata, the static weights are
+// used for the ticker, since they are unitless and cannot be compared with reported weights.
+// Otherwise, the weight reported by the provider is used. Reported weights are denominated in the
+// quote of the provider's off-chain ticker, e.g. USDT for BTC-USDT, so they are converted to the
+// quote of the target ticker at the same rates as the price. Returns nil if there is no weight.
+func (m *IndexPriceAggregator) providerWeight(
+	ticker mmtypes.Ticker,
+	cfg mmtypes.ProviderConfig,
+	adjustedPrice *big.Float,
+) *big.Float {
+	if weights, ok := m.staticWeights[ticker.String()]; ok {
+		return weights[providerWeightKey(cfg)]
+	}
+
+	weight := m.providerWeights[cfg.Name][cfg.OffChainTicker]
+	if weight == nil {
+		return nil
+	}
+
+	// If the provider's ticker is inverted, its quote is the base of the converted price, which
+	// is valued at the converted price. Otherwise, its quote is converted by the normalization
+	// path, i.e. at the ratio of the converted price to the provider's price.
+	if cfg.Invert {
+		return new(big.Float).Mul(weight, adjustedPrice)
+	}
+
+	price, err := m.GetProviderPrice(cfg)
+	if err != nil || price.Sign() == 0 {
+		return nil
+	}
+
+	normalized := new(big.Float).Mul(weight, adjustedPrice)
+	return normalized.Quo(normalized, price)
+}
+
+// providerWeightKey returns the key used to index the static weight of a provider config within
+// its ticker.
+func providerWeightKey(cfg mmtypes.ProviderConfig) string {
+	return fmt.Sprintf("%s/%s", cfg.Name, cfg.OffChainTicker)
+}
+
+// outlierFilter returns the outlier filter for the given ticker. The ticker's metadata takes
+// precedence over the oracle wide configuration if it is valid.
+func (m *IndexPriceAggregator) outlierFilter(ticker mmtypes.Ticker) config.OutlierFilterConfig {
+	md, ok := m.tickerMetadata[ticker.String()]
+	if !ok || md.OutlierFilter == nil {
+		return m.aggregationCfg.OutlierFilter
+	}
+
+	filter := config.OutlierFilterConfig{
+		Method:    config.OutlierFilterMethod(md.OutlierFilter.Method),
+		Threshold: md.OutlierFilter.Threshold,
+	}
+	if err := filter.ValidateBasic(); err != nil {
+		m.logger.Debug(
+			"invalid ticker outlier filter; using default outlier filter",
+			zap.String("ticker", ticker.String()),
+			zap.Error(err),
+		)
+
+		return m.aggregationCfg.OutlierFilter
+	}
+
+	return filter
+}
+
+// circuitBreakerConfig returns the circuit breaker configuration of the given ticker. Returns
+// false if the ticker does not configure a valid circuit breaker in its metadata.
+func (m *IndexPriceAggregator) circuitBreakerConfig(ticker mmtypes.Ticker) (circuitBreakerConfig, bool) {
+	md, ok := m.tickerMetadata[ticker.String()]
+	if !ok || md.CircuitBreaker == nil {
+		return circuitBreakerConfig{}, false
+	}
+
+	cfg, err := newCircuitBreakerConfig(*md.CircuitBreaker)
+	if err != nil {
+		m.logger.Debug(
+			"invalid ticker circuit breaker; circuit breaker is disabled",
+			zap.String("ticker", ticker.String()),
+			zap.Error(err),
+		)
+
+		return circuitBreakerConfig{}, false
+	}
+
+	return cfg, true
+}
+
+// selectPriorityTiers returns the converted prices of the highest priority tiers that together
+// meet the minimum provider count, along with the lowest priority tier that was included. Lower
+// priority tiers are only included if the higher priority tiers do not report enough prices. If
+// no set of tiers meets the minimum provider count, every converted price is returned. The
+// relative order of the prices is preserved.
+func selectPriorityTiers(prices []ConvertedPrice, minProviderCount int) ([]ConvertedPrice, uint32) {
+	tiers := make([]uint32, 0, len(prices))
+	for _, price := range prices {
+		if !slices.Contains(tiers, price.Provider.Priority) {
+			tiers = append(tiers, price.Provider.Priority)
+		}
+	}
+	slices.Sort(tiers)
+
+	if len(tiers) == 0 {
+		return prices, 0
+	}
+
+	count := 0
+	for _, tier := range tiers {
+		for _, price := range prices {
+			if price.Provider.Priority == tier {
+				count++
+			}
+		}
+
+		if count >= minProviderCount {
+			selected := make([]ConvertedPrice, 0, count)
+			for _, price := range prices {
+				if price.Provider.Priority <= tier {
+					selected = append(selected, price)
+				}
+			}
+
+			return selected, tier
+		}
+	}
+
+	return prices, tiers[len(tiers)-1]
+}
+
+// weightsOf returns the weights of the converted prices. Returns false if any converted price
+// does not have a positive weight.
+func weightsOf(prices []ConvertedPrice) ([]*big.Float, bool) {
+	weights := make([]*big.Float, len(prices))
+	for i, price := range prices {
+		if price.Weight == nil || price.Weight.Sign() <= 0 {
+			return nil, false
+		}
+
+		weights[i] = price.Weight
+	}
+
+	return weights, true
+}
+
+// decayWeights returns the weights of the converted prices decayed by their age, i.e.
+// weight * 2^(-age / halfLife). If weights is nil, every price starts with a weight of one.
+// Prices without a timestamp, or with a timestamp in the future, are not decayed.
+func decayWeights(
+	prices []ConvertedPrice,
+	weights []*big.Float,
+	now time.Time,
+	halfLife time.Duration,
+) []*big.Float {
+	decayed := make([]*big.Float, len(prices))
+	for i, price := range prices {
+		weight := big.NewFloat(1)
+		if weights != nil {
+			weight.Copy(weights[i])
+		}
+
+		if age := now.Sub(price.Timestamp); !price.Timestamp.IsZero() && age > 0 {
+			weight.Mul(weight, big.NewFloat(math.Exp2(-age.Seconds()/halfLife.Seconds())))
+		}
+
+		decayed[i] = weight
+	}
+
+	return decayed
+}
+
+// pricesOf returns the prices of the given converted prices.
+func pricesOf(convertedPrices []ConvertedPrice) []*big.Float {
+	prices := make([]*big.Float, len(convertedPrices))
+	for i, price := range convertedPrices {
+		prices[i] = price.Price
+	}
+
+	return prices
+}
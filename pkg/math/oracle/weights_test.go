@@ -0,0 +1,222 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	"github.com/skip-mev/connect/v2/providers/websockets/kucoin"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestAggregateDataWithWeightedMedian(t *testing.T) {
+	weightedMarketMap := func(metadata map[string]string) mmtypes.MarketMap {
+		return mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				BTC_USD.String(): {
+					Ticker: BTC_USD,
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{
+							Name:           coinbase.Name,
+							OffChainTicker: "BTC-USD",
+							Metadata_JSON:  metadata[coinbase.Name],
+						},
+						{
+							Name:           binance.Name,
+							OffChainTicker: "BTCUSD",
+							Metadata_JSON:  metadata[binance.Name],
+						},
+						{
+							Name:           kucoin.Name,
+							OffChainTicker: "BTC-USD",
+							Metadata_JSON:  metadata[kucoin.Name],
+						},
+					},
+				},
+			},
+		}
+	}
+
+	setPrices := func(m *oracle.IndexPriceAggregator) {
+		m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(100)})
+		m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(101)})
+		m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(150)})
+	}
+
+	testCases := []struct {
+		name           string
+		weightedMedian bool
+		metadata       map[string]string
+		weights        map[string]types.Prices
+		expected       *big.Float
+	}{
+		{
+			name:           "weights are ignored if the weighted median is disabled",
+			weightedMedian: false,
+			weights: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(1)},
+				binance.Name:  {"BTCUSD": big.NewFloat(1)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(10)},
+			},
+			expected: big.NewFloat(101),
+		},
+		{
+			name:           "provider weights are used to compute the weighted median",
+			weightedMedian: true,
+			weights: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(1)},
+				binance.Name:  {"BTCUSD": big.NewFloat(1)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(10)},
+			},
+			expected: big.NewFloat(150),
+		},
+		{
+			name:           "static weights take precedence over provider weights",
+			weightedMedian: true,
+			metadata: map[string]string{
+				coinbase.Name: `{"weight":1}`,
+				binance.Name:  `{"weight":1}`,
+				kucoin.Name:   `{"weight":2}`,
+			},
+			weights: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(1)},
+				binance.Name:  {"BTCUSD": big.NewFloat(1)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(10)},
+			},
+			expected: big.NewFloat(125.5),
+		},
+		{
+			name:           "static weights are not mixed with provider weights",
+			weightedMedian: true,
+			metadata: map[string]string{
+				kucoin.Name: `{"weight":2}`,
+			},
+			weights: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(1)},
+				binance.Name:  {"BTCUSD": big.NewFloat(1)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(10)},
+			},
+			expected: big.NewFloat(101),
+		},
+		{
+			name:           "missing weights fall back to the unweighted median",
+			weightedMedian: true,
+			weights: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(1)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(10)},
+			},
+			expected: big.NewFloat(101),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				weightedMarketMap(tc.metadata),
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationConfig(config.AggregationConfig{
+					WeightedMedian: tc.weightedMedian,
+				}),
+			)
+			require.NoError(t, err)
+
+			setPrices(m)
+			for provider, weights := range tc.weights {
+				m.SetProviderWeights(provider, weights)
+			}
+			m.AggregatePrices()
+
+			result := m.GetIndexPrices()
+			require.Contains(t, result, BTC_USD.String())
+			require.Zero(
+				t,
+				tc.expected.Cmp(result[BTC_USD.String()]),
+				"expected %v, got %v", tc.expected, result[BTC_USD.String()],
+			)
+		})
+	}
+}
+
+func TestProviderWeightsAreNormalized(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      mmtypes.ProviderConfig
+		price    *big.Float
+		weight   *big.Float
+		expected *big.Float
+	}{
+		{
+			name: "weights of direct prices are unchanged",
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "BTC-USD",
+			},
+			price:    big.NewFloat(70_000),
+			weight:   big.NewFloat(1_000),
+			expected: big.NewFloat(1_000),
+		},
+		{
+			name: "weights of normalized prices are converted to the target quote",
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "BTC-USDT",
+				NormalizeByPair: &pkgtypes.CurrencyPair{
+					Base:  "USDT",
+					Quote: "USD",
+				},
+			},
+			price:    big.NewFloat(70_000),
+			weight:   big.NewFloat(1_000),
+			expected: big.NewFloat(2_000),
+		},
+		{
+			name: "weights of inverted prices are converted to the target quote",
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "USD-BTC",
+				Invert:         true,
+			},
+			price:    big.NewFloat(0.5),
+			weight:   big.NewFloat(1_000),
+			expected: big.NewFloat(2_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				mmtypes.MarketMap{},
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationConfig(config.AggregationConfig{
+					WeightedMedian: true,
+				}),
+			)
+			require.NoError(t, err)
+
+			m.SetProviderPrices(tc.cfg.Name, types.Prices{tc.cfg.OffChainTicker: tc.price})
+			m.SetProviderWeights(tc.cfg.Name, types.Prices{tc.cfg.OffChainTicker: tc.weight})
+			m.SetIndexPrices(types.Prices{USDT_USD.String(): big.NewFloat(2)})
+
+			prices := m.CalculateProviderPrices(mmtypes.Market{
+				Ticker:          BTC_USD,
+				ProviderConfigs: []mmtypes.ProviderConfig{tc.cfg},
+			})
+			require.Len(t, prices, 1)
+			require.Zero(
+				t,
+				tc.expected.Cmp(prices[0].Weight),
+				"expected %v, got %v", tc.expected, prices[0].Weight,
+			)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package testutils
 import (
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/skip-mev/connect/v2/oracle"
 	"github.com/skip-mev/connect/v2/oracle/types"
@@ -39,6 +40,12 @@ func (m *MedianAggregator) SetProviderPrices(provider string, data types.Prices)
 	m.providerPrices[provider] = data
 }
 
+// SetProviderWeights is a no-op as the median aggregator does not weight prices.
+func (m *MedianAggregator) SetProviderWeights(_ string, _ types.Prices) {}
+
+// SetProviderTimestamps is a no-op as the median aggregator does not decay stale prices.
+func (m *MedianAggregator) SetProviderTimestamps(_ string, _ map[string]time.Time) {}
+
 func (m *MedianAggregator) UpdateMarketMap(_ mmtypes.MarketMap) {}
 
 // AggregatePrices inputs the aggregated prices from all providers and computes
@@ -85,6 +92,34 @@ func (m *MedianAggregator) GetPrices() types.Prices {
 	return m.finalPrices
 }
 
+// GetDispersions returns an empty set of dispersions as the median aggregator does not
+// track them.
+func (m *MedianAggregator) GetDispersions() types.Dispersions {
+	return make(types.Dispersions)
+}
+
+// GetPriorityTiers returns an empty set of priority tiers as the median aggregator does not
+// track them.
+func (m *MedianAggregator) GetPriorityTiers() types.PriorityTiers {
+	return make(types.PriorityTiers)
+}
+
+// GetProviderHealth returns nil as the median aggregator does not track provider health.
+func (m *MedianAggregator) GetProviderHealth() []types.ProviderHealth {
+	return nil
+}
+
+// GetPriceHistory returns nil as the median aggregator does not retain a price history.
+func (m *MedianAggregator) GetPriceHistory(_ types.PriceHistoryFilter) []types.PriceRecord {
+	return nil
+}
+
+// GetProviderPriceDetails returns an empty set of provider price details as the median
+// aggregator does not track them.
+func (m *MedianAggregator) GetProviderPriceDetails() types.ProviderPriceDetails {
+	return make(types.ProviderPriceDetails)
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *MedianAggregator) Reset() {
 	m.mtx.Lock()
@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 // ValidatorStore defines the interface contract required for calculating stake-weighted median
@@ -26,3 +28,11 @@ type CCValidatorStore interface {
 	GetAllCCValidator(ctx sdk.Context) []types.CrossChainValidator
 	GetCCValidator(ctx sdk.Context, addr []byte) (types.CrossChainValidator, bool)
 }
+
+// OracleParamsKeeper defines the interface contract required to read the x/oracle module's params,
+// which determine whether the metadata of extended vote extensions is used to compute the median.
+//
+//go:generate mockery --name OracleParamsKeeper --filename mock_oracle_params_keeper.go
+type OracleParamsKeeper interface {
+	GetParams(ctx context.Context) (oracletypes.Params, error)
+}
@@ -4,6 +4,7 @@ import (
 	"crypto"
 	"math/big"
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
@@ -14,10 +15,12 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/skip-mev/connect/v2/abci/testutils"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	"github.com/skip-mev/connect/v2/aggregator"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted/mocks"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 type MathTestSuite struct {
@@ -405,6 +408,159 @@ func (s *MathTestSuite) TestComputeMedian() {
 	}
 }
 
+func (s *MathTestSuite) TestMedianWithPriceMetadata() {
+	btcUSD := connecttypes.NewCurrencyPair("BTC", "USD")
+	now := time.Now().UTC()
+	fresh := now.Add(-time.Second).UnixMilli()
+	stale := now.Add(-time.Minute).UnixMilli()
+	future := now.Add(time.Minute).UnixMilli()
+
+	providerPrices := aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+		validator1.String(): {btcUSD: big.NewInt(100)},
+		validator2.String(): {btcUSD: big.NewInt(200)},
+		validator3.String(): {btcUSD: big.NewInt(300)},
+	}
+	validators := []validator{
+		{stake: sdkmath.NewInt(40), consAddr: validator1},
+		{stake: sdkmath.NewInt(30), consAddr: validator2},
+		{stake: sdkmath.NewInt(30), consAddr: validator3},
+	}
+
+	cases := []struct {
+		name          string
+		params        oracletypes.Params
+		metadata      voteweighted.PriceMetadata
+		expectedPrice *big.Int
+	}{
+		{
+			name:   "metadata is ignored if extended vote extensions are disabled",
+			params: oracletypes.NewParams(false, 30*time.Second),
+			metadata: voteweighted.PriceMetadata{
+				validator2.String(): {btcUSD: {Timestamp: stale, ProviderCount: 1}},
+				validator3.String(): {btcUSD: {Timestamp: stale, ProviderCount: 1}},
+			},
+			expectedPrice: big.NewInt(200),
+		},
+		{
+			name:   "stale prices are discarded",
+			params: oracletypes.NewParams(true, 30*time.Second),
+			metadata: voteweighted.PriceMetadata{
+				validator1.String(): {btcUSD: {Timestamp: fresh, ProviderCount: 1}},
+				validator2.String(): {btcUSD: {Timestamp: stale, ProviderCount: 1}},
+				validator3.String(): {btcUSD: {Timestamp: fresh, ProviderCount: 1}},
+			},
+			expectedPrice: big.NewInt(100),
+		},
+		{
+			name:   "no price if the stake of the fresh prices is below the threshold",
+			params: oracletypes.NewParams(true, 30*time.Second),
+			metadata: voteweighted.PriceMetadata{
+				validator1.String(): {btcUSD: {Timestamp: fresh, ProviderCount: 1}},
+				validator2.String(): {btcUSD: {Timestamp: stale, ProviderCount: 1}},
+				validator3.String(): {btcUSD: {Timestamp: stale, ProviderCount: 1}},
+			},
+		},
+		{
+			name:   "prices are weighted by their confidence",
+			params: oracletypes.NewParams(true, 30*time.Second),
+			metadata: voteweighted.PriceMetadata{
+				validator1.String(): {btcUSD: {Timestamp: fresh, ProviderCount: 9}},
+				validator2.String(): {btcUSD: {Timestamp: fresh, ProviderCount: 1, Dispersion: 10000}},
+				validator3.String(): {btcUSD: {Timestamp: fresh, ProviderCount: 1, Dispersion: 10000}},
+			},
+			expectedPrice: big.NewInt(100),
+		},
+		{
+			name:   "prices from the future are discarded",
+			params: oracletypes.NewParams(true, 30*time.Second),
+			metadata: voteweighted.PriceMetadata{
+				validator1.String(): {btcUSD: {Timestamp: fresh, ProviderCount: 1}},
+				validator2.String(): {btcUSD: {Timestamp: fresh, ProviderCount: 1}},
+				validator3.String(): {btcUSD: {Timestamp: future, ProviderCount: 1}},
+			},
+			expectedPrice: big.NewInt(100),
+		},
+		{
+			name:   "prices without metadata are weighted like single provider prices",
+			params: oracletypes.NewParams(true, 30*time.Second),
+			metadata: voteweighted.PriceMetadata{
+				validator3.String(): {btcUSD: {Timestamp: fresh, ProviderCount: 9}},
+			},
+			expectedPrice: big.NewInt(200),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.ctx = voteweighted.ContextWithPriceMetadata(s.ctx.WithBlockTime(now), tc.metadata)
+
+			paramsKeeper := mocks.NewOracleParamsKeeper(s.T())
+			paramsKeeper.On("GetParams", s.ctx).Return(tc.params, nil)
+
+			aggregateFn := voteweighted.Median(
+				s.ctx,
+				log.NewTestLogger(s.T()),
+				s.createMockValidatorStore(validators, sdkmath.NewInt(100)),
+				voteweighted.DefaultPowerThreshold,
+				voteweighted.WithParamsKeeper(paramsKeeper),
+			)
+			result := aggregateFn(providerPrices)
+
+			if tc.expectedPrice == nil {
+				s.Require().Empty(result)
+				return
+			}
+
+			s.Require().Equal(map[connecttypes.CurrencyPair]*big.Int{btcUSD: tc.expectedPrice}, result)
+		})
+	}
+}
+
+func (s *MathTestSuite) TestConfidenceWeight() {
+	cases := []struct {
+		name     string
+		metadata vetypes.PriceMetadata
+		expected sdkmath.Int
+	}{
+		{
+			name:     "single provider without dispersion",
+			metadata: vetypes.PriceMetadata{ProviderCount: 1},
+			expected: sdkmath.NewInt(50),
+		},
+		{
+			name:     "zero providers are treated as a single provider",
+			metadata: vetypes.PriceMetadata{},
+			expected: sdkmath.NewInt(50),
+		},
+		{
+			name:     "more providers increase the weight",
+			metadata: vetypes.PriceMetadata{ProviderCount: 9},
+			expected: sdkmath.NewInt(90),
+		},
+		{
+			name:     "dispersion decreases the weight",
+			metadata: vetypes.PriceMetadata{ProviderCount: 9, Dispersion: 2500},
+			expected: sdkmath.NewInt(72),
+		},
+		{
+			name:     "weight is at least half the vote weight",
+			metadata: vetypes.PriceMetadata{ProviderCount: 1, Dispersion: 10000},
+			expected: sdkmath.NewInt(50),
+		},
+		{
+			name:     "weight is at most the vote weight",
+			metadata: vetypes.PriceMetadata{ProviderCount: 256},
+			expected: sdkmath.NewInt(99),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.expected, voteweighted.ConfidenceWeight(sdkmath.NewInt(100), tc.metadata))
+		})
+	}
+}
+
 func (s *MathTestSuite) createMockValidatorStore(
 	validators []validator,
 	totalTokens sdkmath.Int,
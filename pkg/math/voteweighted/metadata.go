@@ -0,0 +1,75 @@
+package voteweighted
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// basisPoints is the number of basis points in one, used to scale the confidence of a price by
+// its dispersion.
+const basisPoints = 10000
+
+// maxConfidenceShift is the largest factor by which the confidence of a price can change its
+// weight.
+const maxConfidenceShift = 2
+
+// PriceMetadata is the metadata of the prices reported by each validator in extended vote
+// extensions, keyed by the validator's consensus address.
+type PriceMetadata map[string]map[connecttypes.CurrencyPair]vetypes.PriceMetadata
+
+type priceMetadataKey struct{}
+
+// ContextWithPriceMetadata returns a copy of the context that carries the metadata of the prices
+// being aggregated. Median uses this metadata to discard stale prices and weight prices by their
+// confidence if extended vote extensions are enabled.
+func ContextWithPriceMetadata(ctx sdk.Context, metadata PriceMetadata) sdk.Context {
+	return ctx.WithValue(priceMetadataKey{}, metadata)
+}
+
+// PriceMetadataFromContext returns the metadata of the prices being aggregated, or nil if the
+// context carries no metadata.
+func PriceMetadataFromContext(ctx sdk.Context) PriceMetadata {
+	metadata, _ := ctx.Value(priceMetadataKey{}).(PriceMetadata)
+	return metadata
+}
+
+// IsStale returns true if the price was observed longer than maxAge before the given time. If
+// maxAge is zero, no price is stale. Prices observed more than MaximumTimestampSkew after the
+// given time are always treated as stale, so that validators cannot keep a price fresh by
+// reporting a timestamp in the future.
+func IsStale(md vetypes.PriceMetadata, now time.Time, maxAge time.Duration) bool {
+	observedAt := time.UnixMilli(md.Timestamp)
+	if observedAt.After(now.Add(connectabci.MaximumTimestampSkew)) {
+		return true
+	}
+
+	if maxAge <= 0 {
+		return false
+	}
+
+	return now.Sub(observedAt) > maxAge
+}
+
+// ConfidenceWeight returns the vote weight of a price scaled by its confidence. The confidence
+// of a price calculated from n provider prices with a dispersion of d basis points is
+//
+//	n / (n + 1) * 10000 / (10000 + d)
+//
+// so that prices calculated from more providers that agree with each other carry more weight.
+// The confidence is self-reported, so it is bounded below by 1 / maxConfidenceShift: a validator
+// can shift the weight of its price by at most a factor of maxConfidenceShift, and never beyond
+// its vote weight.
+func ConfidenceWeight(voteWeight math.Int, md vetypes.PriceMetadata) math.Int {
+	providers := math.NewInt(int64(max(md.ProviderCount, 1)))
+
+	numerator := voteWeight.Mul(providers).MulRaw(basisPoints)
+	denominator := providers.AddRaw(1).MulRaw(basisPoints + int64(md.Dispersion))
+
+	return math.MaxInt(numerator.Quo(denominator), voteWeight.QuoRaw(maxConfidenceShift))
+}
@@ -3,11 +3,13 @@ package voteweighted
 import (
 	"math/big"
 	"sort"
+	"time"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	"github.com/skip-mev/connect/v2/aggregator"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 )
@@ -23,6 +25,10 @@ type (
 	PriceInfo struct {
 		Prices      []PricePerValidator
 		TotalWeight math.Int
+		// TotalStake is the total stake of the validators that submitted a price, which is
+		// checked against the power threshold. This differs from TotalWeight if prices are
+		// weighted by their confidence.
+		TotalStake math.Int
 	}
 
 	// VoteWeightPrice defines a price update that includes the stake weight of the validator.
@@ -32,15 +38,34 @@ type (
 	}
 )
 
+// MedianOption is a function that enables optional configuration of the Median aggregate
+// function.
+type MedianOption func(*medianOptions)
+
+type medianOptions struct {
+	paramsKeeper OracleParamsKeeper
+}
+
+// WithParamsKeeper returns a MedianOption that configures Median to read the x/oracle module's
+// params from the given keeper. If extended vote extensions are enabled in the params, prices
+// that are older than the max price age are discarded, and the remaining prices are weighted by
+// their confidence.
+func WithParamsKeeper(keeper OracleParamsKeeper) MedianOption {
+	return func(o *medianOptions) {
+		o.paramsKeeper = keeper
+	}
+}
+
 // MedianFromContext returns a new Median aggregate function that is parametrized by the
 // latest state of the application.
 func MedianFromContext(
 	logger log.Logger,
 	validatorStore ValidatorStore,
 	threshold math.LegacyDec,
+	opts ...MedianOption,
 ) aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int] {
 	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
-		return Median(ctx, logger, validatorStore, threshold)
+		return Median(ctx, logger, validatorStore, threshold, opts...)
 	}
 }
 
@@ -56,15 +81,32 @@ func MedianFromContext(
 //     price will not be included in the final set of oracle prices.
 //  3. Given the threshold is met, the final oracle price for a given currency pair is the
 //     median price weighted by the stake of each validator that submitted a price.
+//  4. If extended vote extensions are enabled in the x/oracle module's params, prices that were
+//     observed longer than the max price age before the block time are discarded, and each
+//     price is weighted by the stake of the validator scaled by the price's confidence. The
+//     metadata of the prices is read from the context, see ContextWithPriceMetadata.
 func Median(
 	ctx sdk.Context,
 	logger log.Logger,
 	validatorStore ValidatorStore,
 	threshold math.LegacyDec,
+	opts ...MedianOption,
 ) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+	var o medianOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return func(providers aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
 		priceInfo := make(map[connecttypes.CurrencyPair]PriceInfo)
 
+		// Determine whether the metadata of extended vote extensions is used.
+		var metadata PriceMetadata
+		extended, maxPriceAge := extendedVoteExtensionParams(ctx, logger, o.paramsKeeper)
+		if extended {
+			metadata = PriceMetadataFromContext(ctx)
+		}
+
 		// Iterate through all providers and store stake weight + price for each currency pair.
 		for valAddress, validatorPrices := range providers {
 			// Retrieve the validator from the validator store and get its vote weight.
@@ -105,11 +147,34 @@ func Median(
 					continue
 				}
 
+				// Discard stale prices and weight the remaining prices by their confidence. Prices
+				// without metadata are weighted like prices calculated from a single provider.
+				priceWeight := voteWeight
+				if extended {
+					md, ok := metadata[valAddress][currencyPair]
+					if !ok {
+						md = vetypes.PriceMetadata{ProviderCount: 1}
+					} else if IsStale(md, ctx.BlockTime(), maxPriceAge) {
+						logger.Debug(
+							"price is stale; skipping price",
+							"currency_pair", currencyPair.String(),
+							"validator_address", valAddress,
+							"observed_at", time.UnixMilli(md.Timestamp).UTC().String(),
+							"max_price_age", maxPriceAge.String(),
+						)
+
+						continue
+					}
+
+					priceWeight = ConfidenceWeight(voteWeight, md)
+				}
+
 				// Initialize the price info if it does not exist for the given currency pair.
 				if _, ok := priceInfo[currencyPair]; !ok {
 					priceInfo[currencyPair] = PriceInfo{
 						Prices:      make([]PricePerValidator, 0),
 						TotalWeight: math.ZeroInt(),
+						TotalStake:  math.ZeroInt(),
 					}
 				}
 
@@ -117,10 +182,11 @@ func Median(
 				cpInfo := priceInfo[currencyPair]
 				priceInfo[currencyPair] = PriceInfo{
 					Prices: append(cpInfo.Prices, PricePerValidator{
-						VoteWeight: voteWeight,
+						VoteWeight: priceWeight,
 						Price:      price,
 					}),
-					TotalWeight: cpInfo.TotalWeight.Add(voteWeight),
+					TotalWeight: cpInfo.TotalWeight.Add(priceWeight),
+					TotalStake:  cpInfo.TotalStake.Add(voteWeight),
 				}
 			}
 		}
@@ -136,7 +202,7 @@ func Median(
 		for currencyPair, info := range priceInfo {
 			// The total voting power % that submitted a price update for the given currency pair must be
 			// greater than the threshold to be included in the final oracle price.
-			if percentSubmitted := math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens)); percentSubmitted.GTE(threshold) {
+			if percentSubmitted := math.LegacyNewDecFromInt(info.TotalStake).Quo(math.LegacyNewDecFromInt(totalBondedTokens)); percentSubmitted.GTE(threshold) {
 				prices[currencyPair] = ComputeMedian(info)
 
 				logger.Debug(
@@ -162,6 +228,30 @@ func Median(
 	}
 }
 
+// extendedVoteExtensionParams returns whether extended vote extensions are enabled, and the max
+// age of their prices. Extended vote extensions are disabled if no params keeper is configured.
+func extendedVoteExtensionParams(
+	ctx sdk.Context,
+	logger log.Logger,
+	paramsKeeper OracleParamsKeeper,
+) (bool, time.Duration) {
+	if paramsKeeper == nil {
+		return false, 0
+	}
+
+	params, err := paramsKeeper.GetParams(ctx)
+	if err != nil {
+		logger.Error(
+			"failed to get oracle params; not using price metadata",
+			"err", err,
+		)
+
+		return false, 0
+	}
+
+	return params.ExtendedVoteExtensionsEnabled, params.MaxPriceAge
+}
+
 // ComputeMedian computes the stake-weighted median price for a given asset.
 func ComputeMedian(priceInfo PriceInfo) *big.Int {
 	// Sort the prices by price.
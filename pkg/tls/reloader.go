@@ -0,0 +1,176 @@
+package tls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+// reloader holds a value that is loaded from a set of files, and reloads the value when any of
+// the files is modified. The files are checked for modifications at most once per interval,
+// when the value is requested. If reloading fails, e.g. because the files are in the middle of
+// being replaced, the previously loaded value is used until a later check succeeds.
+type reloader[T any] struct {
+	mtx sync.Mutex
+
+	// files are the files the value is loaded from.
+	files []string
+	// load loads the value from the files.
+	load func() (T, error)
+	// interval is the minimum time between checks for modifications. If this is zero, the
+	// value is never reloaded.
+	interval time.Duration
+
+	// value is the most recently loaded value.
+	value T
+	// modTimes are the modification times of the files when the value was loaded.
+	modTimes []time.Time
+	// lastCheck is the time the files were last checked for modifications.
+	lastCheck time.Time
+}
+
+// newReloader returns a new reloader, returning an error if the value cannot be loaded.
+func newReloader[T any](files []string, interval time.Duration, load func() (T, error)) (*reloader[T], error) {
+	r := &reloader[T]{
+		files:    files,
+		load:     load,
+		interval: interval,
+	}
+
+	modTimes, err := r.readModTimes()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	r.value, r.modTimes, r.lastCheck = value, modTimes, time.Now()
+	return r, nil
+}
+
+// Get returns the current value, reloading it first if the files have been modified since it
+// was last loaded.
+func (r *reloader[T]) Get() T {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.interval <= 0 || time.Since(r.lastCheck) < r.interval {
+		return r.value
+	}
+	r.lastCheck = time.Now()
+
+	modTimes, err := r.readModTimes()
+	if err != nil || slices.EqualFunc(modTimes, r.modTimes, time.Time.Equal) {
+		return r.value
+	}
+
+	// the modification times are read before loading, so that files modified while loading
+	// are reloaded on the next check.
+	value, err := r.load()
+	if err != nil {
+		return r.value
+	}
+
+	r.value, r.modTimes = value, modTimes
+	return r.value
+}
+
+// readModTimes returns the modification times of the files.
+func (r *reloader[T]) readModTimes() ([]time.Time, error) {
+	modTimes := make([]time.Time, len(r.files))
+	for i, file := range r.files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+
+		modTimes[i] = info.ModTime()
+	}
+
+	return modTimes, nil
+}
+
+// CertReloader serves a certificate and private key loaded from PEM encoded files, and reloads
+// them when either file is modified. This allows certificates to be rotated without restarting
+// the server or client using them.
+type CertReloader struct {
+	r *reloader[*tls.Certificate]
+}
+
+// NewCertReloader returns a new CertReloader for the given certificate and key files. The files
+// are checked for modifications at most once per interval; if the interval is zero, the
+// certificate is never reloaded. An error is returned if the certificate cannot be loaded.
+func NewCertReloader(certFile, keyFile string, interval time.Duration) (*CertReloader, error) {
+	r, err := newReloader([]string{certFile, keyFile}, interval, func() (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		return &cert, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate %s: %w", certFile, err)
+	}
+
+	return &CertReloader{r: r}, nil
+}
+
+// GetCertificate returns the current certificate. It can be used as the GetCertificate callback
+// of a server's tls.Config.
+func (c *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return c.r.Get(), nil
+}
+
+// GetClientCertificate returns the current certificate. It can be used as the
+// GetClientCertificate callback of a client's tls.Config.
+func (c *CertReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return c.r.Get(), nil
+}
+
+// CertPoolReloader serves a pool of certificate authorities loaded from a PEM encoded file, and
+// reloads it when the file is modified.
+type CertPoolReloader struct {
+	r *reloader[*x509.CertPool]
+}
+
+// NewCertPoolReloader returns a new CertPoolReloader for the given file. The file is checked for
+// modifications at most once per interval; if the interval is zero, the pool is never reloaded.
+// An error is returned if the pool cannot be loaded.
+func NewCertPoolReloader(file string, interval time.Duration) (*CertPoolReloader, error) {
+	r, err := newReloader([]string{file}, interval, func() (*x509.CertPool, error) {
+		return LoadCertPool(file)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertPoolReloader{r: r}, nil
+}
+
+// CertPool returns the current pool of certificate authorities.
+func (c *CertPoolReloader) CertPool() *x509.CertPool {
+	return c.r.Get()
+}
+
+// LoadCertPool returns a pool of the PEM encoded certificates in the given file.
+func LoadCertPool(file string) (*x509.CertPool, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certificate authorities %s: %w", file, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("no certificates found in %s", file)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,161 @@
+package tls_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	connecttls "github.com/skip-mev/connect/v2/pkg/tls"
+	"github.com/skip-mev/connect/v2/pkg/tls/testutils"
+)
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	ca := testutils.NewCA(t, "test")
+	certFile, keyFile := ca.Issue(t, dir, "server")
+
+	// commonName returns the common name of the reloader's current certificate.
+	commonName := func(t *testing.T, r *connecttls.CertReloader) string {
+		t.Helper()
+
+		cert, err := r.GetCertificate(nil)
+		require.NoError(t, err)
+
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		require.NoError(t, err)
+		return leaf.Subject.CommonName
+	}
+
+	// rotate replaces the certificate and key files with a newly issued certificate, and bumps
+	// their modification times so the change is detected regardless of the file system's
+	// timestamp resolution.
+	rotate := func(t *testing.T, name string, modTime time.Time) {
+		t.Helper()
+
+		newCert, newKey := ca.Issue(t, t.TempDir(), name)
+		for src, dst := range map[string]string{newCert: certFile, newKey: keyFile} {
+			bz, err := os.ReadFile(src)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(dst, bz, 0o600))
+			require.NoError(t, os.Chtimes(dst, modTime, modTime))
+		}
+	}
+
+	t.Run("fails if the certificate cannot be loaded", func(t *testing.T) {
+		_, err := connecttls.NewCertReloader(filepath.Join(dir, "missing.pem"), keyFile, 0)
+		require.Error(t, err)
+
+		_, err = connecttls.NewCertReloader(certFile, certFile, 0)
+		require.Error(t, err)
+	})
+
+	t.Run("reloads the certificate once it is modified", func(t *testing.T) {
+		r, err := connecttls.NewCertReloader(certFile, keyFile, time.Nanosecond)
+		require.NoError(t, err)
+		require.Equal(t, "server", commonName(t, r))
+
+		rotate(t, "rotated", time.Now().Add(time.Minute))
+		require.Equal(t, "rotated", commonName(t, r))
+	})
+
+	t.Run("keeps the previous certificate if reloading fails", func(t *testing.T) {
+		r, err := connecttls.NewCertReloader(certFile, keyFile, time.Nanosecond)
+		require.NoError(t, err)
+		expected := commonName(t, r)
+
+		modTime := time.Now().Add(2 * time.Minute)
+		require.NoError(t, os.WriteFile(certFile, []byte("invalid"), 0o600))
+		require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+		require.Equal(t, expected, commonName(t, r))
+
+		// the certificate is reloaded once the files are valid again
+		rotate(t, "recovered", modTime.Add(time.Minute))
+		require.Equal(t, "recovered", commonName(t, r))
+	})
+
+	t.Run("does not reload the certificate without an interval", func(t *testing.T) {
+		r, err := connecttls.NewCertReloader(certFile, keyFile, 0)
+		require.NoError(t, err)
+		expected := commonName(t, r)
+
+		rotate(t, "ignored", time.Now().Add(time.Hour))
+		require.Equal(t, expected, commonName(t, r))
+	})
+}
+
+func TestLoadCertPool(t *testing.T) {
+	dir := t.TempDir()
+	ca := testutils.NewCA(t, "test")
+
+	pool, err := connecttls.LoadCertPool(ca.WriteCert(t, dir))
+	require.NoError(t, err)
+	require.NotNil(t, pool)
+
+	_, err = connecttls.LoadCertPool(filepath.Join(dir, "missing.pem"))
+	require.Error(t, err)
+
+	invalid := filepath.Join(dir, "invalid.pem")
+	require.NoError(t, os.WriteFile(invalid, []byte("invalid"), 0o600))
+	_, err = connecttls.LoadCertPool(invalid)
+	require.Error(t, err)
+}
+
+func TestVerifyAllowedNames(t *testing.T) {
+	dir := t.TempDir()
+	ca := testutils.NewCA(t, "test")
+	certFile, keyFile := ca.Issue(t, dir, "validator-1", "validator-1.example.com")
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		allowed []string
+		state   tls.ConnectionState
+		expErr  bool
+	}{
+		{
+			name:  "accepts every peer without an allowed list",
+			state: tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}},
+		},
+		{
+			name:    "accepts a peer by common name",
+			allowed: []string{"validator-0", "validator-1"},
+			state:   tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}},
+		},
+		{
+			name:    "accepts a peer by dns name",
+			allowed: []string{"validator-1.example.com"},
+			state:   tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}},
+		},
+		{
+			name:    "rejects a peer that is not allowed",
+			allowed: []string{"validator-2"},
+			state:   tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}},
+			expErr:  true,
+		},
+		{
+			name:    "rejects a peer without a certificate",
+			allowed: []string{"validator-1"},
+			expErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := connecttls.VerifyAllowedNames(tc.allowed)(tc.state)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
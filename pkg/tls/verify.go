@@ -0,0 +1,35 @@
+package tls
+
+import (
+	"crypto/tls"
+	"fmt"
+	"slices"
+)
+
+// VerifyAllowedNames returns a callback, to be used as the VerifyConnection callback of a
+// tls.Config, that only accepts peers whose certificate has a common name or DNS name in the
+// allowed list. The callback runs after the peer's certificate chain has been verified. If the
+// allowed list is empty, every peer with a verified certificate is accepted.
+func VerifyAllowedNames(allowed []string) func(tls.ConnectionState) error {
+	return func(state tls.ConnectionState) error {
+		if len(allowed) == 0 {
+			return nil
+		}
+
+		if len(state.PeerCertificates) == 0 {
+			return fmt.Errorf("peer did not present a certificate")
+		}
+
+		leaf := state.PeerCertificates[0]
+		if slices.Contains(allowed, leaf.Subject.CommonName) {
+			return nil
+		}
+		for _, name := range leaf.DNSNames {
+			if slices.Contains(allowed, name) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("peer certificate %q is not in the allowed list", leaf.Subject.CommonName)
+	}
+}
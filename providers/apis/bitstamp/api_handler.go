@@ -3,6 +3,7 @@ package bitstamp
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/http"
 	"time"
 
@@ -101,6 +102,13 @@ func (h *APIHandler) ParseResponse(
 			continue
 		}
 
+		// Weight the price by the 24h quote volume, i.e. the 24h base volume valued at the price.
+		if volume, err := math.Float64StringToBigFloat(feed.Volume); err == nil && volume.Sign() > 0 {
+			weight := new(big.Float).Mul(volume, price)
+			resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), weight)
+			continue
+		}
+
 		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 	}
 
@@ -108,7 +108,8 @@ func TestParseResponse(t *testing.T) {
 			expected: types.NewPriceResponse(
 				types.ResolvedPrices{
 					btcusd: {
-						Value: big.NewFloat(2211.00),
+						Value:  big.NewFloat(2211.00),
+						Weight: big.NewFloat(213.26801100 * 2211.00),
 					},
 				},
 				types.UnResolvedPrices{},
@@ -159,10 +160,36 @@ func TestParseResponse(t *testing.T) {
 			expected: types.NewPriceResponse(
 				types.ResolvedPrices{
 					btcusd: {
-						Value: big.NewFloat(2211.00),
+						Value:  big.NewFloat(2211.00),
+						Weight: big.NewFloat(213.26801100 * 2211.00),
 					},
 					ethusd: {
-						Value: big.NewFloat(420.69),
+						Value:  big.NewFloat(420.69),
+						Weight: big.NewFloat(213.26801100 * 420.69),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name: "valid single without volume is not weighted",
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+[
+	{
+		"last": "2211.00",
+		"pair": "BTC/USD"
+	}
+]
+				`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd: {
+						Value: big.NewFloat(2211.00),
 					},
 				},
 				types.UnResolvedPrices{},
@@ -262,6 +289,11 @@ func TestParseResponse(t *testing.T) {
 				require.Contains(t, resp.Resolved, cp)
 				r := resp.Resolved[cp]
 				require.Equal(t, result.Value.SetPrec(18), r.Value.SetPrec(18))
+				if result.Weight == nil {
+					require.Nil(t, r.Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), r.Weight.SetPrec(18))
+				}
 				require.True(t, r.Timestamp.After(now))
 			}
 
@@ -60,6 +60,7 @@ type MarketTickerResponse []MarketTickerData
 
 // MarketTickerData is the data returned by the Bitstamp API.
 type MarketTickerData struct {
-	Last string `json:"last"`
-	Pair string `json:"pair"`
+	Last   string `json:"last"`
+	Pair   string `json:"pair"`
+	Volume string `json:"volume"`
 }
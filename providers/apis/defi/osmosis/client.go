@@ -34,6 +34,9 @@ type Client interface {
 		baseAsset,
 		quoteAsset string,
 	) (WrappedSpotPriceResponse, error)
+	PoolLiquidity(ctx context.Context,
+		poolID uint64,
+	) (WrappedPoolLiquidityResponse, error)
 }
 
 // ClientImpl is an implementation of a client to Osmosis using a
@@ -118,6 +121,45 @@ func (c *ClientImpl) SpotPrice(ctx context.Context, poolID uint64, baseAsset, qu
 	}, nil
 }
 
+// PoolLiquidity uses the underlying x/poolmanager client to access the total liquidity of a pool.
+func (c *ClientImpl) PoolLiquidity(ctx context.Context, poolID uint64) (WrappedPoolLiquidityResponse, error) {
+	start := time.Now()
+	defer func() {
+		c.apiMetrics.ObserveProviderResponseLatency(c.api.Name, c.redactedURL, time.Since(start))
+	}()
+
+	url, err := CreateLiquidityURL(c.endpoint.URL, poolID)
+	if err != nil {
+		return WrappedPoolLiquidityResponse{}, err
+	}
+
+	resp, err := c.httpClient.GetWithContext(ctx, url)
+	if err != nil {
+		return WrappedPoolLiquidityResponse{}, err
+	}
+
+	c.apiMetrics.AddHTTPStatusCode(c.api.Name, resp)
+
+	var blockHeight uint64
+	heightStr := resp.Header.Get(headerBlockHeight)
+	if heightStr != "" {
+		blockHeight, err = strconv.ParseUint(heightStr, 10, 64)
+		if err != nil {
+			return WrappedPoolLiquidityResponse{}, fmt.Errorf("failed to parse block height: %w", err)
+		}
+	}
+
+	var poolLiquidityResponse PoolLiquidityResponse
+	if err := json.NewDecoder(resp.Body).Decode(&poolLiquidityResponse); err != nil {
+		return WrappedPoolLiquidityResponse{}, err
+	}
+
+	return WrappedPoolLiquidityResponse{
+		PoolLiquidityResponse: poolLiquidityResponse,
+		BlockHeight:           blockHeight,
+	}, nil
+}
+
 // MultiClientImpl is an Osmosis client that wraps a set of multiple Clients.
 type MultiClientImpl struct {
 	logger     *zap.Logger
@@ -256,3 +298,57 @@ func (mc *MultiClientImpl) filterSpotPriceResponses(responses []WrappedSpotPrice
 
 	return responses[highestHeightIndex], nil
 }
+
+// PoolLiquidity delegates the request to all underlying clients and applies a filter to the
+// set of responses.
+func (mc *MultiClientImpl) PoolLiquidity(ctx context.Context, poolID uint64) (WrappedPoolLiquidityResponse, error) {
+	resps := make([]WrappedPoolLiquidityResponse, len(mc.clients))
+
+	var wg sync.WaitGroup
+	wg.Add(len(mc.clients))
+
+	for i := range mc.clients {
+		url := mc.api.Endpoints[i].URL
+
+		go func(index int, client Client) {
+			defer wg.Done()
+			resp, err := client.PoolLiquidity(ctx, poolID)
+			if err != nil {
+				mc.logger.Error("failed to get pool liquidity in sub client", zap.String("url", url), zap.Error(err))
+				return
+			}
+
+			mc.logger.Debug("successfully fetched pool liquidity", zap.String("url", url))
+
+			resps[index] = resp
+		}(i, mc.clients[i])
+	}
+
+	wg.Wait()
+
+	return mc.filterPoolLiquidityResponses(resps)
+}
+
+// filterPoolLiquidityResponses chooses the response with the highest block height.
+func (mc *MultiClientImpl) filterPoolLiquidityResponses(responses []WrappedPoolLiquidityResponse) (WrappedPoolLiquidityResponse, error) {
+	if len(responses) == 0 {
+		return WrappedPoolLiquidityResponse{}, fmt.Errorf("no responses found")
+	}
+
+	highestHeight := uint64(0)
+	highestHeightIndex := 0
+
+	for i, resp := range responses {
+		if resp.BlockHeight > highestHeight {
+			highestHeight = resp.BlockHeight
+			highestHeightIndex = i
+		}
+	}
+
+	// check the block height
+	if valid := mc.blockAgeChecker.IsHeightValid(highestHeight); !valid {
+		return WrappedPoolLiquidityResponse{}, fmt.Errorf("height %d is stale and older than %d", highestHeight, mc.api.MaxBlockHeightAge)
+	}
+
+	return responses[highestHeightIndex], nil
+}
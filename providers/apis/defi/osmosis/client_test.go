@@ -127,4 +127,31 @@ func TestMultiClient(t *testing.T) {
 
 		require.Equal(t, expectedPrice, resp.SpotPrice)
 	})
+	// test that the pool liquidity of the highest block height is used
+	t.Run("test pool liquidity of the highest block height", func(t *testing.T) {
+		var poolID uint64 = 1
+
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+
+		stale := osmosis.PoolLiquidityResponse{Liquidity: []osmosis.Coin{{Denom: "test1", Amount: "1"}}}
+		latest := osmosis.PoolLiquidityResponse{Liquidity: []osmosis.Coin{{Denom: "test1", Amount: "2"}}}
+
+		// mocks
+		client1.On("PoolLiquidity", mock.Anything, poolID).Return(osmosis.WrappedPoolLiquidityResponse{
+			PoolLiquidityResponse: stale,
+			BlockHeight:           1,
+		}, nil).Once()
+		client2.On("PoolLiquidity", mock.Anything, poolID).Return(osmosis.WrappedPoolLiquidityResponse{
+			PoolLiquidityResponse: latest,
+			BlockHeight:           2,
+		}, nil).Once()
+		client3.On("PoolLiquidity", mock.Anything, poolID).Return(osmosis.WrappedPoolLiquidityResponse{},
+			fmt.Errorf("error")).Once()
+
+		resp, err := client.PoolLiquidity(ctx, poolID)
+		require.NoError(t, err)
+
+		require.Equal(t, latest, resp.PoolLiquidityResponse)
+	})
 }
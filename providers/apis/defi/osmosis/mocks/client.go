@@ -23,6 +23,63 @@ func (_m *Client) EXPECT() *Client_Expecter {
 	return &Client_Expecter{mock: &_m.Mock}
 }
 
+// PoolLiquidity provides a mock function with given fields: ctx, poolID
+func (_m *Client) PoolLiquidity(ctx context.Context, poolID uint64) (osmosis.WrappedPoolLiquidityResponse, error) {
+	ret := _m.Called(ctx, poolID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PoolLiquidity")
+	}
+
+	var r0 osmosis.WrappedPoolLiquidityResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (osmosis.WrappedPoolLiquidityResponse, error)); ok {
+		return rf(ctx, poolID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) osmosis.WrappedPoolLiquidityResponse); ok {
+		r0 = rf(ctx, poolID)
+	} else {
+		r0 = ret.Get(0).(osmosis.WrappedPoolLiquidityResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, poolID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_PoolLiquidity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PoolLiquidity'
+type Client_PoolLiquidity_Call struct {
+	*mock.Call
+}
+
+// PoolLiquidity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - poolID uint64
+func (_e *Client_Expecter) PoolLiquidity(ctx interface{}, poolID interface{}) *Client_PoolLiquidity_Call {
+	return &Client_PoolLiquidity_Call{Call: _e.mock.On("PoolLiquidity", ctx, poolID)}
+}
+
+func (_c *Client_PoolLiquidity_Call) Run(run func(ctx context.Context, poolID uint64)) *Client_PoolLiquidity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *Client_PoolLiquidity_Call) Return(_a0 osmosis.WrappedPoolLiquidityResponse, _a1 error) *Client_PoolLiquidity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_PoolLiquidity_Call) RunAndReturn(run func(context.Context, uint64) (osmosis.WrappedPoolLiquidityResponse, error)) *Client_PoolLiquidity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SpotPrice provides a mock function with given fields: ctx, poolID, baseAsset, quoteAsset
 func (_m *Client) SpotPrice(ctx context.Context, poolID uint64, baseAsset string, quoteAsset string) (osmosis.WrappedSpotPriceResponse, error) {
 	ret := _m.Called(ctx, poolID, baseAsset, quoteAsset)
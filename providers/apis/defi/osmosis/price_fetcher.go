@@ -3,6 +3,7 @@ package osmosis
 import (
 	"context"
 	"fmt"
+	gomath "math"
 	"math/big"
 	"sync"
 	"time"
@@ -108,6 +109,8 @@ func NewAPIPriceFetcherWithClient(
 // Fetch fetches prices from the osmosis API for the given currency-pairs. Specifically
 // for each currency-pair,
 //   - Query the spot price.
+//   - Query the total liquidity of the pool, which weights the price. If the liquidity
+//     cannot be retrieved, the price is returned without a weight.
 func (pf *APIPriceFetcher) Fetch(
 	ctx context.Context,
 	tickers []oracletypes.ProviderTicker,
@@ -129,10 +132,10 @@ func (pf *APIPriceFetcher) Fetch(
 		}
 	}
 
-	resolvedTickerCallback := func(ticker oracletypes.ProviderTicker, price *big.Float) {
+	resolvedTickerCallback := func(ticker oracletypes.ProviderTicker, price, weight *big.Float) {
 		resolveMtx.Lock()
 		defer resolveMtx.Unlock()
-		resolved[ticker] = oracletypes.NewPriceResult(price, time.Now().UTC())
+		resolved[ticker] = oracletypes.NewPriceResultWithWeight(price, time.Now().UTC(), weight)
 	}
 
 	pf.logger.Info("fetching for tickers", zap.Any("tickers", tickers))
@@ -192,7 +195,21 @@ func (pf *APIPriceFetcher) Fetch(
 				return nil
 			}
 
-			resolvedTickerCallback(ticker, price)
+			// weight the price by the quote-denominated liquidity of the pool
+			var weight *big.Float
+			liquidity, err := pf.client.PoolLiquidity(callCtx, metadata.PoolID)
+			if err == nil {
+				weight, err = calculateLiquidity(liquidity, metadata, price)
+			}
+			if err != nil {
+				pf.logger.Debug(
+					"failed to get pool liquidity; price is not weighted",
+					zap.String("ticker", ticker.String()),
+					zap.Error(err),
+				)
+			}
+
+			resolvedTickerCallback(ticker, price, weight)
 
 			return nil
 		})
@@ -206,3 +223,45 @@ func (pf *APIPriceFetcher) Fetch(
 func calculatePrice(resp WrappedSpotPriceResponse) (*big.Float, error) {
 	return math.Float64StringToBigFloat(resp.SpotPrice)
 }
+
+// calculateLiquidity returns the liquidity of the pool's base and quote tokens denominated in
+// the quote token. The base token is valued at the spot price of the pool.
+func calculateLiquidity(
+	resp WrappedPoolLiquidityResponse,
+	metadata TickerMetadata,
+	price *big.Float,
+) (*big.Float, error) {
+	var base, quote *big.Float
+	for _, coin := range resp.Liquidity {
+		if coin.Denom != metadata.BaseTokenDenom && coin.Denom != metadata.QuoteTokenDenom {
+			continue
+		}
+
+		amount, err := math.Float64StringToBigFloat(coin.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse liquidity of %s: %w", coin.Denom, err)
+		}
+
+		if coin.Denom == metadata.BaseTokenDenom {
+			base = amount
+		} else {
+			quote = amount
+		}
+	}
+
+	if base == nil || quote == nil {
+		return nil, fmt.Errorf("pool liquidity does not include %s and %s", metadata.BaseTokenDenom, metadata.QuoteTokenDenom)
+	}
+
+	decimals := metadata.GetQuoteTokenDecimals()
+	if decimals > gomath.MaxInt64 {
+		decimals = gomath.MaxInt64
+	}
+
+	//nolint:gosec // handled above
+	scalingFactor := math.GetScalingFactor(0, int64(decimals))
+
+	liquidity := new(big.Float).Mul(base, price)
+	liquidity.Add(liquidity, quote)
+	return liquidity.Mul(liquidity, scalingFactor), nil
+}
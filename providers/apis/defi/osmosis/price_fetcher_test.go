@@ -167,10 +167,12 @@ func TestProviderFetch(t *testing.T) {
 		BaseTokenDenom:  ETHTokenDenom,
 		QuoteTokenDenom: USDTTokenDenom,
 	}
+	solDecimals := uint64(9)
 	mogSOLMetadata := osmosis.TickerMetadata{
-		PoolID:          MOGSOLPoolID,
-		BaseTokenDenom:  MOGTokenDenom,
-		QuoteTokenDenom: SOLTokenDenom,
+		PoolID:             MOGSOLPoolID,
+		BaseTokenDenom:     MOGTokenDenom,
+		QuoteTokenDenom:    SOLTokenDenom,
+		QuoteTokenDecimals: &solDecimals,
 	}
 
 	var (
@@ -209,11 +211,52 @@ func TestProviderFetch(t *testing.T) {
 			},
 		}, nil).Once()
 
+		client.On("PoolLiquidity", mock.Anything, btcUSDTMetadata.PoolID).Return(osmosis.WrappedPoolLiquidityResponse{
+			PoolLiquidityResponse: osmosis.PoolLiquidityResponse{
+				Liquidity: []osmosis.Coin{
+					{Denom: BTCTokenDenom, Amount: "2000000"},
+					{Denom: USDTTokenDenom, Amount: "30000000"},
+				},
+			},
+		}, nil).Once()
+
 		ts := defaultTickersToProviderTickers([]types.DefaultProviderTicker{tickers[0]})
 		resp := pf.Fetch(ctx, ts)
 		// expect a failed response
 		require.Equal(t, 1, len(resp.Resolved))
 		require.Equal(t, 0, len(resp.UnResolved))
+
+		// the price is weighted by the pool's liquidity in the quote token
+		weight, _ := resp.Resolved[ts[0]].Weight.Float64()
+		require.InDelta(t, 50, weight, 1e-9)
+	})
+
+	t.Run("single valid ticker without pool liquidity", func(t *testing.T) {
+		client := mocks.NewClient(t)
+		pf, err := newPriceFetcher(client)
+		require.NoError(t, err)
+
+		ctx := context.Background()
+
+		client.On("SpotPrice", mock.Anything, btcUSDTMetadata.PoolID, btcUSDTMetadata.BaseTokenDenom,
+			btcUSDTMetadata.QuoteTokenDenom,
+		).Return(osmosis.WrappedSpotPriceResponse{
+			SpotPriceResponse: osmosis.SpotPriceResponse{
+				SpotPrice: expectedBTCUSDTPrice,
+			},
+		}, nil).Once()
+
+		client.On("PoolLiquidity", mock.Anything, btcUSDTMetadata.PoolID).Return(
+			osmosis.WrappedPoolLiquidityResponse{}, fmt.Errorf("error"),
+		).Once()
+
+		ts := defaultTickersToProviderTickers([]types.DefaultProviderTicker{tickers[0]})
+		resp := pf.Fetch(ctx, ts)
+
+		// the price is resolved without a weight
+		require.Equal(t, 1, len(resp.Resolved))
+		require.Equal(t, 0, len(resp.UnResolved))
+		require.Nil(t, resp.Resolved[ts[0]].Weight)
 	})
 
 	t.Run("failing query", func(t *testing.T) {
@@ -293,6 +336,10 @@ func TestProviderFetch(t *testing.T) {
 			SpotPriceResponse: osmosis.SpotPriceResponse{SpotPrice: expectedMOGSOLPRICE},
 		}, nil).Once()
 
+		client.On("PoolLiquidity", mock.Anything, mock.Anything).Return(
+			osmosis.WrappedPoolLiquidityResponse{}, fmt.Errorf("error"),
+		).Twice()
+
 		ts := defaultTickersToProviderTickers(tickers)
 		resp := pf.Fetch(ctx, ts)
 
@@ -330,12 +377,30 @@ func TestProviderFetch(t *testing.T) {
 			SpotPriceResponse: osmosis.SpotPriceResponse{SpotPrice: expectedMOGSOLPRICE},
 		}, nil).Once()
 
+		// the liquidity of the MOG/SOL pool is denominated in SOL with 9 decimals
+		for _, metadata := range []osmosis.TickerMetadata{btcUSDTMetadata, ethUSDTMetadata, mogSOLMetadata} {
+			client.On("PoolLiquidity", mock.Anything, metadata.PoolID).Return(osmosis.WrappedPoolLiquidityResponse{
+				PoolLiquidityResponse: osmosis.PoolLiquidityResponse{
+					Liquidity: []osmosis.Coin{
+						{Denom: metadata.BaseTokenDenom, Amount: "1000000000"},
+						{Denom: metadata.QuoteTokenDenom, Amount: "1000000000"},
+					},
+				},
+			}, nil).Once()
+		}
+
 		ts := defaultTickersToProviderTickers(tickers)
 		resp := pf.Fetch(ctx, ts)
 
 		// expect a failed response
 		require.Equal(t, 3, len(resp.Resolved))
 		require.Equal(t, 0, len(resp.UnResolved))
+
+		expectedWeights := []float64{11000, 12000, 13}
+		for i, ticker := range ts {
+			weight, _ := resp.Resolved[ticker].Weight.Float64()
+			require.InDelta(t, expectedWeights[i], weight, 1e-9)
+		}
 	})
 }
 
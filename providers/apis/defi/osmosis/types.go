@@ -13,10 +13,15 @@ import (
 )
 
 const (
-	Name              = "osmosis_api"
-	QueryURLCharacter = "?"
-	URLSeparator      = "/"
-	URLSuffix         = "osmosis/poolmanager/v2/pools/%s/prices%sbase_asset_denom=%s&quote_asset_denom=%s"
+	Name               = "osmosis_api"
+	QueryURLCharacter  = "?"
+	URLSeparator       = "/"
+	URLSuffix          = "osmosis/poolmanager/v2/pools/%s/prices%sbase_asset_denom=%s&quote_asset_denom=%s"
+	LiquidityURLSuffix = "osmosis/poolmanager/v1beta1/pools/%s/total_pool_liquidity"
+
+	// DefaultTokenDecimals is the number of decimals assumed for a quote token if none is
+	// configured in the ticker's metadata. This is the convention for most Cosmos SDK denoms.
+	DefaultTokenDecimals uint64 = 6
 )
 
 // CreateURL creates the properly formatted osmosis query URL for spot price.
@@ -30,6 +35,18 @@ func CreateURL(baseURL string, poolID uint64, baseAsset, quoteAsset string) (str
 	), nil
 }
 
+// CreateLiquidityURL creates the properly formatted osmosis query URL for the total liquidity
+// of a pool.
+func CreateLiquidityURL(baseURL string, poolID uint64) (string, error) {
+	return strings.Join(
+		[]string{
+			baseURL,
+			fmt.Sprintf(LiquidityURLSuffix, strconv.FormatUint(poolID, 10)),
+		},
+		URLSeparator,
+	), nil
+}
+
 // NoOsmosisMetadataForTickerError is returned when there is no metadata associated with a given ticker.
 func NoOsmosisMetadataForTickerError(ticker string) error {
 	return fmt.Errorf("no osmosis metadata for ticker: %s", ticker)
@@ -89,6 +106,20 @@ type TickerMetadata struct {
 
 	// QuoteTokenDenom is the identifier (on osmosis) of the quote token.
 	QuoteTokenDenom string `json:"quote_token_denom"`
+
+	// QuoteTokenDecimals is the number of decimals of the quote token. This is used to
+	// denominate the liquidity of the pool, which weights its price, in the quote token. If
+	// omitted, DefaultTokenDecimals is assumed.
+	QuoteTokenDecimals *uint64 `json:"quote_token_decimals,omitempty"`
+}
+
+// GetQuoteTokenDecimals returns the number of decimals of the quote token.
+func (metadata TickerMetadata) GetQuoteTokenDecimals() uint64 {
+	if metadata.QuoteTokenDecimals == nil {
+		return DefaultTokenDecimals
+	}
+
+	return *metadata.QuoteTokenDecimals
 }
 
 // ValidateBasic checks that the pool and token information is formatted properly.
@@ -138,3 +169,19 @@ type WrappedSpotPriceResponse struct {
 	SpotPriceResponse
 	BlockHeight uint64 `json:"block_height"`
 }
+
+// Coin is an amount of a given denom.
+type Coin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// PoolLiquidityResponse is the response of the total pool liquidity query.
+type PoolLiquidityResponse struct {
+	Liquidity []Coin `json:"liquidity"`
+}
+
+type WrappedPoolLiquidityResponse struct {
+	PoolLiquidityResponse
+	BlockHeight uint64 `json:"block_height"`
+}
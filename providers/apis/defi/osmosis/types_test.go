@@ -47,3 +47,9 @@ func TestCreateURL(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateLiquidityURL(t *testing.T) {
+	got, err := osmosis.CreateLiquidityURL("http://localhost", 1)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost/osmosis/poolmanager/v1beta1/pools/1/total_pool_liquidity", got)
+}
@@ -319,8 +319,11 @@ func (pf *APIPriceFetcher) Fetch(
 			zap.String("price", price.String()),
 		)
 
+		// weight the price by the pool's quote-denominated liquidity
+		liquidity := calculateLiquidity(quoteTokenBalance, metadata.QuoteTokenVault.TokenDecimals)
+
 		// return the price
-		resolved[ticker] = oracletypes.NewPriceResult(price, time.Now().UTC())
+		resolved[ticker] = oracletypes.NewPriceResultWithWeight(price, time.Now().UTC(), liquidity)
 	}
 
 	return oracletypes.NewPriceResponse(resolved, unresolved)
@@ -419,3 +422,18 @@ func calculatePrice(
 
 	return new(big.Float).Mul(quo, scalingFactor)
 }
+
+// calculateLiquidity returns the total liquidity of the pool denominated in the quote token.
+// The base token reserves are valued at the pool price, so this is twice the quote token
+// reserves.
+func calculateLiquidity(quoteTokenBalance *big.Int, quoteTokenDecimals uint64) *big.Float {
+	if quoteTokenDecimals > gomath.MaxInt64 {
+		quoteTokenDecimals = gomath.MaxInt64
+	}
+
+	//nolint:gosec // handled above
+	scalingFactor := math.GetScalingFactor(0, int64(quoteTokenDecimals))
+
+	liquidity := new(big.Float).Mul(new(big.Float).SetInt(quoteTokenBalance), big.NewFloat(2))
+	return liquidity.Mul(liquidity, scalingFactor)
+}
@@ -465,6 +465,8 @@ func TestProviderFetch(t *testing.T) {
 		require.True(t, strings.Contains(resp.UnResolved[tickers[0]].Error(), "solana json-rpc error"))
 		result := resp.Resolved[tickers[1]]
 		require.Equal(t, result.Value.SetPrec(30), big.NewFloat(3).SetPrec(30))
+		weight, _ := result.Weight.Float64()
+		require.InDelta(t, 3, weight, 1e-9)
 	})
 
 	t.Run("incorrectly encoded accounts are handled gracefully", func(t *testing.T) {
@@ -29,7 +29,8 @@ var _ types.PriceAPIFetcher = (*PriceFetcher)(nil)
 // derived from the slot 0 data of the pool contract.
 //
 // To read more about how the price is calculated, see the Uniswap V3 documentation
-// https://blog.uniswap.org/uniswap-v3-math-primer.
+// https://blog.uniswap.org/uniswap-v3-math-primer. The price is weighted by the quote-denominated
+// value of the pool's in-range liquidity, which is derived from the liquidity of the pool contract.
 //
 // We utilize the eth client's BatchCallContext to batch the calls to the ethereum network as
 // this is more performant than making individual calls or the multi call contract:
@@ -46,6 +47,9 @@ type PriceFetcher struct {
 	// payload is the packed slot0 call to the pool contract. Since the slot0 payload is the same
 	// for all pools, we can reuse this payload for all pools.
 	payload []byte
+	// liquidityPayload is the packed liquidity call to the pool contract. Like the slot0 payload,
+	// this is the same for all pools.
+	liquidityPayload []byte
 	// poolCache is a cache of the tickers to pool configs. This is used to avoid unmarshalling
 	// the metadata for each ticker.
 	poolCache map[types.ProviderTicker]PoolConfig
@@ -132,20 +136,28 @@ func NewPriceFetcherWithClient(
 		return nil, fmt.Errorf("failed to pack slot0: %w", err)
 	}
 
+	liquidityPayload, err := abi.Pack(LiquidityMethod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack liquidity: %w", err)
+	}
+
 	return &PriceFetcher{
-		logger:    logger.With(zap.String("fetcher", api.Name)),
-		api:       api,
-		client:    client,
-		abi:       abi,
-		payload:   payload,
-		poolCache: make(map[types.ProviderTicker]PoolConfig),
+		logger:           logger.With(zap.String("fetcher", api.Name)),
+		api:              api,
+		client:           client,
+		abi:              abi,
+		payload:          payload,
+		liquidityPayload: liquidityPayload,
+		poolCache:        make(map[types.ProviderTicker]PoolConfig),
 	}, nil
 }
 
 // Fetch returns the price of a given set of tickers. This fetch utilizes the batch call to lower
 // overhead of making individual RPC calls for each ticker. The fetcher will query the Uniswap V3
 // pool contract for the price of the pool. The price is derived from the slot 0 data of the pool
-// contract, specifically the sqrtPriceX96 value.
+// contract, specifically the sqrtPriceX96 value. The liquidity of each pool is queried in the same
+// batch and is used to weight the price. If the liquidity cannot be retrieved, the price is
+// returned without a weight.
 func (u *PriceFetcher) Fetch(
 	ctx context.Context,
 	tickers []types.ProviderTicker,
@@ -155,8 +167,9 @@ func (u *PriceFetcher) Fetch(
 		unResolved = make(types.UnResolvedPrices)
 	)
 
-	// Create a batch element for each ticker and pool.
-	batchElems := make([]rpc.BatchElem, len(tickers))
+	// Create a slot0 and a liquidity batch element for each ticker and pool. The liquidity batch
+	// elements follow the slot0 batch elements.
+	batchElems := make([]rpc.BatchElem, 2*len(tickers))
 	pools := make([]PoolConfig, len(tickers))
 
 	for i, ticker := range tickers {
@@ -190,6 +203,19 @@ func (u *PriceFetcher) Fetch(
 			},
 			Result: &result,
 		}
+
+		var liquidity string
+		batchElems[len(tickers)+i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{
+				map[string]interface{}{
+					"to":   common.HexToAddress(pool.Address),
+					"data": hexutil.Bytes(u.liquidityPayload), // liquidity call to the pool contract.
+				},
+				"latest",
+			},
+			Result: &liquidity,
+		}
 		pools[i] = pool
 	}
 
@@ -256,7 +282,22 @@ func (u *PriceFetcher) Fetch(
 
 		// Scale the price to the respective token decimals.
 		scaledPrice := ScalePrice(pools[i], price)
-		resolved[ticker] = types.NewPriceResult(scaledPrice, time.Now().UTC())
+
+		// Weight the price by the in-range liquidity of the pool if it is available.
+		liquidity, err := u.parseLiquidity(batchElems[len(tickers)+i])
+		if err != nil {
+			u.logger.Debug(
+				"failed to parse liquidity; price is not weighted",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			resolved[ticker] = types.NewPriceResult(scaledPrice, time.Now().UTC())
+			continue
+		}
+
+		weight := CalculateLiquidity(pools[i], sqrtPriceX96, liquidity)
+		resolved[ticker] = types.NewPriceResultWithWeight(scaledPrice, time.Now().UTC(), weight)
 	}
 
 	// Add the price to the resolved prices.
@@ -288,6 +329,43 @@ func (u *PriceFetcher) GetPool(
 func (u *PriceFetcher) ParseSqrtPriceX96(
 	result interface{},
 ) (*big.Int, error) {
+	out, err := u.unpack(ContractMethod, result)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the sqrtPriceX96 from the result.
+	sqrtPriceX96 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	return sqrtPriceX96, nil
+}
+
+// ParseLiquidity parses the in-range liquidity of a pool from the result of the batch call.
+func (u *PriceFetcher) ParseLiquidity(
+	result interface{},
+) (*big.Int, error) {
+	out, err := u.unpack(LiquidityMethod, result)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidity := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	return liquidity, nil
+}
+
+// parseLiquidity parses the in-range liquidity of a pool from the given liquidity batch element.
+func (u *PriceFetcher) parseLiquidity(elem rpc.BatchElem) (*big.Int, error) {
+	if elem.Error != nil {
+		return nil, elem.Error
+	}
+
+	return u.ParseLiquidity(elem.Result)
+}
+
+// unpack decodes the result of a batch call to the given pool contract method.
+func (u *PriceFetcher) unpack(
+	method string,
+	result interface{},
+) ([]interface{}, error) {
 	r, ok := result.(*string)
 	if !ok {
 		return nil, fmt.Errorf("expected result to be a string, got %T", result)
@@ -302,12 +380,10 @@ func (u *PriceFetcher) ParseSqrtPriceX96(
 		return nil, fmt.Errorf("failed to decode hex result: %w", err)
 	}
 
-	out, err := u.abi.Methods[ContractMethod].Outputs.UnpackValues(bz)
+	out, err := u.abi.Methods[method].Outputs.UnpackValues(bz)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unpack values: %w", err)
 	}
 
-	// Parse the sqrtPriceX96 from the result.
-	sqrtPriceX96 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
-	return sqrtPriceX96, nil
+	return out, nil
 }
@@ -77,9 +77,11 @@ func TestFetch(t *testing.T) {
 			client: func() ethmulticlient.EVMClient {
 				batchErrors := []error{
 					fmt.Errorf("request for ticker did not return a result"),
+					nil,
 				}
 				responses := []string{
 					"",
+					wethusdcLiquidity,
 				}
 				return createEVMClientWithResponse(t, nil, responses, batchErrors)
 			},
@@ -98,9 +100,11 @@ func TestFetch(t *testing.T) {
 			client: func() ethmulticlient.EVMClient {
 				batchErrors := []error{
 					nil,
+					nil,
 				}
 				responses := []string{
 					"not a valid result",
+					wethusdcLiquidity,
 				}
 				return createEVMClientWithResponse(t, nil, responses, batchErrors)
 			},
@@ -119,9 +123,37 @@ func TestFetch(t *testing.T) {
 			client: func() ethmulticlient.EVMClient {
 				batchErrors := []error{
 					nil,
+					nil,
+				}
+				responses := []string{
+					wethusdcSlot0,
+					wethusdcLiquidity,
+				}
+				return createEVMClientWithResponse(t, nil, responses, batchErrors)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{
+					wethusdcTicker: {
+						Value:  big.NewFloat(3313.131879703878971626114658316303),
+						Weight: big.NewFloat(345358867.9465747307459987798902045),
+					},
+				},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+		{
+			name: "weth/usdc mainnet result without liquidity is not weighted",
+			tickers: []types.ProviderTicker{
+				wethusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				batchErrors := []error{
+					nil,
+					fmt.Errorf("request for liquidity did not return a result"),
 				}
 				responses := []string{
-					"0x00000000000000000000000000000000000043dd3b966e761000000000000000000000000000000000000000000000000000000000000000000000000002fabf000000000000000000000000000000000000000000000000000000000000057900000000000000000000000000000000000000000000000000000000000005a000000000000000000000000000000000000000000000000000000000000005a000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001",
+					wethusdcSlot0,
+					"",
 				}
 				return createEVMClientWithResponse(t, nil, responses, batchErrors)
 			},
@@ -147,6 +179,11 @@ func TestFetch(t *testing.T) {
 			for ticker, result := range tc.expected.Resolved {
 				require.Contains(t, response.Resolved, ticker)
 				require.Equal(t, result.Value.SetPrec(40), response.Resolved[ticker].Value.SetPrec(40))
+				if result.Weight == nil {
+					require.Nil(t, response.Resolved[ticker].Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(40), response.Resolved[ticker].Weight.SetPrec(40))
+				}
 			}
 
 			for ticker := range tc.expected.UnResolved {
@@ -247,6 +284,33 @@ func TestParseSqrtPriceX96(t *testing.T) {
 	})
 }
 
+func TestParseLiquidity(t *testing.T) {
+	fetcher := createPriceFetcher(t)
+
+	t.Run("result is a nil string pointer", func(t *testing.T) {
+		_, err := fetcher.ParseLiquidity((*string)(nil))
+		require.Error(t, err)
+	})
+
+	t.Run("result cannot be unpacked by the uniswap abi", func(t *testing.T) {
+		result := new(string)
+		*result = "0x1234"
+		_, err := fetcher.ParseLiquidity(result)
+		require.Error(t, err)
+	})
+
+	t.Run("valid liquidity result", func(t *testing.T) {
+		result := new(string)
+		*result = wethusdcLiquidity
+		liquidity, err := fetcher.ParseLiquidity(result)
+		require.NoError(t, err)
+
+		expectedResult, ok := new(big.Int).SetString("3000000000000000000", 10)
+		require.True(t, ok)
+		require.Equal(t, expectedResult, liquidity)
+	})
+}
+
 func TestNewPriceFetcher(t *testing.T) {
 	ctx := context.TODO()
 
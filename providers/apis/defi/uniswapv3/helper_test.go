@@ -27,6 +27,10 @@ var (
 
 	// Tickers used for testing.
 	wethusdcTicker = types.NewProviderTicker("WETH/USDC", wethusdcCfg.MustToJSON())
+
+	// Batch call results used for testing.
+	wethusdcSlot0     = "0x00000000000000000000000000000000000043dd3b966e761000000000000000000000000000000000000000000000000000000000000000000000000002fabf000000000000000000000000000000000000000000000000000000000000057900000000000000000000000000000000000000000000000000000000000005a000000000000000000000000000000000000000000000000000000000000005a000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001"
+	wethusdcLiquidity = "0x00000000000000000000000000000000000000000000000029a2241af62c0000"
 )
 
 func createPriceFetcher(
@@ -47,3 +47,34 @@ func ScalePrice(
 	}
 	return new(big.Float).Mul(price, erc20ScalingFactor)
 }
+
+// CalculateLiquidity returns the value of the pool's in-range liquidity denominated in the quote
+// token. The virtual reserves of the quote token are L * sqrtPrice if the quote token is token1,
+// and L / sqrtPrice if the price is inverted, i.e. the quote token is token0. The base token
+// reserves are valued at the pool price, so the liquidity is twice the quote token reserves.
+func CalculateLiquidity(
+	cfg PoolConfig,
+	sqrtPriceX96 *big.Int,
+	liquidity *big.Int,
+) *big.Float {
+	if sqrtPriceX96.Sign() == 0 {
+		return new(big.Float)
+	}
+
+	// sqrtPrice = sqrtPriceX96 / 2^96.
+	x96Float := new(big.Float).SetInt(
+		new(big.Int).Exp(big.NewInt(2), big.NewInt(96), nil),
+	)
+	sqrtPrice := new(big.Float).Quo(new(big.Float).SetInt(sqrtPriceX96), x96Float)
+
+	reserves := new(big.Float).SetInt(liquidity)
+	if cfg.Invert {
+		reserves.Quo(reserves, sqrtPrice)
+	} else {
+		reserves.Mul(reserves, sqrtPrice)
+	}
+
+	scalingFactor := math.GetScalingFactor(0, cfg.QuoteDecimals)
+	reserves.Mul(reserves, scalingFactor)
+	return reserves.Mul(reserves, big.NewFloat(2))
+}
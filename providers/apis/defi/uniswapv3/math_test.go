@@ -34,6 +34,55 @@ func TestConvertSquareRootX96Price(t *testing.T) {
 	})
 }
 
+func TestCalculateLiquidity(t *testing.T) {
+	x96 := new(big.Int).Exp(big.NewInt(2), big.NewInt(96), nil)
+	twoX96 := new(big.Int).Mul(x96, big.NewInt(2))
+
+	testCases := []struct {
+		name         string
+		cfg          uniswapv3.PoolConfig
+		sqrtPriceX96 *big.Int
+		liquidity    *big.Int
+		expected     *big.Float
+	}{
+		{
+			name:         "quote token is token1",
+			cfg:          uniswapv3.PoolConfig{QuoteDecimals: 6},
+			sqrtPriceX96: twoX96,
+			liquidity:    big.NewInt(1_000_000),
+			expected:     big.NewFloat(4),
+		},
+		{
+			name:         "quote token is token0",
+			cfg:          uniswapv3.PoolConfig{QuoteDecimals: 6, Invert: true},
+			sqrtPriceX96: twoX96,
+			liquidity:    big.NewInt(1_000_000),
+			expected:     big.NewFloat(1),
+		},
+		{
+			name:         "no liquidity",
+			cfg:          uniswapv3.PoolConfig{QuoteDecimals: 6},
+			sqrtPriceX96: x96,
+			liquidity:    big.NewInt(0),
+			expected:     big.NewFloat(0),
+		},
+		{
+			name:         "zero price",
+			cfg:          uniswapv3.PoolConfig{QuoteDecimals: 6, Invert: true},
+			sqrtPriceX96: big.NewInt(0),
+			liquidity:    big.NewInt(1_000_000),
+			expected:     big.NewFloat(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := uniswapv3.CalculateLiquidity(tc.cfg, tc.sqrtPriceX96, tc.liquidity)
+			require.Equal(t, tc.expected.SetPrec(40), actual.SetPrec(40))
+		})
+	}
+}
+
 func TestScalePrice(t *testing.T) {
 	testCases := []struct {
 		name     string
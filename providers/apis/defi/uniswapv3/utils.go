@@ -22,6 +22,10 @@ const (
 	// ContractMethod is the contract method to call for the Uniswap V3 API.
 	ContractMethod = "slot0"
 
+	// LiquidityMethod is the contract method to call for the in-range liquidity of a Uniswap V3
+	// pool. This is used to weight the price of the pool.
+	LiquidityMethod = "liquidity"
+
 	// ETH_URL is the URL for the Uniswap V3 API. This uses a free public RPC provider on Ethereum Mainnet.
 	ETH_URL = "https://eth.public-rpc.com/"
 
@@ -3,6 +3,7 @@ package kraken
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"strings"
 	"time"
@@ -127,6 +128,13 @@ func (h *APIHandler) ParseResponse(
 			continue
 		}
 
+		// Weight the price by the 24h quote volume, i.e. the 24h base volume valued at the price.
+		if volume, err := math.Float64StringToBigFloat(resultTicker.Volume24H()); err == nil && volume.Sign() > 0 {
+			weight := new(big.Float).Mul(volume, price)
+			resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), weight)
+			continue
+		}
+
 		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 	}
 
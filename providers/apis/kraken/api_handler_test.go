@@ -96,7 +96,8 @@ func TestParseResponse(t *testing.T) {
 			expected: types.NewPriceResponse(
 				types.ResolvedPrices{
 					btcusd: {
-						Value: big.NewFloat(64587.4),
+						Value:  big.NewFloat(64587.4),
+						Weight: big.NewFloat(6251.33408493 * 64587.4),
 					},
 				},
 				types.UnResolvedPrices{},
@@ -114,10 +115,29 @@ func TestParseResponse(t *testing.T) {
 			expected: types.NewPriceResponse(
 				types.ResolvedPrices{
 					btcusd: {
-						Value: big.NewFloat(64547.2),
+						Value:  big.NewFloat(64547.2),
+						Weight: big.NewFloat(6253.84063618 * 64547.2),
 					},
 					ethusd: {
-						Value: big.NewFloat(3338.08),
+						Value:  big.NewFloat(3338.08),
+						Weight: big.NewFloat(35692.20596751 * 3338.08),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name: "valid single without volume is not weighted",
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`{"error":[],"result":{"XXBTZUSD":{"c":["64587.40000","0.01026127"]}}}`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd: {
+						Value: big.NewFloat(64587.4),
 					},
 				},
 				types.UnResolvedPrices{},
@@ -199,6 +219,11 @@ func TestParseResponse(t *testing.T) {
 				require.Contains(t, resp.Resolved, cp)
 				r := resp.Resolved[cp]
 				require.Equal(t, result.Value.SetPrec(18), r.Value.SetPrec(18))
+				if result.Weight == nil {
+					require.Nil(t, r.Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), r.Weight.SetPrec(18))
+				}
 				require.True(t, r.Timestamp.After(now))
 			}
 
@@ -227,6 +252,7 @@ func TestDecode(t *testing.T) {
 				Tickers: map[string]kraken.TickerResult{
 					"XXBTZUSD": {
 						ClosePriceStats: []string{"64587.40000", "0.01026127"},
+						VolumeStats:     []string{"5866.14264484", "6251.33408493"},
 					},
 				},
 			}, expectErr: false,
@@ -241,9 +267,11 @@ func TestDecode(t *testing.T) {
 				Tickers: map[string]kraken.TickerResult{
 					"XETHZUSD": {
 						ClosePriceStats: []string{"3338.08000", "0.00702654"},
+						VolumeStats:     []string{"33234.61736920", "35692.20596751"},
 					},
 					"XXBTZUSD": {
 						ClosePriceStats: []string{"64547.20000", "0.00013362"},
+						VolumeStats:     []string{"5869.92462186", "6253.84063618"},
 					},
 				},
 			},
@@ -36,18 +36,32 @@ var DefaultAPIConfig = config.APIConfig{
 	Endpoints:        []config.Endpoint{{URL: URL}},
 }
 
+// Last24HoursVolumeIndex is the index of the trailing 24 hour base volume in the
+// ticker's volume stats.
+const Last24HoursVolumeIndex = 1
+
 // TickerResult is the result of a Kraken API call for a single ticker.
 //
 // https://api.kraken.com/0/public/Ticker
 type TickerResult struct {
 	pair            string
 	ClosePriceStats []string `json:"c"`
+	VolumeStats     []string `json:"v"`
 }
 
 func (ktr *TickerResult) LastPrice() string {
 	return ktr.ClosePriceStats[0]
 }
 
+// Volume24H returns the trailing 24 hour base volume of the ticker, or an empty
+// string if it was not included in the response.
+func (ktr *TickerResult) Volume24H() string {
+	if len(ktr.VolumeStats) <= Last24HoursVolumeIndex {
+		return ""
+	}
+	return ktr.VolumeStats[Last24HoursVolumeIndex]
+}
+
 // ResponseBody returns a list of tickers for the response.  If there is an error, it will be included,
 // and all Tickers will be undefined.
 type ResponseBody struct {
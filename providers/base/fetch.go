@@ -146,6 +146,7 @@ func (p *Provider[K, V]) recv(ctx context.Context) {
 			return
 		case r := <-p.responseCh:
 			resolved, unResolved := r.Resolved, r.UnResolved
+			now := time.Now().UTC()
 
 			// Update all the resolved data.
 			for id, result := range resolved {
@@ -164,6 +165,10 @@ func (p *Provider[K, V]) recv(ctx context.Context) {
 				p.metrics.LastUpdated(p.name, strID, p.Type())
 			}
 
+			if len(resolved) > 0 {
+				p.recordSuccess(now)
+			}
+
 			// Log and record all the unresolved data.
 			for id, result := range unResolved {
 				p.logger.Debug(
@@ -176,6 +181,11 @@ func (p *Provider[K, V]) recv(ctx context.Context) {
 				strID := strings.ToLower(id.String())
 				p.metrics.AddProviderResponseByID(p.name, strID, providermetrics.Failure, result.Code(), p.Type())
 				p.metrics.AddProviderResponse(p.name, providermetrics.Failure, result.Code(), p.Type())
+				p.recordError(providertypes.ProviderError{
+					ID:        id.String(),
+					Code:      result.Code(),
+					Timestamp: now,
+				})
 			}
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"maps"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -65,6 +66,13 @@ type Provider[K providertypes.ResponseKey, V providertypes.ResponseValue] struct
 
 	// responseCh is the channel that is used to receive the response(s) from the query handler.
 	responseCh chan providertypes.GetResponse[K, V]
+
+	// lastUpdated is the time at which the provider last successfully fetched data.
+	lastUpdated time.Time
+
+	// recentErrors are the most recent errors the provider encountered while fetching data,
+	// from oldest to newest.
+	recentErrors []providertypes.ProviderError
 }
 
 // NewProvider returns a new Base provider.
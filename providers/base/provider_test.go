@@ -826,6 +826,60 @@ func TestAPIProviderLoop(t *testing.T) {
 	}
 }
 
+func TestProviderStatus(t *testing.T) {
+	// the provider fetches one price, and then fails to fetch the other price more times than
+	// the number of errors that are retained.
+	resolved := map[connecttypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+		pairs[0]: {
+			Value:     big.NewInt(100),
+			Timestamp: respTime,
+		},
+	}
+	responses := []providertypes.GetResponse[connecttypes.CurrencyPair, *big.Int]{
+		providertypes.NewGetResponse[connecttypes.CurrencyPair, *big.Int](resolved, nil),
+	}
+
+	numErrors := base.MaxRecentErrors + 2
+	for i := 0; i < numErrors; i++ {
+		unResolved := map[connecttypes.CurrencyPair]providertypes.UnresolvedResult{
+			pairs[1]: {
+				ErrorWithCode: providertypes.NewErrorWithCode(apierrors.ErrRateLimit, providertypes.ErrorCode(i+1)),
+			},
+		}
+		responses = append(responses, providertypes.NewGetResponse[connecttypes.CurrencyPair, *big.Int](nil, unResolved))
+	}
+
+	provider, err := base.NewProvider[connecttypes.CurrencyPair, *big.Int](
+		base.WithName[connecttypes.CurrencyPair, *big.Int](apiCfg.Name),
+		base.WithAPIQueryHandler[connecttypes.CurrencyPair, *big.Int](
+			testutils.CreateAPIQueryHandlerWithGetResponses[connecttypes.CurrencyPair, *big.Int](t, logger, responses, 0),
+		),
+		base.WithAPIConfig[connecttypes.CurrencyPair, *big.Int](apiCfg),
+		base.WithLogger[connecttypes.CurrencyPair, *big.Int](logger),
+		base.WithIDs[connecttypes.CurrencyPair, *big.Int](pairs),
+	)
+	require.NoError(t, err)
+
+	require.True(t, provider.LastUpdated().IsZero())
+	require.Empty(t, provider.RecentErrors())
+
+	now := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), apiCfg.Interval*2)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, provider.Start(ctx))
+
+	require.True(t, provider.LastUpdated().After(now))
+
+	// only the most recent errors are retained, from oldest to newest
+	errs := provider.RecentErrors()
+	require.Len(t, errs, base.MaxRecentErrors)
+	for i, err := range errs {
+		require.Equal(t, pairs[1].String(), err.ID)
+		require.Equal(t, providertypes.ErrorCode(numErrors-base.MaxRecentErrors+i+1), err.Code)
+		require.False(t, err.Timestamp.Before(now))
+	}
+}
+
 func TestMetrics(t *testing.T) {
 	testCases := []struct {
 		name    string
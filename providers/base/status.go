@@ -0,0 +1,51 @@
+package base
+
+import (
+	"time"
+
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+)
+
+// MaxRecentErrors is the maximum number of recent errors retained by a provider.
+const MaxRecentErrors = 10
+
+// LastUpdated returns the time at which the provider last successfully fetched data, or the zero
+// time if it has not yet.
+func (p *Provider[K, V]) LastUpdated() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.lastUpdated
+}
+
+// RecentErrors returns the most recent errors the provider encountered while fetching data, from
+// oldest to newest. At most MaxRecentErrors errors are returned.
+func (p *Provider[K, V]) RecentErrors() []providertypes.ProviderError {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	errs := make([]providertypes.ProviderError, len(p.recentErrors))
+	copy(errs, p.recentErrors)
+
+	return errs
+}
+
+// recordSuccess records that the provider successfully fetched data at the given time.
+func (p *Provider[K, V]) recordSuccess(timestamp time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastUpdated = timestamp
+}
+
+// recordError records an error the provider encountered while fetching data, dropping the oldest
+// recorded error if MaxRecentErrors errors are already recorded.
+func (p *Provider[K, V]) recordError(err providertypes.ProviderError) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.recentErrors) == MaxRecentErrors {
+		p.recentErrors = append(p.recentErrors[:0], p.recentErrors[1:]...)
+	}
+	p.recentErrors = append(p.recentErrors, err)
+}
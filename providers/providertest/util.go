@@ -17,6 +17,7 @@ func FilterMarketMapToProviders(mm mmtypes.MarketMap) map[string]mmtypes.MarketM
 		for _, pc := range market.ProviderConfigs {
 			// remove normalizations to isolate markets
 			pc.NormalizeByPair = nil
+			pc.NormalizeByPairs = nil
 
 			// create a market from the given provider config
 			isolatedMarket := mmtypes.Market{
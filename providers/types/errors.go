@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"time"
 )
 
 // ErrorCode is a type alias for an int error code.
@@ -93,3 +94,13 @@ func NewErrorWithCode(err error, ec ErrorCode) ErrorWithCode {
 		internalErr: err,
 	}
 }
+
+// ProviderError is an error that a provider encountered while fetching the data of an ID.
+type ProviderError struct {
+	// ID is the ID whose data the provider failed to fetch, e.g. a ticker.
+	ID string
+	// Code is the error code.
+	Code ErrorCode
+	// Timestamp is the time at which the error was encountered.
+	Timestamp time.Time
+}
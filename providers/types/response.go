@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 )
 
@@ -56,6 +57,11 @@ type ResolvedResult[V ResponseValue] struct {
 	// ResponseCode is an optional code that can be attached to responses to provide
 	// additional context.
 	ResponseCode ResponseCode
+	// Weight is an optional weight of the value, e.g. the 24h quote volume of a ticker or
+	// the quote-denominated liquidity of a pool. This is used by the aggregator when
+	// computing weighted prices. A nil weight indicates that the provider does not report
+	// a weight for the value.
+	Weight *big.Float
 }
 
 // UnresolvedResult is an unresolved (failed) result of a single requested ID.
@@ -122,6 +128,15 @@ func NewResultWithCode[V ResponseValue](value V, timestamp time.Time, code Respo
 	}
 }
 
+// NewResultWithWeight creates a new ResolvedResult with the given weight.
+func NewResultWithWeight[V ResponseValue](value V, timestamp time.Time, weight *big.Float) ResolvedResult[V] {
+	return ResolvedResult[V]{
+		Value:     value,
+		Timestamp: timestamp,
+		Weight:    weight,
+	}
+}
+
 // String returns a string representation of the ResolvedResult. This is mostly used for logging
 // and testing purposes.
 func (r ResolvedResult[V]) String() string {
@@ -200,6 +200,13 @@ type TickerMessageResponse struct {
 		Ticker string `json:"s"`
 		// LastPrice is the last price.
 		LastPrice string `json:"c"`
+		// QuoteVolume is the total traded quote asset volume in the last 24h.
+		QuoteVolume string `json:"q"`
+		// LastQuantity is the last quantity.
+		//
+		// Note: This is unused but is included since json.Unmarshal requires all fields with same character but different casing
+		// to be present.
+		LastQuantity string `json:"Q"`
 		// StatisticsCloseTime is the statistics close time.
 		//
 		// Note: This is unused but is included since json.Unmarshal requires all fields with same character but different casing
@@ -11,8 +11,14 @@ import (
 )
 
 // parsePriceUpdateMessage parses a price update message from the Binance websocket feed.
-// This is repurposed for ticker and aggregate trade messages.
-func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker string, price string) (types.PriceResponse, error) {
+// This is repurposed for ticker and aggregate trade messages. The price is weighted by the
+// 24h quote volume of the ticker, which is only included in ticker messages. Aggregate trade
+// messages, which have an empty quote volume, use the latest quote volume seen for the ticker.
+func (h *WebSocketHandler) parsePriceUpdateMessage(
+	offChainTicker string,
+	price string,
+	quoteVolume string,
+) (types.PriceResponse, error) {
 	var (
 		resolved   = make(types.ResolvedPrices)
 		unResolved = make(types.UnResolvedPrices)
@@ -33,6 +39,11 @@ func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker string, price
 		return types.NewPriceResponse(resolved, unResolved), err
 	}
 
-	resolved[ticker] = types.NewPriceResult(priceFloat, time.Now().UTC())
+	// Update the quote volume if it is included in the message.
+	if volume, err := math.Float64StringToBigFloat(quoteVolume); err == nil && volume.Sign() > 0 {
+		h.quoteVolumes[ticker] = volume
+	}
+
+	resolved[ticker] = types.NewPriceResultWithWeight(priceFloat, time.Now().UTC(), h.quoteVolumes[ticker])
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
@@ -3,6 +3,7 @@ package binance
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"math/rand"
 
 	"go.uber.org/zap"
@@ -27,6 +28,9 @@ type WebSocketHandler struct {
 	messageIDs map[int64][]string
 	// nextID is the next message ID to use for the Binance websocket API.
 	nextID int64
+	// quoteVolumes is the latest 24h quote volume of each ticker, as received on the ticker
+	// stream. This is used to weight the prices received on the aggregate trade stream.
+	quoteVolumes map[types.ProviderTicker]*big.Float
 }
 
 // NewWebSocketDataHandler returns a new Binance PriceWebSocketDataHandler.
@@ -47,11 +51,12 @@ func NewWebSocketDataHandler(
 	}
 
 	return &WebSocketHandler{
-		logger:     logger,
-		ws:         ws,
-		cache:      types.NewProviderTickers(),
-		messageIDs: make(map[int64][]string),
-		nextID:     rand.Int63() + 1,
+		logger:       logger,
+		ws:           ws,
+		cache:        types.NewProviderTickers(),
+		messageIDs:   make(map[int64][]string),
+		nextID:       rand.Int63() + 1,
+		quoteVolumes: make(map[types.ProviderTicker]*big.Float),
 	}, nil
 }
 
@@ -113,7 +118,7 @@ func (h *WebSocketHandler) HandleMessage(
 		}
 
 		h.logger.Debug("received ticker message", zap.String("ticker", tickerResp.Data.Ticker))
-		resp, err := h.parsePriceUpdateMessage(tickerResp.Data.Ticker, tickerResp.Data.LastPrice)
+		resp, err := h.parsePriceUpdateMessage(tickerResp.Data.Ticker, tickerResp.Data.LastPrice, tickerResp.Data.QuoteVolume)
 		return resp, nil, err
 	case AggregateTradeStream:
 		// Aggregate trade stream is sent when a trade is executed on the Binance exchange.
@@ -123,7 +128,7 @@ func (h *WebSocketHandler) HandleMessage(
 		}
 
 		h.logger.Debug("received aggregate trade message", zap.String("ticker", aggTradeResp.Data.Ticker))
-		resp, err := h.parsePriceUpdateMessage(aggTradeResp.Data.Ticker, aggTradeResp.Data.Price)
+		resp, err := h.parsePriceUpdateMessage(aggTradeResp.Data.Ticker, aggTradeResp.Data.Price, "")
 		return resp, nil, err
 	default:
 		return resp, nil, fmt.Errorf("unknown stream type %s", streamMsg.Stream)
@@ -158,10 +163,11 @@ func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessa
 // Copy is used to create a copy of the WebSocketHandler.
 func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
 	return &WebSocketHandler{
-		logger:     h.logger,
-		ws:         h.ws,
-		cache:      types.NewProviderTickers(),
-		messageIDs: make(map[int64][]string),
-		nextID:     rand.Int63() + 1,
+		logger:       h.logger,
+		ws:           h.ws,
+		cache:        types.NewProviderTickers(),
+		messageIDs:   make(map[int64][]string),
+		nextID:       rand.Int63() + 1,
+		quoteVolumes: make(map[types.ProviderTicker]*big.Float),
 	}
 }
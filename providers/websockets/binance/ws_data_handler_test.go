@@ -128,6 +128,35 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        false,
 		},
+		{
+			name: "ticker stream message with quote volume is weighted",
+			msg: func() []byte {
+				msg := `
+				{
+					"stream": "btcusdt@ticker",
+					"data": {
+						"s": "btcusdt",
+						"c": "10000.00000000",
+						"Q": "0.5",
+						"q": "25000.00000000",
+						"C": 1600000000000
+						}
+				}`
+
+				return []byte(msg)
+			},
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {
+						Value:  big.NewFloat(10000.0),
+						Weight: big.NewFloat(25000.0),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 		{
 			name: "ticker stream message with bad price",
 			msg: func() []byte {
@@ -292,6 +321,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Weight == nil {
+					require.Nil(t, resp.Resolved[cp].Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), resp.Resolved[cp].Weight.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -302,6 +336,30 @@ func TestHandleMessage(t *testing.T) {
 	}
 }
 
+func TestHandleMessageWeightsAggregateTrades(t *testing.T) {
+	wsHandler, err := binance.NewWebSocketDataHandler(logger, binance.DefaultWebSocketConfig)
+	require.NoError(t, err)
+
+	_, err = wsHandler.CreateMessages([]types.ProviderTicker{btcusdt})
+	require.NoError(t, err)
+
+	aggTrade := []byte(`{"stream": "btcusdt@aggTrade", "data": {"s": "btcusdt", "p": "10000.00000000"}}`)
+
+	// aggregate trades are not weighted until a ticker message is received
+	resp, _, err := wsHandler.HandleMessage(aggTrade)
+	require.NoError(t, err)
+	require.Nil(t, resp.Resolved[btcusdt].Weight)
+
+	ticker := []byte(`{"stream": "btcusdt@ticker", "data": {"s": "btcusdt", "c": "10000.00000000", "q": "25000", "C": 1600000000000}}`)
+	_, _, err = wsHandler.HandleMessage(ticker)
+	require.NoError(t, err)
+
+	// aggregate trades are weighted by the latest quote volume of the ticker
+	resp, _, err = wsHandler.HandleMessage(aggTrade)
+	require.NoError(t, err)
+	require.Equal(t, big.NewFloat(25000).SetPrec(18), resp.Resolved[btcusdt].Weight.SetPrec(18))
+}
+
 func TestCreateMessages(t *testing.T) {
 	batchCfg := binance.DefaultWebSocketConfig
 	batchCfg.MaxSubscriptionsPerBatch = 2
@@ -121,6 +121,14 @@ func (h *WebSocketHandler) handleStream(
 	lastPrice := dataArr[6]
 	// Convert the price to a big Float.
 	price := big.NewFloat(lastPrice.(float64))
+
+	// Weight the price by the 24h quote volume, i.e. the 24h base volume valued at the price.
+	if volume, ok := dataArr[7].(float64); ok && volume > 0 {
+		weight := new(big.Float).Mul(big.NewFloat(volume), price)
+		resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), weight)
+		return types.NewPriceResponse(resolved, unResolved), nil
+	}
+
 	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 
 	return types.NewPriceResponse(resolved, unResolved), nil
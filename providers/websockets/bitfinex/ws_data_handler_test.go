@@ -85,7 +85,8 @@ func TestHandleMessage(t *testing.T) {
 			resp: types.NewPriceResponse(
 				types.ResolvedPrices{
 					btcusd: {
-						Value: big.NewFloat(1.0),
+						Value:  big.NewFloat(1.0),
+						Weight: big.NewFloat(53723.08813995),
 					},
 				},
 				types.UnResolvedPrices{},
@@ -181,6 +182,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Weight == nil {
+					require.Nil(t, resp.Resolved[cp].Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), resp.Resolved[cp].Weight.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
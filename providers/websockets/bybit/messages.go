@@ -179,4 +179,6 @@ type TickerUpdateMessage struct {
 type TickerUpdateData struct {
 	Symbol    string `json:"symbol"`
 	LastPrice string `json:"lastPrice"`
+	// Turnover24H is the 24h volume of the ticker denominated in the quote asset.
+	Turnover24H string `json:"turnover24h"`
 }
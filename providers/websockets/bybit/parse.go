@@ -67,6 +67,12 @@ func (h *WebSocketHandler) parseTickerUpdate(
 		return types.NewPriceResponse(resolved, unresolved), nil
 	}
 
+	// Weight the price by the 24h quote volume if the turnover is included in the message.
+	if turnover, err := math.Float64StringToBigFloat(data.Turnover24H); err == nil && turnover.Sign() > 0 {
+		resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), turnover)
+		return types.NewPriceResponse(resolved, unresolved), nil
+	}
+
 	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 	return types.NewPriceResponse(resolved, unresolved), nil
 }
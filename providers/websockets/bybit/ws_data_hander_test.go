@@ -87,6 +87,35 @@ func TestHandlerMessage(t *testing.T) {
 			updateMsg: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:    false,
 		},
+		{
+			name: "price update with turnover is weighted by quote volume",
+			msg: func() []byte {
+				msg := bybit.TickerUpdateMessage{
+					Topic: "tickers.BTCUSDT",
+					Data: bybit.TickerUpdateData{
+						Symbol:      "BTCUSDT",
+						LastPrice:   "1",
+						Turnover24H: "141946527.22907118",
+					},
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return bz
+			},
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {
+						Value:  big.NewFloat(1.0),
+						Weight: big.NewFloat(141946527.22907118),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+			updateMsg: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:    false,
+		},
 		{
 			name: "price update with unknown pair ID",
 			msg: func() []byte {
@@ -187,6 +216,11 @@ func TestHandlerMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Weight == nil {
+					require.Nil(t, resp.Resolved[cp].Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), resp.Resolved[cp].Weight.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
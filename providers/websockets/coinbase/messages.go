@@ -236,6 +236,9 @@ type TickerResponseMessage struct {
 	// Price is the price of the ticker.
 	Price string `json:"price"`
 
+	// Volume24H is the 24h volume of the ticker denominated in the base asset.
+	Volume24H string `json:"volume_24h"`
+
 	// TradeID is the trade ID of the ticker.
 	TradeID int64 `json:"trade_id"`
 }
@@ -51,6 +51,13 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 	// Update the trade ID.
 	h.tradeIDs[ticker] = msg.TradeID
 
+	// Weight the price by the 24h quote volume if the volume is included in the message.
+	if volume, err := math.Float64StringToBigFloat(msg.Volume24H); err == nil && volume.Sign() > 0 {
+		weight := new(big.Float).Mul(volume, price)
+		resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), weight)
+		return types.NewPriceResponse(resolved, unResolved), nil
+	}
+
 	// Convert the time to a time object and resolve the price into the response.
 	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 	return types.NewPriceResponse(resolved, unResolved), nil
@@ -282,6 +282,32 @@ func TestHandleMessage(t *testing.T) {
 			},
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        false,
+		},		{
+			name: "ticker message with 24h volume is weighted by quote volume",
+			msg: func() []byte {
+				msg := coinbase.TickerResponseMessage{
+					Type:      string(coinbase.TickerMessage),
+					Ticker:    "BTC-USD",
+					Price:     "10000.00",
+					Volume24H: "2.5",
+					Sequence:  3,
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return bz
+			},
+			resp: types.PriceResponse{
+				Resolved: types.ResolvedPrices{
+					btcusd: {
+						Value:  big.NewFloat(10000.00),
+						Weight: big.NewFloat(25000.00),
+					},
+				},
+			},
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
 		},
 	}
 
@@ -315,6 +341,11 @@ func TestHandleMessage(t *testing.T) {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
 				require.Equal(t, result.ResponseCode, resp.Resolved[cp].ResponseCode)
+				if result.Weight == nil {
+					require.Nil(t, resp.Resolved[cp].Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), resp.Resolved[cp].Weight.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
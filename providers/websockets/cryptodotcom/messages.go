@@ -179,4 +179,7 @@ type InstrumentData struct {
 
 	// Name is the instrument name.
 	Name string `json:"i"`
+
+	// Volume24H is the total 24h traded volume denominated in the base asset.
+	Volume24H string `json:"v"`
 }
@@ -2,6 +2,7 @@ package cryptodotcom
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
@@ -45,6 +46,10 @@ func (h *WebSocketHandler) parseInstrumentMessage(
 			unresolved[ticker] = providertypes.UnresolvedResult{
 				ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
 			}
+		} else if volume, err := math.Float64StringToBigFloat(instrument.Volume24H); err == nil && volume.Sign() > 0 {
+			// Weight the price by the 24h quote volume if the volume is included in the message.
+			weight := new(big.Float).Mul(volume, price)
+			resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), weight)
 		} else {
 			resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 		}
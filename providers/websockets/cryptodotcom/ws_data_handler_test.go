@@ -195,6 +195,7 @@ func TestHandleMessage(t *testing.T) {
 							{
 								Name:             "ETHUSD-PERP",
 								LatestTradePrice: "2000",
+								Volume24H:        "10",
 							},
 							{
 								Name:             "SOLUSD-PERP",
@@ -210,7 +211,7 @@ func TestHandleMessage(t *testing.T) {
 			resp: types.PriceResponse{
 				Resolved: types.ResolvedPrices{
 					btcusd: types.NewPriceResult(big.NewFloat(42069.00), time.Now()),
-					ethusd: types.NewPriceResult(big.NewFloat(2000.00), time.Now()),
+					ethusd: types.NewPriceResultWithWeight(big.NewFloat(2000.00), time.Now(), big.NewFloat(20000.00)),
 					solusd: types.NewPriceResult(big.NewFloat(1000.00), time.Now()),
 				},
 				UnResolved: types.UnResolvedPrices{},
@@ -284,6 +285,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Weight == nil {
+					require.Nil(t, resp.Resolved[cp].Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), resp.Resolved[cp].Weight.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
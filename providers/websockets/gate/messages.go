@@ -189,4 +189,6 @@ type TickerResult struct {
 	CurrencyPair string `json:"currency_pair"`
 	// Last is the last price of the pair.
 	Last string `json:"last"`
+	// QuoteVolume is the 24h volume of the pair denominated in the quote asset.
+	QuoteVolume string `json:"quote_volume"`
 }
@@ -60,6 +60,12 @@ func (h *WebSocketHandler) parseTickerStream(
 		return types.NewPriceResponse(resolved, unresolved), unresolved[ticker]
 	}
 
+	// Weight the price by the 24h quote volume if the volume is included in the message.
+	if volume, err := math.Float64StringToBigFloat(stream.Result.QuoteVolume); err == nil && volume.Sign() > 0 {
+		resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), volume)
+		return types.NewPriceResponse(resolved, unresolved), nil
+	}
+
 	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 	return types.NewPriceResponse(resolved, unresolved), nil
 }
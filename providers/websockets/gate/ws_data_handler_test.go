@@ -116,6 +116,39 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        false,
 		},
+		{
+			name: "ticker stream price update with quote volume is weighted",
+			msg: func() []byte {
+				msg := gate.TickerStream{
+					BaseMessage: gate.BaseMessage{
+						Time:    0,
+						Channel: string(gate.ChannelTickers),
+						Event:   string(gate.EventUpdate),
+					},
+					Result: gate.TickerResult{
+						CurrencyPair: "BTC_USDT",
+						Last:         "1",
+						QuoteVolume:  "145082083.2535",
+					},
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return bz
+			},
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {
+						Value:  big.NewFloat(1.00),
+						Weight: big.NewFloat(145082083.2535),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 		{
 			name: "price update with unknown currency pair",
 			msg: func() []byte {
@@ -226,6 +259,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Weight == nil {
+					require.Nil(t, resp.Resolved[cp].Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), resp.Resolved[cp].Weight.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -84,4 +84,6 @@ type TickerStream struct {
 // Tick is the tick payload attached to a TickerStream message.
 type Tick struct {
 	LastPrice float64 `json:"lastPrice"`
+	// Vol is the 24h volume of the ticker denominated in the quote asset.
+	Vol float64 `json:"vol"`
 }
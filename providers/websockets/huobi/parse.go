@@ -48,6 +48,13 @@ func (h *WebSocketHandler) parseTickerStream(stream TickerStream) (types.PriceRe
 	}
 
 	price := big.NewFloat(stream.Tick.LastPrice)
+
+	// Weight the price by the 24h quote volume if the volume is included in the message.
+	if stream.Tick.Vol > 0 {
+		resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), big.NewFloat(stream.Tick.Vol))
+		return types.NewPriceResponse(resolved, unresolved), nil
+	}
+
 	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 
 	return types.NewPriceResponse(resolved, unresolved), nil
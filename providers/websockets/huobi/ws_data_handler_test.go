@@ -101,6 +101,38 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        false,
 		},
+		{
+			name: "ticker price update with volume is weighted by quote volume",
+			msg: func() []byte {
+				msg := huobi.TickerStream{
+					Channel: "market.btcusdt.ticker",
+					Tick:    huobi.Tick{LastPrice: 1, Vol: 2500},
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				zw := gzip.NewWriter(&buf)
+
+				_, err = zw.Write(bz)
+				require.NoError(t, err)
+				require.NoError(t, zw.Close())
+
+				return buf.Bytes()
+			},
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {
+						Value:  big.NewFloat(1),
+						Weight: big.NewFloat(2500),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 		{
 			name: "ticker price update with unknown ticker ID",
 			msg: func() []byte {
@@ -278,6 +310,7 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value, resp.Resolved[cp].Value)
+				require.Equal(t, result.Weight, resp.Resolved[cp].Weight)
 				require.Equal(t, result.ResponseCode, resp.Resolved[cp].ResponseCode)
 			}
 
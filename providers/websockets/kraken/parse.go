@@ -3,6 +3,7 @@ package kraken
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"time"
 
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
@@ -116,6 +117,16 @@ func (h *WebSocketHandler) parseTickerMessage(
 		return types.NewPriceResponse(resolved, unResolved), unResolved[ticker]
 	}
 
+	// Weight the price by the 24h quote volume, i.e. the 24h base volume valued at the price.
+	if len(resp.TickerData.Volume) > Last24HoursVolumeIndex {
+		volume, err := math.Float64StringToBigFloat(resp.TickerData.Volume[Last24HoursVolumeIndex])
+		if err == nil && volume.Sign() > 0 {
+			weight := new(big.Float).Mul(volume, price)
+			resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), weight)
+			return types.NewPriceResponse(resolved, unResolved), nil
+		}
+	}
+
 	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
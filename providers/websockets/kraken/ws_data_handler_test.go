@@ -43,7 +43,8 @@ func TestHandleMessage(t *testing.T) {
 			resp: types.PriceResponse{
 				Resolved: types.ResolvedPrices{
 					btcusd: {
-						Value: big.NewFloat(42596.41907000),
+						Value:  big.NewFloat(42596.41907000),
+						Weight: new(big.Float).Mul(big.NewFloat(2075.61202911), big.NewFloat(42596.41907000)),
 					},
 				},
 				UnResolved: types.UnResolvedPrices{},
@@ -224,6 +225,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Weight == nil {
+					require.Nil(t, resp.Resolved[cp].Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), resp.Resolved[cp].Weight.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -399,6 +405,7 @@ func TestDecodeTickerResponseMessage(t *testing.T) {
 				ChannelID: 340,
 				TickerData: kraken.TickerData{
 					VolumeWeightedAveragePrice: []string{"42596.41907", "42598.31137"},
+					Volume:                     []string{"2068.49653432", "2075.61202911"},
 				},
 				ChannelName: "ticker",
 				Pair:        "XBT/USD",
@@ -217,4 +217,8 @@ type TickerData struct {
 
 	// Price is the latest price for the currency pair i.e. market.
 	Price string `json:"p"`
+
+	// Volume is the 24h volume for the currency pair i.e. market. As the example above shows,
+	// this is denominated in the quote asset, i.e. it is roughly the base volume "q" times the price.
+	Volume string `json:"v"`
 }
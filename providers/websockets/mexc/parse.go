@@ -45,6 +45,12 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 		return types.NewPriceResponse(resolved, unResolved), err
 	}
 
+	// Weight the price by the 24h quote volume if the volume is included in the message.
+	if volume, err := math.Float64StringToBigFloat(msg.Data.Volume); err == nil && volume.Sign() > 0 {
+		resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), volume)
+		return types.NewPriceResponse(resolved, unResolved), nil
+	}
+
 	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
@@ -87,6 +87,25 @@ func TestHandleMessage(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "price update message with volume is weighted by quote volume",
+			msg: func() []byte {
+				msg := `{"c":"spot@public.miniTicker.v3.api@BTCUSDT@UTC+8","d":{"s":"BTCUSDT","p":"10000.00","v":"375173478.65","q":"37517.35"}}`
+				return []byte(msg)
+			},
+			resp: types.PriceResponse{
+				Resolved: types.ResolvedPrices{
+					btcusdt: {
+						Value:  big.NewFloat(10000.00),
+						Weight: big.NewFloat(375173478.65),
+					},
+				},
+			},
+			updateMessage: func() []handlers.WebsocketEncodedMessage {
+				return nil
+			},
+			expErr: false,
+		},
 		{
 			name: "unsupported market price update",
 			msg: func() []byte {
@@ -166,6 +185,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Weight == nil {
+					require.Nil(t, resp.Resolved[cp].Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), resp.Resolved[cp].Weight.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
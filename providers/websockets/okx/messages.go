@@ -214,4 +214,7 @@ type IndexTicker struct {
 
 	// LastPrice is the last price.
 	LastPrice string `json:"last" validate:"required"`
+
+	// QuoteVolume is the 24h volume denominated in the quote currency for spot instruments.
+	QuoteVolume string `json:"volCcy24h"`
 }
@@ -100,6 +100,12 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 			continue
 		}
 
+		// Weight the price by the 24h quote volume if the volume is included in the message.
+		if volume, err := math.Float64StringToBigFloat(instrument.QuoteVolume); err == nil && volume.Sign() > 0 {
+			resolved[ticker] = types.NewPriceResultWithWeight(price, time.Now().UTC(), volume)
+			continue
+		}
+
 		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
 	}
 
@@ -107,8 +107,9 @@ func TestHandleMessage(t *testing.T) {
 							LastPrice: "1",
 						},
 						{
-							ID:        "ETH-USDT",
-							LastPrice: "2",
+							ID:          "ETH-USDT",
+							LastPrice:   "2",
+							QuoteVolume: "2222",
 						},
 					},
 				}
@@ -124,7 +125,8 @@ func TestHandleMessage(t *testing.T) {
 						Value: big.NewFloat(1.0),
 					},
 					ethusdt: {
-						Value: big.NewFloat(2.0),
+						Value:  big.NewFloat(2.0),
+						Weight: big.NewFloat(2222),
 					},
 				},
 				types.UnResolvedPrices{},
@@ -318,6 +320,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Weight == nil {
+					require.Nil(t, resp.Resolved[cp].Weight)
+				} else {
+					require.Equal(t, result.Weight.SetPrec(18), resp.Resolved[cp].Weight.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
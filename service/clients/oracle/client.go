@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/skip-mev/connect/v2/oracle/config"
@@ -39,10 +40,14 @@ type GRPCClient struct {
 	metrics metrics.Metrics
 	// blockingDial is a parameter which determines whether the client should block on dialing the server
 	blockingDial bool
+	// tls is the TLS configuration used to connect to the server. If TLS is disabled, the client
+	// connects over plaintext.
+	tls config.ClientTLSConfig
 }
 
 // NewClientFromConfig creates a new grpc client of the oracle service with the given
-// app configuration. This returns an error if the configuration is invalid.
+// app configuration. If fallback addresses are configured, the client fails over across
+// every configured sidecar. This returns an error if the configuration is invalid.
 func NewClientFromConfig(
 	cfg config.AppConfig,
 	logger log.Logger,
@@ -65,7 +70,26 @@ func NewClientFromConfig(
 		return nil, fmt.Errorf("metrics cannot be nil")
 	}
 
-	return NewClient(logger, cfg.OracleAddress, cfg.ClientTimeout, metrics, opts...)
+	// the config is applied first, so that it can be overridden by the given options
+	opts = append([]Option{WithTLSConfig(cfg.TLS), WithSidecarMode(cfg.SidecarMode)}, opts...)
+
+	addresses := cfg.Addresses()
+	if len(addresses) == 1 {
+		return NewClient(logger, cfg.OracleAddress, cfg.ClientTimeout, metrics, opts...)
+	}
+
+	// connect to every sidecar, and fail over across them
+	sidecars := make([]Sidecar, 0, len(addresses))
+	for _, addr := range addresses {
+		client, err := NewClient(logger, addr, cfg.ClientTimeout, metrics, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		sidecars = append(sidecars, Sidecar{Address: addr, Client: client})
+	}
+
+	return NewFailoverClient(logger, sidecars, cfg.PriceTTL, opts...)
 }
 
 // NewPriceDaemonClientFromConfig creates a new grpc client of the oracle service with the given
@@ -130,8 +154,18 @@ func NewClient(
 func (c *GRPCClient) Start(ctx context.Context) error {
 	c.logger.Info("starting oracle client", "addr", c.addr)
 
+	creds := insecure.NewCredentials()
+	if c.tls.Enabled {
+		tlsCfg, err := newTLSConfig(c.tls)
+		if err != nil {
+			c.logger.Error("failed to load oracle client tls config", "err", err)
+			return fmt.Errorf("failed to load oracle client tls config: %w", err)
+		}
+		creds = credentials.NewTLS(tlsCfg)
+	}
+
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 	}
 
 	// dial the client, but defer to context closure, if necessary
@@ -264,3 +298,136 @@ func (c *GRPCClient) Version(ctx context.Context, req *types.QueryVersionRequest
 
 	return c.client.Version(ctx, req, grpc.WaitForReady(true))
 }
+
+// ProviderHealth returns the health of every (provider, ticker) pair from the oracle service.
+func (c *GRPCClient) ProviderHealth(
+	ctx context.Context,
+	req *types.QueryProviderHealthRequest,
+	_ ...grpc.CallOption,
+) (res *types.QueryProviderHealthResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.ProviderHealth(ctx, req, grpc.WaitForReady(true))
+}
+
+// PriceHistory returns the price records retained by the oracle service that match the request.
+func (c *GRPCClient) PriceHistory(
+	ctx context.Context,
+	req *types.QueryPriceHistoryRequest,
+	_ ...grpc.CallOption,
+) (res *types.QueryPriceHistoryResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.PriceHistory(ctx, req, grpc.WaitForReady(true))
+}
+
+// ProviderPrices returns the price of each provider config of every ticker from the oracle
+// service.
+func (c *GRPCClient) ProviderPrices(
+	ctx context.Context,
+	req *types.QueryProviderPricesRequest,
+	_ ...grpc.CallOption,
+) (res *types.QueryProviderPricesResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.ProviderPrices(ctx, req, grpc.WaitForReady(true))
+}
+
+// ProviderStatus returns the status of every provider run by the oracle service.
+func (c *GRPCClient) ProviderStatus(
+	ctx context.Context,
+	req *types.QueryProviderStatusRequest,
+	_ ...grpc.CallOption,
+) (res *types.QueryProviderStatusResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.ProviderStatus(ctx, req, grpc.WaitForReady(true))
+}
+
+// StreamPrices opens a stream of the prices from the oracle service. The stream is not subject to
+// the client's timeout, and ends when the given context is cancelled.
+func (c *GRPCClient) StreamPrices(
+	ctx context.Context,
+	req *types.QueryStreamPricesRequest,
+	_ ...grpc.CallOption,
+) (stream types.Oracle_StreamPricesClient, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.StreamPrices(ctx, req, grpc.WaitForReady(true))
+}
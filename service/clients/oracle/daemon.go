@@ -109,13 +109,28 @@ func (d *PriceDaemon) fetchPrices(ctx context.Context) {
 		return
 	}
 
+	// the oracle may keep serving the last prices it aggregated if its aggregation has
+	// stalled, so prices that were last updated longer than the ttl ago are not stored.
 	ts := time.Now()
+	if err := CheckPricesAge(resp, d.config.PriceTTL, ts); err != nil {
+		d.logger.Error(
+			"sidecar returned stale prices",
+			"err", err,
+			"address", d.config.OracleAddress,
+			"oracle_timestamp", resp.Timestamp.String(),
+			"ttl", d.config.PriceTTL.String(),
+		)
+
+		return
+	}
+
 	d.logger.Debug("fetched prices", "timestamp", ts, "prices", resp.Prices)
 	d.resp.Update(resp)
 }
 
 // Prices returns the latest price response fetched by the daemon. If the latest response
-// is too stale, an error is returned.
+// was fetched too long ago, or the oracle last updated its prices too long ago, an error is
+// returned.
 func (d *PriceDaemon) Prices(
 	_ context.Context,
 	_ *types.QueryPricesRequest,
@@ -142,6 +157,17 @@ func (d *PriceDaemon) Prices(
 		)
 	}
 
+	if err := CheckPricesAge(latest, d.config.PriceTTL, time.Now()); err != nil {
+		d.logger.Error(
+			"latest prices from the price daemon are stale",
+			"err", err,
+			"oracle_timestamp", latest.Timestamp.String(),
+			"ttl", d.config.PriceTTL.String(),
+		)
+
+		return nil, err
+	}
+
 	return latest, nil
 }
 
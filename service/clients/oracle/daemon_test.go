@@ -123,7 +123,8 @@ func TestPriceDaemon_Start(t *testing.T) {
 		client := mocks.NewOracleClient(t)
 		client.On("Start", mock.Anything).Return(nil).Once()
 		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{
-			Prices: prices,
+			Prices:    prices,
+			Timestamp: time.Now(),
 		}, nil).Maybe()
 		client.On("Stop").Return(nil).Once()
 
@@ -159,7 +160,7 @@ func TestPriceDaemon_Start(t *testing.T) {
 
 		client := mocks.NewOracleClient(t)
 		client.On("Start", mock.Anything).Return(nil).Once()
-		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{Prices: prices}, nil).Once()
+		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{Prices: prices, Timestamp: time.Now()}, nil).Once()
 		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{}, fmt.Errorf("failed to make request")).Maybe()
 		client.On("Stop").Return(nil).Once()
 
@@ -185,6 +186,61 @@ func TestPriceDaemon_Start(t *testing.T) {
 		require.Nil(t, resp)
 	})
 
+	t.Run("does not store prices that the oracle last updated longer than the ttl ago", func(t *testing.T) {
+		client := mocks.NewOracleClient(t)
+		client.On("Start", mock.Anything).Return(nil).Once()
+		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{
+			Prices:    map[string]string{"btc/usd": "10000"},
+			Timestamp: time.Now().Add(-2 * cfg.PriceTTL),
+		}, nil).Maybe()
+		client.On("Stop").Return(nil).Once()
+
+		d, err := oracle.NewPriceDaemon(logger, cfg, client)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(time.Millisecond * 300)
+			cancel()
+		}()
+
+		err = d.Start(ctx)
+		require.Equal(t, err, context.Canceled)
+
+		resp, err := d.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.Error(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("will return an error if the oracle last updated the stored prices longer than the ttl ago", func(t *testing.T) {
+		client := mocks.NewOracleClient(t)
+		client.On("Start", mock.Anything).Return(nil).Once()
+		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{
+			Prices:    map[string]string{"btc/usd": "10000"},
+			Timestamp: time.Now().Add(-cfg.PriceTTL / 2),
+		}, nil).Once()
+		client.On("Prices", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("failed to make request")).Maybe()
+		client.On("Stop").Return(nil).Once()
+
+		d, err := oracle.NewPriceDaemon(logger, cfg, client)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(time.Millisecond * 150)
+			cancel()
+		}()
+
+		err = d.Start(ctx)
+		require.Equal(t, err, context.Canceled)
+
+		// the prices were fetched recently, but were last updated by the oracle over a ttl ago
+		time.Sleep(cfg.PriceTTL / 2)
+		resp, err := d.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.ErrorAs(t, err, &oracle.StalePricesError{})
+		require.Nil(t, resp)
+	})
+
 	t.Run("returns an error if it never started", func(t *testing.T) {
 		client := mocks.NewOracleClient(t)
 		d, err := oracle.NewPriceDaemon(logger, cfg, client)
@@ -0,0 +1,50 @@
+package oracle
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/skip-mev/connect/v2/service/servers/oracle/types"
+)
+
+// StalePricesError is returned when the oracle last updated its prices longer ago than the
+// maximum age of the prices, e.g. because the oracle's aggregation has stalled.
+type StalePricesError struct {
+	// Timestamp is the time the oracle last updated its prices.
+	Timestamp time.Time
+	// Age is the age of the prices when they were checked.
+	Age time.Duration
+	// MaxAge is the maximum age of the prices.
+	MaxAge time.Duration
+}
+
+func (e StalePricesError) Error() string {
+	return fmt.Sprintf(
+		"prices are stale; last updated at %s, %s ago, which exceeds the max age of %s",
+		e.Timestamp.Format(time.RFC3339),
+		e.Age,
+		e.MaxAge,
+	)
+}
+
+func (e StalePricesError) Label() string {
+	return "StalePricesError"
+}
+
+// CheckPricesAge returns a StalePricesError if the prices of the response were last updated
+// longer than maxAge before now. The age of the prices is not checked if maxAge is zero.
+func CheckPricesAge(resp *types.QueryPricesResponse, maxAge time.Duration, now time.Time) error {
+	if maxAge <= 0 {
+		return nil
+	}
+
+	if age := now.Sub(resp.Timestamp); age > maxAge {
+		return StalePricesError{
+			Timestamp: resp.Timestamp,
+			Age:       age,
+			MaxAge:    maxAge,
+		}
+	}
+
+	return nil
+}
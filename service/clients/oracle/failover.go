@@ -0,0 +1,479 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"google.golang.org/grpc"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/service/servers/oracle/types"
+)
+
+var _ OracleClient = (*FailoverClient)(nil)
+
+// DefaultSidecarRetryInterval is the default time between attempts to reach an unhealthy
+// sidecar.
+const DefaultSidecarRetryInterval = 10 * time.Second
+
+// Sidecar is an oracle sidecar that is queried by the FailoverClient.
+type Sidecar struct {
+	// Address is the address of the sidecar, used to identify it in logs and health reports.
+	Address string
+	// Client is the client connected to the sidecar.
+	Client OracleClient
+}
+
+// SidecarHealth is the health of a sidecar, as tracked by the FailoverClient.
+type SidecarHealth struct {
+	// Address is the address of the sidecar.
+	Address string
+	// Healthy is true if the last price request to the sidecar returned fresh prices.
+	Healthy bool
+	// ConsecutiveFailures is the number of price requests to the sidecar that have failed
+	// since it last returned fresh prices.
+	ConsecutiveFailures int
+	// LastError is the error of the last failed price request, if any.
+	LastError error
+	// LastFailure is the time of the last failed price request, if any.
+	LastFailure time.Time
+}
+
+// FailoverClient is an OracleClient that queries a list of oracle sidecars in order of
+// preference, so that the application keeps receiving prices when a sidecar fails. A sidecar is
+// unhealthy after it errors or returns prices that are older than the max age, and is skipped
+// until it recovers. Unhealthy sidecars are retried in the background once per retry interval,
+// so that the preferred sidecar is used again as soon as it recovers.
+//
+// In SidecarModeFailover, the first healthy sidecar that returns fresh prices is used. In
+// SidecarModeMedian, every healthy sidecar is queried and the median price of each ticker is
+// used. If every sidecar is unhealthy, every sidecar is queried.
+type FailoverClient struct {
+	logger log.Logger
+
+	// sidecars are the sidecars, in order of preference.
+	sidecars []Sidecar
+	// maxAge is the maximum age of the prices returned by a sidecar. If this is zero, the
+	// age of the prices is not checked.
+	maxAge time.Duration
+	// mode is either config.SidecarModeFailover or config.SidecarModeMedian.
+	mode string
+	// retryInterval is the time between attempts to reach an unhealthy sidecar.
+	retryInterval time.Duration
+
+	mtx sync.Mutex
+	// health is the health of each sidecar, indexed like sidecars.
+	health []SidecarHealth
+	// probing is true for each unhealthy sidecar that is being retried in the background.
+	probing []bool
+
+	// probeCtx is cancelled when the client is stopped, to end any background retries.
+	probeCtx     context.Context
+	cancelProbes context.CancelFunc
+}
+
+// NewFailoverClient returns a new FailoverClient that queries the given sidecars in order of
+// preference. Prices older than maxAge are considered stale; if maxAge is zero, the age of the
+// prices is not checked.
+func NewFailoverClient(
+	logger log.Logger,
+	sidecars []Sidecar,
+	maxAge time.Duration,
+	opts ...Option,
+) (*FailoverClient, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if len(sidecars) == 0 {
+		return nil, fmt.Errorf("at least one sidecar is required")
+	}
+
+	for _, sidecar := range sidecars {
+		if sidecar.Client == nil {
+			return nil, fmt.Errorf("client of sidecar %s cannot be nil", sidecar.Address)
+		}
+	}
+
+	if maxAge < 0 {
+		return nil, fmt.Errorf("max age cannot be negative")
+	}
+
+	client := &FailoverClient{
+		logger:        logger.With("process", "failover_client"),
+		sidecars:      sidecars,
+		maxAge:        maxAge,
+		mode:          config.SidecarModeFailover,
+		retryInterval: DefaultSidecarRetryInterval,
+		health:        make([]SidecarHealth, len(sidecars)),
+		probing:       make([]bool, len(sidecars)),
+	}
+	for i, sidecar := range sidecars {
+		client.health[i] = SidecarHealth{Address: sidecar.Address, Healthy: true}
+	}
+
+	// apply options
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	client.probeCtx, client.cancelProbes = context.WithCancel(context.Background())
+	return client, nil
+}
+
+// Start starts the client of every sidecar. An error is only returned if no client could be
+// started.
+func (c *FailoverClient) Start(ctx context.Context) error {
+	var errs []error
+	for _, sidecar := range c.sidecars {
+		if err := sidecar.Client.Start(ctx); err != nil {
+			c.logger.Error("failed to start oracle sidecar client", "address", sidecar.Address, "err", err)
+			errs = append(errs, fmt.Errorf("oracle sidecar %s: %w", sidecar.Address, err))
+		}
+	}
+
+	if len(errs) == len(c.sidecars) {
+		return fmt.Errorf("failed to start any oracle sidecar client: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Stop stops the client of every sidecar.
+func (c *FailoverClient) Stop() error {
+	c.cancelProbes()
+
+	var errs []error
+	for _, sidecar := range c.sidecars {
+		errs = append(errs, sidecar.Client.Stop())
+	}
+
+	return errors.Join(errs...)
+}
+
+// Health returns the health of every sidecar, in order of preference.
+func (c *FailoverClient) Health() []SidecarHealth {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return slices.Clone(c.health)
+}
+
+// Prices returns fresh prices from the first healthy sidecar in SidecarModeFailover, or the
+// median price of each ticker across the healthy sidecars in SidecarModeMedian. An error is
+// returned if no sidecar returned fresh prices.
+func (c *FailoverClient) Prices(
+	ctx context.Context,
+	req *types.QueryPricesRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryPricesResponse, error) {
+	if c.mode == config.SidecarModeMedian {
+		return c.medianPrices(ctx, req)
+	}
+
+	var errs []error
+	for _, i := range c.candidates() {
+		resp, err := c.fetchPrices(ctx, i, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		errs = append(errs, err)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return nil, fmt.Errorf("failed to fetch prices from any oracle sidecar: %w", errors.Join(errs...))
+}
+
+// medianPrices queries every candidate sidecar concurrently, and returns the median price of
+// each ticker across the sidecars that returned fresh prices.
+func (c *FailoverClient) medianPrices(
+	ctx context.Context,
+	req *types.QueryPricesRequest,
+) (*types.QueryPricesResponse, error) {
+	candidates := c.candidates()
+	responses := make([]*types.QueryPricesResponse, len(candidates))
+	errs := make([]error, len(candidates))
+
+	var wg sync.WaitGroup
+	for j, i := range candidates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[j], errs[j] = c.fetchPrices(ctx, i, req)
+		}()
+	}
+	wg.Wait()
+
+	// responses are kept in order of preference
+	responses = slices.DeleteFunc(responses, func(resp *types.QueryPricesResponse) bool {
+		return resp == nil
+	})
+	if len(responses) == 0 {
+		return nil, fmt.Errorf("failed to fetch prices from any oracle sidecar: %w", errors.Join(errs...))
+	}
+
+	return medianResponse(responses), nil
+}
+
+// fetchPrices fetches prices from the sidecar, and records whether the sidecar returned fresh
+// prices.
+func (c *FailoverClient) fetchPrices(
+	ctx context.Context,
+	i int,
+	req *types.QueryPricesRequest,
+) (*types.QueryPricesResponse, error) {
+	resp, err := c.sidecars[i].Client.Prices(ctx, req)
+	if err == nil {
+		err = c.checkFresh(resp)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("oracle sidecar %s: %w", c.sidecars[i].Address, err)
+		c.recordFailure(i, err)
+		return nil, err
+	}
+
+	c.recordSuccess(i)
+	return resp, nil
+}
+
+// checkFresh returns an error if the response has no prices or its prices are older than the
+// max age.
+func (c *FailoverClient) checkFresh(resp *types.QueryPricesResponse) error {
+	if resp == nil {
+		return fmt.Errorf("empty price response")
+	}
+
+	return CheckPricesAge(resp, c.maxAge, time.Now())
+}
+
+// candidates returns the indices of the healthy sidecars in order of preference, or of every
+// sidecar if none is healthy. Unhealthy sidecars that are due to be retried are retried in the
+// background.
+func (c *FailoverClient) candidates() []int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	healthy := make([]int, 0, len(c.health))
+	for i, health := range c.health {
+		if health.Healthy {
+			healthy = append(healthy, i)
+			continue
+		}
+
+		if !c.probing[i] && time.Since(health.LastFailure) >= c.retryInterval {
+			c.probing[i] = true
+			go c.probe(i)
+		}
+	}
+
+	if len(healthy) > 0 {
+		return healthy
+	}
+
+	all := make([]int, len(c.health))
+	for i := range all {
+		all[i] = i
+	}
+
+	return all
+}
+
+// probe retries an unhealthy sidecar, which is healthy again if it returns fresh prices.
+func (c *FailoverClient) probe(i int) {
+	defer func() {
+		c.mtx.Lock()
+		c.probing[i] = false
+		c.mtx.Unlock()
+	}()
+
+	_, _ = c.fetchPrices(c.probeCtx, i, &types.QueryPricesRequest{})
+}
+
+// recordSuccess marks the sidecar as healthy.
+func (c *FailoverClient) recordSuccess(i int) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	health := &c.health[i]
+	if !health.Healthy {
+		c.logger.Info(
+			"oracle sidecar recovered",
+			"address", health.Address,
+			"failures", health.ConsecutiveFailures,
+		)
+	}
+
+	health.Healthy = true
+	health.ConsecutiveFailures = 0
+}
+
+// recordFailure marks the sidecar as unhealthy.
+func (c *FailoverClient) recordFailure(i int, err error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	health := &c.health[i]
+	if health.Healthy {
+		c.logger.Error("oracle sidecar is unhealthy", "address", health.Address, "err", err)
+	}
+
+	health.Healthy = false
+	health.ConsecutiveFailures++
+	health.LastError = err
+	health.LastFailure = time.Now()
+}
+
+// medianResponse returns a response with the median price of each ticker across the given
+// responses, which must be in order of preference. The timestamp of the response is the oldest
+// timestamp of the given responses, and the version is that of the most preferred response. The
+// dispersions and priority tiers are specific to each sidecar, and are not included.
+func medianResponse(responses []*types.QueryPricesResponse) *types.QueryPricesResponse {
+	median := &types.QueryPricesResponse{
+		Prices:    make(map[string]string),
+		Timestamp: responses[0].Timestamp,
+		Version:   responses[0].Version,
+	}
+
+	prices := make(map[string][]*big.Int)
+	for _, resp := range responses {
+		if resp.Timestamp.Before(median.Timestamp) {
+			median.Timestamp = resp.Timestamp
+		}
+
+		for ticker, price := range resp.Prices {
+			value, ok := new(big.Int).SetString(price, 10)
+			if !ok {
+				continue
+			}
+
+			prices[ticker] = append(prices[ticker], value)
+		}
+	}
+
+	for ticker, values := range prices {
+		slices.SortFunc(values, func(a, b *big.Int) int {
+			return a.Cmp(b)
+		})
+
+		// average the two middle values if the number of values is even
+		middle := len(values) / 2
+		value := values[middle]
+		if len(values)%2 == 0 {
+			value = new(big.Int).Add(values[middle-1], values[middle])
+			value.Quo(value, big.NewInt(2))
+		}
+
+		median.Prices[ticker] = value.String()
+	}
+
+	return median
+}
+
+// query calls the given function with the client of each candidate sidecar in order of
+// preference, and returns the first successful response. Unlike prices, the responses of other
+// queries do not affect the health of the sidecars.
+func query[T any](ctx context.Context, c *FailoverClient, fn func(OracleClient) (T, error)) (T, error) {
+	var errs []error
+	for _, i := range c.candidates() {
+		resp, err := fn(c.sidecars[i].Client)
+		if err == nil {
+			return resp, nil
+		}
+
+		errs = append(errs, fmt.Errorf("oracle sidecar %s: %w", c.sidecars[i].Address, err))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	var zero T
+	return zero, fmt.Errorf("failed to query any oracle sidecar: %w", errors.Join(errs...))
+}
+
+// MarketMap returns the market map from the first healthy sidecar that responds.
+func (c *FailoverClient) MarketMap(
+	ctx context.Context,
+	req *types.QueryMarketMapRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryMarketMapResponse, error) {
+	return query(ctx, c, func(client OracleClient) (*types.QueryMarketMapResponse, error) {
+		return client.MarketMap(ctx, req)
+	})
+}
+
+// Version returns the version of the first healthy sidecar that responds.
+func (c *FailoverClient) Version(
+	ctx context.Context,
+	req *types.QueryVersionRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryVersionResponse, error) {
+	return query(ctx, c, func(client OracleClient) (*types.QueryVersionResponse, error) {
+		return client.Version(ctx, req)
+	})
+}
+
+// ProviderHealth returns the provider health from the first healthy sidecar that responds.
+func (c *FailoverClient) ProviderHealth(
+	ctx context.Context,
+	req *types.QueryProviderHealthRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProviderHealthResponse, error) {
+	return query(ctx, c, func(client OracleClient) (*types.QueryProviderHealthResponse, error) {
+		return client.ProviderHealth(ctx, req)
+	})
+}
+
+// PriceHistory returns the price history from the first healthy sidecar that responds.
+func (c *FailoverClient) PriceHistory(
+	ctx context.Context,
+	req *types.QueryPriceHistoryRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryPriceHistoryResponse, error) {
+	return query(ctx, c, func(client OracleClient) (*types.QueryPriceHistoryResponse, error) {
+		return client.PriceHistory(ctx, req)
+	})
+}
+
+// ProviderPrices returns the provider prices from the first healthy sidecar that responds.
+func (c *FailoverClient) ProviderPrices(
+	ctx context.Context,
+	req *types.QueryProviderPricesRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProviderPricesResponse, error) {
+	return query(ctx, c, func(client OracleClient) (*types.QueryProviderPricesResponse, error) {
+		return client.ProviderPrices(ctx, req)
+	})
+}
+
+// ProviderStatus returns the provider statuses from the first healthy sidecar that responds.
+func (c *FailoverClient) ProviderStatus(
+	ctx context.Context,
+	req *types.QueryProviderStatusRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProviderStatusResponse, error) {
+	return query(ctx, c, func(client OracleClient) (*types.QueryProviderStatusResponse, error) {
+		return client.ProviderStatus(ctx, req)
+	})
+}
+
+// StreamPrices opens a stream of the prices from the first healthy sidecar that accepts it. The
+// stream does not fail over if the sidecar fails after the stream is opened.
+func (c *FailoverClient) StreamPrices(
+	ctx context.Context,
+	req *types.QueryStreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	return query(ctx, c, func(client OracleClient) (types.Oracle_StreamPricesClient, error) {
+		return client.StreamPrices(ctx, req)
+	})
+}
@@ -0,0 +1,308 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/service/clients/oracle"
+	"github.com/skip-mev/connect/v2/service/clients/oracle/mocks"
+	"github.com/skip-mev/connect/v2/service/metrics"
+	"github.com/skip-mev/connect/v2/service/servers/oracle/types"
+)
+
+const maxAge = 10 * time.Second
+
+// pricesResponse returns a price response with the given prices, updated at the given time.
+func pricesResponse(timestamp time.Time, prices map[string]string) *types.QueryPricesResponse {
+	return &types.QueryPricesResponse{
+		Prices:    prices,
+		Timestamp: timestamp,
+	}
+}
+
+// sidecarResult is the result of a price request to a mocked sidecar. If resp and err are both
+// nil, the sidecar is expected not to be queried.
+type sidecarResult struct {
+	resp *types.QueryPricesResponse
+	err  error
+}
+
+// newSidecars returns a mocked sidecar for each of the results.
+func newSidecars(t *testing.T, results ...sidecarResult) []oracle.Sidecar {
+	t.Helper()
+
+	sidecars := make([]oracle.Sidecar, len(results))
+	for i, result := range results {
+		client := mocks.NewOracleClient(t)
+		if result.resp != nil || result.err != nil {
+			client.EXPECT().Prices(mock.Anything, mock.Anything).Return(result.resp, result.err)
+		}
+
+		sidecars[i] = oracle.Sidecar{Address: fmt.Sprintf("sidecar-%d", i), Client: client}
+	}
+
+	return sidecars
+}
+
+func TestNewFailoverClient(t *testing.T) {
+	t.Run("fails without sidecars", func(t *testing.T) {
+		_, err := oracle.NewFailoverClient(log.NewNopLogger(), nil, maxAge)
+		require.Error(t, err)
+	})
+
+	t.Run("fails with a nil sidecar client", func(t *testing.T) {
+		_, err := oracle.NewFailoverClient(log.NewNopLogger(), []oracle.Sidecar{{Address: "sidecar"}}, maxAge)
+		require.Error(t, err)
+	})
+
+	t.Run("is created from a config with fallback addresses", func(t *testing.T) {
+		client, err := oracle.NewClientFromConfig(config.AppConfig{
+			Enabled:           true,
+			OracleAddress:     "localhost:8080",
+			FallbackAddresses: []string{"localhost:8081"},
+			ClientTimeout:     time.Second,
+			Interval:          time.Second,
+			PriceTTL:          2 * time.Second,
+		}, log.NewNopLogger(), metrics.NewNopMetrics())
+		require.NoError(t, err)
+		require.IsType(t, &oracle.FailoverClient{}, client)
+
+		health := client.(*oracle.FailoverClient).Health()
+		require.Len(t, health, 2)
+		require.Equal(t, "localhost:8080", health[0].Address)
+		require.Equal(t, "localhost:8081", health[1].Address)
+	})
+}
+
+func TestFailoverClientPrices(t *testing.T) {
+	now := time.Now()
+	primary := pricesResponse(now, map[string]string{"BTC/USD": "100"})
+	secondary := pricesResponse(now, map[string]string{"BTC/USD": "101"})
+	stale := pricesResponse(now.Add(-2*maxAge), map[string]string{"BTC/USD": "99"})
+
+	testCases := []struct {
+		name            string
+		results         []sidecarResult
+		expected        *types.QueryPricesResponse
+		expectedHealthy []bool
+	}{
+		{
+			name: "uses the preferred sidecar",
+			results: []sidecarResult{
+				{resp: primary},
+				{},
+			},
+			expected:        primary,
+			expectedHealthy: []bool{true, true},
+		},
+		{
+			name: "fails over if the preferred sidecar errors",
+			results: []sidecarResult{
+				{err: fmt.Errorf("connection refused")},
+				{resp: secondary},
+			},
+			expected:        secondary,
+			expectedHealthy: []bool{false, true},
+		},
+		{
+			name: "fails over if the preferred sidecar returns stale prices",
+			results: []sidecarResult{
+				{resp: stale},
+				{resp: secondary},
+			},
+			expected:        secondary,
+			expectedHealthy: []bool{false, true},
+		},
+		{
+			name: "errors if every sidecar fails",
+			results: []sidecarResult{
+				{err: fmt.Errorf("connection refused")},
+				{resp: stale},
+			},
+			expectedHealthy: []bool{false, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := oracle.NewFailoverClient(
+				log.NewNopLogger(),
+				newSidecars(t, tc.results...),
+				maxAge,
+				oracle.WithSidecarRetryInterval(time.Hour),
+			)
+			require.NoError(t, err)
+
+			resp, err := client.Prices(context.Background(), &types.QueryPricesRequest{})
+			if tc.expected == nil {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, resp)
+			}
+
+			for i, health := range client.Health() {
+				require.Equal(t, tc.expectedHealthy[i], health.Healthy, "sidecar %d", i)
+			}
+		})
+	}
+
+	t.Run("skips an unhealthy sidecar until it is retried", func(t *testing.T) {
+		sidecars := newSidecars(t, sidecarResult{}, sidecarResult{resp: secondary})
+		sidecars[0].Client.(*mocks.OracleClient).EXPECT().
+			Prices(mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("connection refused")).
+			Once()
+
+		client, err := oracle.NewFailoverClient(
+			log.NewNopLogger(),
+			sidecars,
+			maxAge,
+			oracle.WithSidecarRetryInterval(time.Hour),
+		)
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			resp, err := client.Prices(context.Background(), &types.QueryPricesRequest{})
+			require.NoError(t, err)
+			require.Equal(t, secondary, resp)
+		}
+
+		health := client.Health()
+		require.False(t, health[0].Healthy)
+		require.Equal(t, 1, health[0].ConsecutiveFailures)
+		require.ErrorContains(t, health[0].LastError, "connection refused")
+	})
+
+	t.Run("uses the preferred sidecar again once it recovers", func(t *testing.T) {
+		sidecars := newSidecars(t, sidecarResult{}, sidecarResult{resp: secondary})
+		preferred := sidecars[0].Client.(*mocks.OracleClient)
+		preferred.EXPECT().Prices(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("connection refused")).Once()
+		preferred.EXPECT().Prices(mock.Anything, mock.Anything).Return(primary, nil)
+
+		client, err := oracle.NewFailoverClient(
+			log.NewNopLogger(),
+			sidecars,
+			maxAge,
+			oracle.WithSidecarRetryInterval(time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		resp, err := client.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, secondary, resp)
+
+		// the preferred sidecar is retried in the background
+		require.Eventually(t, func() bool {
+			_, err := client.Prices(context.Background(), &types.QueryPricesRequest{})
+			require.NoError(t, err)
+			return client.Health()[0].Healthy
+		}, 5*time.Second, 10*time.Millisecond)
+
+		resp, err = client.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, primary, resp)
+	})
+}
+
+func TestFailoverClientMedianPrices(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Second)
+
+	testCases := []struct {
+		name     string
+		results  []sidecarResult
+		expected *types.QueryPricesResponse
+	}{
+		{
+			name: "returns the median price of each ticker",
+			results: []sidecarResult{
+				{resp: pricesResponse(now, map[string]string{"BTC/USD": "100", "ETH/USD": "10"})},
+				{resp: pricesResponse(earlier, map[string]string{"BTC/USD": "103", "ETH/USD": "13"})},
+				{resp: pricesResponse(now, map[string]string{"BTC/USD": "200"})},
+			},
+			expected: pricesResponse(earlier, map[string]string{"BTC/USD": "103", "ETH/USD": "11"}),
+		},
+		{
+			name: "ignores sidecars that fail or return stale prices",
+			results: []sidecarResult{
+				{err: fmt.Errorf("connection refused")},
+				{resp: pricesResponse(now, map[string]string{"BTC/USD": "100"})},
+				{resp: pricesResponse(now.Add(-2*maxAge), map[string]string{"BTC/USD": "1000"})},
+				{resp: pricesResponse(now, map[string]string{"BTC/USD": "110"})},
+			},
+			expected: pricesResponse(now, map[string]string{"BTC/USD": "105"}),
+		},
+		{
+			name: "errors if every sidecar fails",
+			results: []sidecarResult{
+				{err: fmt.Errorf("connection refused")},
+				{resp: pricesResponse(now.Add(-2*maxAge), map[string]string{"BTC/USD": "1000"})},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := oracle.NewFailoverClient(
+				log.NewNopLogger(),
+				newSidecars(t, tc.results...),
+				maxAge,
+				oracle.WithSidecarMode(config.SidecarModeMedian),
+				oracle.WithSidecarRetryInterval(time.Hour),
+			)
+			require.NoError(t, err)
+
+			resp, err := client.Prices(context.Background(), &types.QueryPricesRequest{})
+			if tc.expected == nil {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resp)
+		})
+	}
+}
+
+func TestFailoverClientWithPriceDaemon(t *testing.T) {
+	cfg := config.AppConfig{
+		Enabled:       true,
+		OracleAddress: "sidecar-0",
+		ClientTimeout: time.Second,
+		Interval:      10 * time.Millisecond,
+		PriceTTL:      maxAge,
+	}
+
+	sidecars := newSidecars(t,
+		sidecarResult{err: fmt.Errorf("connection refused")},
+		sidecarResult{resp: pricesResponse(time.Now(), map[string]string{"BTC/USD": "101"})},
+	)
+	for _, sidecar := range sidecars {
+		client := sidecar.Client.(*mocks.OracleClient)
+		client.EXPECT().Start(mock.Anything).Return(nil)
+		client.EXPECT().Stop().Return(nil).Maybe()
+	}
+
+	client, err := oracle.NewFailoverClient(log.NewNopLogger(), sidecars, maxAge, oracle.WithSidecarRetryInterval(time.Hour))
+	require.NoError(t, err)
+
+	daemon, err := oracle.NewPriceDaemon(log.NewNopLogger(), cfg, client)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go daemon.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		resp, err := daemon.Prices(context.Background(), &types.QueryPricesRequest{})
+		return err == nil && resp.Prices["BTC/USD"] == "101"
+	}, 5*time.Second, 10*time.Millisecond)
+}
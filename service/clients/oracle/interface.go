@@ -62,3 +62,43 @@ func (c NoOpClient) Version(
 ) (*types.QueryVersionResponse, error) {
 	return nil, nil
 }
+
+func (c NoOpClient) ProviderHealth(
+	_ context.Context,
+	_ *types.QueryProviderHealthRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProviderHealthResponse, error) {
+	return nil, nil
+}
+
+func (c NoOpClient) PriceHistory(
+	_ context.Context,
+	_ *types.QueryPriceHistoryRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryPriceHistoryResponse, error) {
+	return nil, nil
+}
+
+func (c NoOpClient) ProviderPrices(
+	_ context.Context,
+	_ *types.QueryProviderPricesRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProviderPricesResponse, error) {
+	return nil, nil
+}
+
+func (c NoOpClient) ProviderStatus(
+	_ context.Context,
+	_ *types.QueryProviderStatusRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProviderStatusResponse, error) {
+	return nil, nil
+}
+
+func (c NoOpClient) StreamPrices(
+	_ context.Context,
+	_ *types.QueryStreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	return nil, nil
+}
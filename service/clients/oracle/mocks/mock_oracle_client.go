@@ -99,6 +99,80 @@ func (_c *OracleClient_MarketMap_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// PriceHistory provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) PriceHistory(ctx context.Context, in *types.QueryPriceHistoryRequest, opts ...grpc.CallOption) (*types.QueryPriceHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceHistory")
+	}
+
+	var r0 *types.QueryPriceHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceHistoryRequest, ...grpc.CallOption) (*types.QueryPriceHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceHistoryRequest, ...grpc.CallOption) *types.QueryPriceHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPriceHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPriceHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleClient_PriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceHistory'
+type OracleClient_PriceHistory_Call struct {
+	*mock.Call
+}
+
+// PriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryPriceHistoryRequest
+//   - opts ...grpc.CallOption
+func (_e *OracleClient_Expecter) PriceHistory(ctx interface{}, in interface{}, opts ...interface{}) *OracleClient_PriceHistory_Call {
+	return &OracleClient_PriceHistory_Call{Call: _e.mock.On("PriceHistory",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *OracleClient_PriceHistory_Call) Run(run func(ctx context.Context, in *types.QueryPriceHistoryRequest, opts ...grpc.CallOption)) *OracleClient_PriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryPriceHistoryRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *OracleClient_PriceHistory_Call) Return(_a0 *types.QueryPriceHistoryResponse, _a1 error) *OracleClient_PriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleClient_PriceHistory_Call) RunAndReturn(run func(context.Context, *types.QueryPriceHistoryRequest, ...grpc.CallOption) (*types.QueryPriceHistoryResponse, error)) *OracleClient_PriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Prices provides a mock function with given fields: ctx, in, opts
 func (_m *OracleClient) Prices(ctx context.Context, in *types.QueryPricesRequest, opts ...grpc.CallOption) (*types.QueryPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -173,6 +247,228 @@ func (_c *OracleClient_Prices_Call) RunAndReturn(run func(context.Context, *type
 	return _c
 }
 
+// ProviderHealth provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) ProviderHealth(ctx context.Context, in *types.QueryProviderHealthRequest, opts ...grpc.CallOption) (*types.QueryProviderHealthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderHealth")
+	}
+
+	var r0 *types.QueryProviderHealthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderHealthRequest, ...grpc.CallOption) (*types.QueryProviderHealthResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderHealthRequest, ...grpc.CallOption) *types.QueryProviderHealthResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProviderHealthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProviderHealthRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleClient_ProviderHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderHealth'
+type OracleClient_ProviderHealth_Call struct {
+	*mock.Call
+}
+
+// ProviderHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryProviderHealthRequest
+//   - opts ...grpc.CallOption
+func (_e *OracleClient_Expecter) ProviderHealth(ctx interface{}, in interface{}, opts ...interface{}) *OracleClient_ProviderHealth_Call {
+	return &OracleClient_ProviderHealth_Call{Call: _e.mock.On("ProviderHealth",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *OracleClient_ProviderHealth_Call) Run(run func(ctx context.Context, in *types.QueryProviderHealthRequest, opts ...grpc.CallOption)) *OracleClient_ProviderHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryProviderHealthRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *OracleClient_ProviderHealth_Call) Return(_a0 *types.QueryProviderHealthResponse, _a1 error) *OracleClient_ProviderHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleClient_ProviderHealth_Call) RunAndReturn(run func(context.Context, *types.QueryProviderHealthRequest, ...grpc.CallOption) (*types.QueryProviderHealthResponse, error)) *OracleClient_ProviderHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProviderPrices provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) ProviderPrices(ctx context.Context, in *types.QueryProviderPricesRequest, opts ...grpc.CallOption) (*types.QueryProviderPricesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderPrices")
+	}
+
+	var r0 *types.QueryProviderPricesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderPricesRequest, ...grpc.CallOption) (*types.QueryProviderPricesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderPricesRequest, ...grpc.CallOption) *types.QueryProviderPricesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProviderPricesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProviderPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleClient_ProviderPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderPrices'
+type OracleClient_ProviderPrices_Call struct {
+	*mock.Call
+}
+
+// ProviderPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryProviderPricesRequest
+//   - opts ...grpc.CallOption
+func (_e *OracleClient_Expecter) ProviderPrices(ctx interface{}, in interface{}, opts ...interface{}) *OracleClient_ProviderPrices_Call {
+	return &OracleClient_ProviderPrices_Call{Call: _e.mock.On("ProviderPrices",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *OracleClient_ProviderPrices_Call) Run(run func(ctx context.Context, in *types.QueryProviderPricesRequest, opts ...grpc.CallOption)) *OracleClient_ProviderPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryProviderPricesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *OracleClient_ProviderPrices_Call) Return(_a0 *types.QueryProviderPricesResponse, _a1 error) *OracleClient_ProviderPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleClient_ProviderPrices_Call) RunAndReturn(run func(context.Context, *types.QueryProviderPricesRequest, ...grpc.CallOption) (*types.QueryProviderPricesResponse, error)) *OracleClient_ProviderPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProviderStatus provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) ProviderStatus(ctx context.Context, in *types.QueryProviderStatusRequest, opts ...grpc.CallOption) (*types.QueryProviderStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderStatus")
+	}
+
+	var r0 *types.QueryProviderStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderStatusRequest, ...grpc.CallOption) (*types.QueryProviderStatusResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderStatusRequest, ...grpc.CallOption) *types.QueryProviderStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProviderStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProviderStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleClient_ProviderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderStatus'
+type OracleClient_ProviderStatus_Call struct {
+	*mock.Call
+}
+
+// ProviderStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryProviderStatusRequest
+//   - opts ...grpc.CallOption
+func (_e *OracleClient_Expecter) ProviderStatus(ctx interface{}, in interface{}, opts ...interface{}) *OracleClient_ProviderStatus_Call {
+	return &OracleClient_ProviderStatus_Call{Call: _e.mock.On("ProviderStatus",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *OracleClient_ProviderStatus_Call) Run(run func(ctx context.Context, in *types.QueryProviderStatusRequest, opts ...grpc.CallOption)) *OracleClient_ProviderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryProviderStatusRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *OracleClient_ProviderStatus_Call) Return(_a0 *types.QueryProviderStatusResponse, _a1 error) *OracleClient_ProviderStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleClient_ProviderStatus_Call) RunAndReturn(run func(context.Context, *types.QueryProviderStatusRequest, ...grpc.CallOption) (*types.QueryProviderStatusResponse, error)) *OracleClient_ProviderStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *OracleClient) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -264,6 +560,80 @@ func (_c *OracleClient_Stop_Call) RunAndReturn(run func() error) *OracleClient_S
 	return _c
 }
 
+// StreamPrices provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) StreamPrices(ctx context.Context, in *types.QueryStreamPricesRequest, opts ...grpc.CallOption) (types.Oracle_StreamPricesClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPrices")
+	}
+
+	var r0 types.Oracle_StreamPricesClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStreamPricesRequest, ...grpc.CallOption) (types.Oracle_StreamPricesClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStreamPricesRequest, ...grpc.CallOption) types.Oracle_StreamPricesClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Oracle_StreamPricesClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStreamPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleClient_StreamPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPrices'
+type OracleClient_StreamPrices_Call struct {
+	*mock.Call
+}
+
+// StreamPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryStreamPricesRequest
+//   - opts ...grpc.CallOption
+func (_e *OracleClient_Expecter) StreamPrices(ctx interface{}, in interface{}, opts ...interface{}) *OracleClient_StreamPrices_Call {
+	return &OracleClient_StreamPrices_Call{Call: _e.mock.On("StreamPrices",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *OracleClient_StreamPrices_Call) Run(run func(ctx context.Context, in *types.QueryStreamPricesRequest, opts ...grpc.CallOption)) *OracleClient_StreamPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryStreamPricesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *OracleClient_StreamPrices_Call) Return(_a0 types.Oracle_StreamPricesClient, _a1 error) *OracleClient_StreamPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleClient_StreamPrices_Call) RunAndReturn(run func(context.Context, *types.QueryStreamPricesRequest, ...grpc.CallOption) (types.Oracle_StreamPricesClient, error)) *OracleClient_StreamPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Version provides a mock function with given fields: ctx, in, opts
 func (_m *OracleClient) Version(ctx context.Context, in *types.QueryVersionRequest, opts ...grpc.CallOption) (*types.QueryVersionResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1,5 +1,11 @@
 package oracle
 
+import (
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
 // Option enables consumers to configure the behavior of an OracleClient on initialization.
 type Option func(OracleClient)
 
@@ -16,3 +22,43 @@ func WithBlockingDial() Option {
 		client.blockingDial = true
 	}
 }
+
+// WithTLSConfig configures the OracleClient to connect to the remote oracle server over TLS, if
+// TLS is enabled in the given configuration. The configured client certificate is presented to
+// servers that require mutual TLS.
+func WithTLSConfig(cfg config.ClientTLSConfig) Option {
+	return func(c OracleClient) {
+		client, ok := c.(*GRPCClient)
+		if !ok {
+			return
+		}
+
+		client.tls = cfg
+	}
+}
+
+// WithSidecarMode configures how a FailoverClient queries its sidecars, either
+// config.SidecarModeFailover or config.SidecarModeMedian. An empty mode is ignored.
+func WithSidecarMode(mode string) Option {
+	return func(c OracleClient) {
+		client, ok := c.(*FailoverClient)
+		if !ok || len(mode) == 0 {
+			return
+		}
+
+		client.mode = mode
+	}
+}
+
+// WithSidecarRetryInterval configures the time between attempts of a FailoverClient to reach
+// an unhealthy sidecar.
+func WithSidecarRetryInterval(interval time.Duration) Option {
+	return func(c OracleClient) {
+		client, ok := c.(*FailoverClient)
+		if !ok {
+			return
+		}
+
+		client.retryInterval = interval
+	}
+}
@@ -0,0 +1,37 @@
+package oracle
+
+import (
+	"crypto/tls"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	connecttls "github.com/skip-mev/connect/v2/pkg/tls"
+)
+
+// newTLSConfig returns the TLS configuration used to connect to the oracle server. The client
+// certificate, if any, is reloaded from disk when it changes, so that it can be rotated without
+// restarting the application.
+func newTLSConfig(cfg config.ClientTLSConfig) (*tls.Config, error) {
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+
+	// if no CAs are configured, the system's CAs are used
+	if len(cfg.CAFile) > 0 {
+		rootCAs, err := connecttls.LoadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.RootCAs = rootCAs
+	}
+
+	if len(cfg.CertFile) > 0 {
+		certs, err := connecttls.NewCertReloader(cfg.CertFile, cfg.KeyFile, cfg.ReloadInterval)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.GetClientCertificate = certs.GetClientCertificate
+	}
+
+	return tlsCfg, nil
+}
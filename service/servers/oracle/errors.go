@@ -6,4 +6,5 @@ var (
 	ErrNilRequest       = errors.New("request cannot be nil")
 	ErrOracleNotRunning = errors.New("oracle is not running")
 	ErrContextCancelled = errors.New("context cancelled")
+	ErrStalePrices      = errors.New("prices are stale")
 )
@@ -0,0 +1,92 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// HealthzPath is the HTTP path at which the server reports whether the oracle is alive.
+	HealthzPath = "/healthz"
+
+	// ReadyzPath is the HTTP path at which the server reports whether the oracle is ready to serve
+	// prices.
+	ReadyzPath = "/readyz"
+)
+
+// healthzHandler responds with 200 if the oracle is running, and 503 otherwise.
+func (os *OracleServer) healthzHandler(w http.ResponseWriter, _ *http.Request) {
+	if !os.o.IsRunning() {
+		http.Error(w, ErrOracleNotRunning.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	fmt.Fprintln(w, "ok")
+}
+
+// readyzHandler responds with 200 if the oracle is ready to serve prices, and 503 along with the
+// reasons it is not ready otherwise.
+func (os *OracleServer) readyzHandler(w http.ResponseWriter, _ *http.Request) {
+	if err := os.checkReadiness(time.Now()); err != nil {
+		os.logger.Debug("oracle is not ready", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	fmt.Fprintln(w, "ok")
+}
+
+// checkReadiness returns an error describing every reason the oracle is not ready to serve prices
+// at the given time, or nil if it is ready. The oracle is ready if it is running, has updated its
+// prices within the max sync age, has loaded a market map and has prices for at least the min
+// priced fraction of the enabled markets.
+func (os *OracleServer) checkReadiness(now time.Time) error {
+	if !os.o.IsRunning() {
+		return ErrOracleNotRunning
+	}
+
+	var errs []error
+
+	lastSyncTime := os.o.GetLastSyncTime()
+	switch age := now.Sub(lastSyncTime); {
+	case lastSyncTime.IsZero():
+		errs = append(errs, errors.New("the oracle has not updated its prices"))
+	case os.readiness.MaxSyncAge > 0 && age > os.readiness.MaxSyncAge:
+		errs = append(errs, fmt.Errorf(
+			"prices were last updated %s ago, which exceeds the max sync age of %s",
+			age, os.readiness.MaxSyncAge,
+		))
+	}
+
+	marketMap := os.o.GetMarketMap()
+	if len(marketMap.Markets) == 0 {
+		errs = append(errs, errors.New("the market map is not loaded"))
+	} else if os.readiness.MinPricedFraction > 0 {
+		prices := os.o.GetPrices()
+
+		var enabled, priced int
+		for ticker, market := range marketMap.Markets {
+			if !market.Ticker.Enabled {
+				continue
+			}
+
+			enabled++
+			if _, ok := prices[ticker]; ok {
+				priced++
+			}
+		}
+
+		if enabled > 0 && float64(priced)/float64(enabled) < os.readiness.MinPricedFraction {
+			errs = append(errs, fmt.Errorf(
+				"%d of %d enabled markets have prices, which is below the min priced fraction of %g",
+				priced, enabled, os.readiness.MinPricedFraction,
+			))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -1,7 +1,13 @@
 package oracle
 
 import (
+	"math/big"
+	"strings"
+	"time"
+
 	"github.com/skip-mev/connect/v2/oracle/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	servicetypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
 )
 
 func ToReqPrices(prices types.Prices) map[string]string {
@@ -14,3 +20,252 @@ func ToReqPrices(prices types.Prices) map[string]string {
 
 	return reqPrices
 }
+
+func ToReqDispersions(dispersions types.Dispersions) map[string]servicetypes.PriceDispersion {
+	reqDispersions := make(map[string]servicetypes.PriceDispersion, len(dispersions))
+
+	for cp, dispersion := range dispersions {
+		reqDispersions[cp] = servicetypes.PriceDispersion{
+			StdDev:             toReqInt(dispersion.StdDev),
+			InterquartileRange: toReqInt(dispersion.InterquartileRange),
+			ProviderCount:      uint64(dispersion.ProviderCount), //nolint:gosec
+			Min:                toReqInt(dispersion.Min),
+			Max:                toReqInt(dispersion.Max),
+		}
+	}
+
+	return reqDispersions
+}
+
+// ToReqProviderHealth converts the health of each (provider, ticker) pair to its response type.
+func ToReqProviderHealth(health []types.ProviderHealth) []servicetypes.ProviderHealth {
+	reqHealth := make([]servicetypes.ProviderHealth, 0, len(health))
+
+	for _, h := range health {
+		var quarantinedSince *time.Time
+		if h.Quarantined {
+			since := h.QuarantinedSince.UTC()
+			quarantinedSince = &since
+		}
+
+		reqHealth = append(reqHealth, servicetypes.ProviderHealth{
+			Provider:         h.Provider,
+			Ticker:           h.Ticker,
+			Score:            h.Score,
+			ErrorRate:        h.ErrorRate,
+			StaleRate:        h.StaleRate,
+			DeviationRate:    h.DeviationRate,
+			Samples:          uint64(h.Samples), //nolint:gosec
+			Quarantined:      h.Quarantined,
+			QuarantinedSince: quarantinedSince,
+		})
+	}
+
+	return reqHealth
+}
+
+// ToReqPriceRecords converts the price records to their response type.
+func ToReqPriceRecords(records []types.PriceRecord) []servicetypes.PriceRecord {
+	reqRecords := make([]servicetypes.PriceRecord, 0, len(records))
+
+	for _, record := range records {
+		var price string
+		if record.Price != nil {
+			price = toReqInt(record.Price)
+		}
+
+		providerPrices := make([]servicetypes.ProviderPrice, 0, len(record.ProviderPrices))
+		for _, providerPrice := range record.ProviderPrices {
+			providerPrices = append(providerPrices, servicetypes.ProviderPrice{
+				Provider:       providerPrice.Provider,
+				OffChainTicker: providerPrice.OffChainTicker,
+				Price:          toReqInt(providerPrice.Price),
+			})
+		}
+
+		reqRecords = append(reqRecords, servicetypes.PriceRecord{
+			Ticker:         record.Ticker,
+			Timestamp:      record.Timestamp.UTC(),
+			Price:          price,
+			ProviderPrices: providerPrices,
+		})
+	}
+
+	return reqRecords
+}
+
+// ToReqProviderStatuses converts the provider statuses to their response type.
+func ToReqProviderStatuses(statuses []types.ProviderStatus) []servicetypes.ProviderStatus {
+	reqStatuses := make([]servicetypes.ProviderStatus, 0, len(statuses))
+
+	for _, status := range statuses {
+		var lastUpdated *time.Time
+		if !status.LastUpdated.IsZero() {
+			updated := status.LastUpdated.UTC()
+			lastUpdated = &updated
+		}
+
+		reqErrors := make([]servicetypes.ProviderError, 0, len(status.RecentErrors))
+		for _, err := range status.RecentErrors {
+			// OK has no error description.
+			var description string
+			if codeErr := err.Code.Error(); codeErr != nil {
+				description = codeErr.Error()
+			}
+
+			reqErrors = append(reqErrors, servicetypes.ProviderError{
+				Ticker:    err.ID,
+				Code:      int64(err.Code),
+				Error:     description,
+				Timestamp: err.Timestamp.UTC(),
+			})
+		}
+
+		reqStatuses = append(reqStatuses, servicetypes.ProviderStatus{
+			Name:         status.Name,
+			Type:         string(status.Type),
+			MarketMap:    status.MarketMap,
+			Running:      status.Running,
+			TickerCount:  uint64(status.TickerCount), //nolint:gosec
+			LastUpdated:  lastUpdated,
+			RecentErrors: reqErrors,
+		})
+	}
+
+	return reqStatuses
+}
+
+// ToReqProviderPriceDetails converts the provider price details of each ticker to their response
+// type.
+func ToReqProviderPriceDetails(details types.ProviderPriceDetails) map[string]servicetypes.ProviderPriceDetails {
+	reqDetails := make(map[string]servicetypes.ProviderPriceDetails, len(details))
+
+	for ticker, tickerDetails := range details {
+		reqTickerDetails := make([]servicetypes.ProviderPriceDetail, 0, len(tickerDetails))
+		for _, detail := range tickerDetails {
+			reqDetail := servicetypes.ProviderPriceDetail{
+				Provider:          detail.Provider,
+				OffChainTicker:    detail.OffChainTicker,
+				Invert:            detail.Invert,
+				NormalizationPath: detail.NormalizationPath,
+				Included:          detail.Included,
+				ExclusionReason:   detail.ExclusionReason,
+			}
+
+			if detail.RawPrice != nil {
+				reqDetail.RawPrice = detail.RawPrice.Text('f', -1)
+			}
+
+			if detail.ConvertedPrice != nil {
+				reqDetail.ConvertedPrice = toReqInt(detail.ConvertedPrice)
+			}
+
+			if detail.TWAPPrice != nil {
+				reqDetail.TwapPrice = toReqInt(detail.TWAPPrice)
+			}
+
+			if !detail.Timestamp.IsZero() {
+				timestamp := detail.Timestamp.UTC()
+				reqDetail.Timestamp = &timestamp
+			}
+
+			reqTickerDetails = append(reqTickerDetails, reqDetail)
+		}
+
+		reqDetails[ticker] = servicetypes.ProviderPriceDetails{Details: reqTickerDetails}
+	}
+
+	return reqDetails
+}
+
+// tickerFilter selects tickers by name, prefix, base and quote currency. A ticker is selected if
+// it matches every criterion that is set, so the zero value selects every ticker.
+type tickerFilter struct {
+	// tickers is the set of selected tickers, e.g. "BTC/USD".
+	tickers map[string]struct{}
+	// prefix is the prefix of the selected tickers, e.g. "BTC/".
+	prefix string
+	// base is the base currency of the selected tickers, e.g. "BTC".
+	base string
+	// quote is the quote currency of the selected tickers, e.g. "USD".
+	quote string
+}
+
+// tickersFilter returns a filter that selects the given tickers, or every ticker if none are given.
+func tickersFilter(tickers []string) tickerFilter {
+	var filter tickerFilter
+	if len(tickers) > 0 {
+		filter.tickers = make(map[string]struct{}, len(tickers))
+		for _, ticker := range tickers {
+			filter.tickers[ticker] = struct{}{}
+		}
+	}
+
+	return filter
+}
+
+// pricesFilter returns the filter of the tickers selected by the Prices request.
+func pricesFilter(req *servicetypes.QueryPricesRequest) tickerFilter {
+	filter := tickersFilter(req.Tickers)
+	filter.prefix = req.TickerPrefix
+	filter.base = req.Base
+	filter.quote = req.Quote
+	return filter
+}
+
+// IsEmpty returns true if the filter selects every ticker.
+func (f tickerFilter) IsEmpty() bool {
+	return f.tickers == nil && f.prefix == "" && f.base == "" && f.quote == ""
+}
+
+// Matches returns true if the filter selects the ticker. Currencies are compared case-insensitively.
+func (f tickerFilter) Matches(ticker string) bool {
+	if f.tickers != nil {
+		if _, ok := f.tickers[ticker]; !ok {
+			return false
+		}
+	}
+
+	if !strings.HasPrefix(ticker, f.prefix) {
+		return false
+	}
+
+	if f.base == "" && f.quote == "" {
+		return true
+	}
+
+	cp, err := connecttypes.CurrencyPairFromString(ticker)
+	if err != nil {
+		return false
+	}
+
+	return (f.base == "" || strings.EqualFold(f.base, cp.Base)) &&
+		(f.quote == "" || strings.EqualFold(f.quote, cp.Quote))
+}
+
+// filterTickers returns the entries of the map, which is indexed by ticker, whose ticker is
+// selected by the filter.
+func filterTickers[V any](values map[string]V, filter tickerFilter) map[string]V {
+	if filter.IsEmpty() {
+		return values
+	}
+
+	filtered := make(map[string]V)
+	for ticker, value := range values {
+		if filter.Matches(ticker) {
+			filtered[ticker] = value
+		}
+	}
+
+	return filtered
+}
+
+// toReqInt returns the integer string representation of the value, or "0" if it is nil.
+func toReqInt(value *big.Float) string {
+	if value == nil {
+		return "0"
+	}
+
+	intValue, _ := value.Int(nil)
+	return intValue.String()
+}
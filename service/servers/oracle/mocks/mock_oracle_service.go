@@ -82,6 +82,65 @@ func (_c *OracleService_MarketMap_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// PriceHistory provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) PriceHistory(_a0 context.Context, _a1 *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceHistory")
+	}
+
+	var r0 *types.QueryPriceHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceHistoryRequest) *types.QueryPriceHistoryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPriceHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPriceHistoryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleService_PriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceHistory'
+type OracleService_PriceHistory_Call struct {
+	*mock.Call
+}
+
+// PriceHistory is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *types.QueryPriceHistoryRequest
+func (_e *OracleService_Expecter) PriceHistory(_a0 interface{}, _a1 interface{}) *OracleService_PriceHistory_Call {
+	return &OracleService_PriceHistory_Call{Call: _e.mock.On("PriceHistory", _a0, _a1)}
+}
+
+func (_c *OracleService_PriceHistory_Call) Run(run func(_a0 context.Context, _a1 *types.QueryPriceHistoryRequest)) *OracleService_PriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.QueryPriceHistoryRequest))
+	})
+	return _c
+}
+
+func (_c *OracleService_PriceHistory_Call) Return(_a0 *types.QueryPriceHistoryResponse, _a1 error) *OracleService_PriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleService_PriceHistory_Call) RunAndReturn(run func(context.Context, *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error)) *OracleService_PriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Prices provides a mock function with given fields: _a0, _a1
 func (_m *OracleService) Prices(_a0 context.Context, _a1 *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -141,6 +200,183 @@ func (_c *OracleService_Prices_Call) RunAndReturn(run func(context.Context, *typ
 	return _c
 }
 
+// ProviderHealth provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) ProviderHealth(_a0 context.Context, _a1 *types.QueryProviderHealthRequest) (*types.QueryProviderHealthResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderHealth")
+	}
+
+	var r0 *types.QueryProviderHealthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderHealthRequest) (*types.QueryProviderHealthResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderHealthRequest) *types.QueryProviderHealthResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProviderHealthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProviderHealthRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleService_ProviderHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderHealth'
+type OracleService_ProviderHealth_Call struct {
+	*mock.Call
+}
+
+// ProviderHealth is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *types.QueryProviderHealthRequest
+func (_e *OracleService_Expecter) ProviderHealth(_a0 interface{}, _a1 interface{}) *OracleService_ProviderHealth_Call {
+	return &OracleService_ProviderHealth_Call{Call: _e.mock.On("ProviderHealth", _a0, _a1)}
+}
+
+func (_c *OracleService_ProviderHealth_Call) Run(run func(_a0 context.Context, _a1 *types.QueryProviderHealthRequest)) *OracleService_ProviderHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.QueryProviderHealthRequest))
+	})
+	return _c
+}
+
+func (_c *OracleService_ProviderHealth_Call) Return(_a0 *types.QueryProviderHealthResponse, _a1 error) *OracleService_ProviderHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleService_ProviderHealth_Call) RunAndReturn(run func(context.Context, *types.QueryProviderHealthRequest) (*types.QueryProviderHealthResponse, error)) *OracleService_ProviderHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProviderPrices provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) ProviderPrices(_a0 context.Context, _a1 *types.QueryProviderPricesRequest) (*types.QueryProviderPricesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderPrices")
+	}
+
+	var r0 *types.QueryProviderPricesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderPricesRequest) (*types.QueryProviderPricesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderPricesRequest) *types.QueryProviderPricesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProviderPricesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProviderPricesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleService_ProviderPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderPrices'
+type OracleService_ProviderPrices_Call struct {
+	*mock.Call
+}
+
+// ProviderPrices is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *types.QueryProviderPricesRequest
+func (_e *OracleService_Expecter) ProviderPrices(_a0 interface{}, _a1 interface{}) *OracleService_ProviderPrices_Call {
+	return &OracleService_ProviderPrices_Call{Call: _e.mock.On("ProviderPrices", _a0, _a1)}
+}
+
+func (_c *OracleService_ProviderPrices_Call) Run(run func(_a0 context.Context, _a1 *types.QueryProviderPricesRequest)) *OracleService_ProviderPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.QueryProviderPricesRequest))
+	})
+	return _c
+}
+
+func (_c *OracleService_ProviderPrices_Call) Return(_a0 *types.QueryProviderPricesResponse, _a1 error) *OracleService_ProviderPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleService_ProviderPrices_Call) RunAndReturn(run func(context.Context, *types.QueryProviderPricesRequest) (*types.QueryProviderPricesResponse, error)) *OracleService_ProviderPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProviderStatus provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) ProviderStatus(_a0 context.Context, _a1 *types.QueryProviderStatusRequest) (*types.QueryProviderStatusResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderStatus")
+	}
+
+	var r0 *types.QueryProviderStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderStatusRequest) (*types.QueryProviderStatusResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderStatusRequest) *types.QueryProviderStatusResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProviderStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProviderStatusRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleService_ProviderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderStatus'
+type OracleService_ProviderStatus_Call struct {
+	*mock.Call
+}
+
+// ProviderStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *types.QueryProviderStatusRequest
+func (_e *OracleService_Expecter) ProviderStatus(_a0 interface{}, _a1 interface{}) *OracleService_ProviderStatus_Call {
+	return &OracleService_ProviderStatus_Call{Call: _e.mock.On("ProviderStatus", _a0, _a1)}
+}
+
+func (_c *OracleService_ProviderStatus_Call) Run(run func(_a0 context.Context, _a1 *types.QueryProviderStatusRequest)) *OracleService_ProviderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.QueryProviderStatusRequest))
+	})
+	return _c
+}
+
+func (_c *OracleService_ProviderStatus_Call) Return(_a0 *types.QueryProviderStatusResponse, _a1 error) *OracleService_ProviderStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleService_ProviderStatus_Call) RunAndReturn(run func(context.Context, *types.QueryProviderStatusRequest) (*types.QueryProviderStatusResponse, error)) *OracleService_ProviderStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *OracleService) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -233,6 +469,53 @@ func (_c *OracleService_Stop_Call) RunAndReturn(run func(context.Context) error)
 	return _c
 }
 
+// StreamPrices provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) StreamPrices(_a0 *types.QueryStreamPricesRequest, _a1 types.Oracle_StreamPricesServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPrices")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.QueryStreamPricesRequest, types.Oracle_StreamPricesServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleService_StreamPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPrices'
+type OracleService_StreamPrices_Call struct {
+	*mock.Call
+}
+
+// StreamPrices is a helper method to define mock.On call
+//   - _a0 *types.QueryStreamPricesRequest
+//   - _a1 types.Oracle_StreamPricesServer
+func (_e *OracleService_Expecter) StreamPrices(_a0 interface{}, _a1 interface{}) *OracleService_StreamPrices_Call {
+	return &OracleService_StreamPrices_Call{Call: _e.mock.On("StreamPrices", _a0, _a1)}
+}
+
+func (_c *OracleService_StreamPrices_Call) Run(run func(_a0 *types.QueryStreamPricesRequest, _a1 types.Oracle_StreamPricesServer)) *OracleService_StreamPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*types.QueryStreamPricesRequest), args[1].(types.Oracle_StreamPricesServer))
+	})
+	return _c
+}
+
+func (_c *OracleService_StreamPrices_Call) Return(_a0 error) *OracleService_StreamPrices_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleService_StreamPrices_Call) RunAndReturn(run func(*types.QueryStreamPricesRequest, types.Oracle_StreamPricesServer) error) *OracleService_StreamPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Version provides a mock function with given fields: _a0, _a1
 func (_m *OracleService) Version(_a0 context.Context, _a1 *types.QueryVersionRequest) (*types.QueryVersionResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -0,0 +1,26 @@
+package oracle
+
+import (
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+// Option is a functional option for the oracle server.
+type Option func(*OracleServer)
+
+// WithReadinessConfig sets the configuration for when the server reports the oracle as ready on
+// its readiness endpoint. By default, the oracle is ready once it is running, has loaded a market
+// map and has updated its prices at least once.
+func WithReadinessConfig(cfg config.ReadinessConfig) Option {
+	return func(os *OracleServer) {
+		os.readiness = cfg
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the server. If the configuration has a
+// certificate, the server only accepts TLS connections, and if it has a client CA file, clients
+// must present a valid certificate. By default, the server accepts plaintext connections.
+func WithTLSConfig(cfg config.ServerTLSConfig) Option {
+	return func(os *OracleServer) {
+		os.tls = cfg
+	}
+}
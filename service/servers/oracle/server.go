@@ -2,23 +2,27 @@ package oracle
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"strings"
 	"time"
 
-	gateway "github.com/cosmos/gogogateway"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"github.com/skip-mev/connect/v2/cmd/build"
 	"github.com/skip-mev/connect/v2/oracle"
+	"github.com/skip-mev/connect/v2/oracle/config"
+	oracletypes "github.com/skip-mev/connect/v2/oracle/types"
 	"github.com/skip-mev/connect/v2/pkg/sync"
 	"github.com/skip-mev/connect/v2/service/servers/oracle/types"
 )
@@ -47,17 +51,36 @@ type OracleServer struct { //nolint
 
 	// logger to log incoming requests
 	logger *zap.Logger
+
+	// readiness is the configuration for when the oracle is reported as ready.
+	readiness config.ReadinessConfig
+
+	// tls is the TLS configuration of the server.
+	tls config.ServerTLSConfig
+
+	// streamsCtx is cancelled when the server is closed to end all open price streams, which
+	// would otherwise block the graceful shutdown of the server.
+	streamsCtx    context.Context
+	cancelStreams context.CancelFunc
 }
 
 // NewOracleServer returns a new instance of the OracleServer, given an implementation of the Oracle interface.
-func NewOracleServer(o oracle.Oracle, logger *zap.Logger) *OracleServer {
+func NewOracleServer(o oracle.Oracle, logger *zap.Logger, opts ...Option) *OracleServer {
 	logger = logger.With(zap.String("server", "oracle"))
 
 	os := &OracleServer{
 		o:      o,
 		logger: logger,
 	}
+	for _, opt := range opts {
+		opt(os)
+	}
+
+	os.streamsCtx, os.cancelStreams = context.WithCancel(context.Background())
 	os.Closer = sync.NewCloser().WithCallback(func() {
+		// end all open price streams
+		os.cancelStreams()
+
 		// if the server has been started, close it
 		if os.httpSrv != nil {
 			ctx, cf := context.WithTimeout(context.Background(), DefaultServerShutdownTimeout)
@@ -96,20 +119,33 @@ func (os *OracleServer) StartServerWithListener(ctx context.Context, ln net.List
 	// register the grpc-gateway
 	// it handles the http request and dials the server endpoint with the grpc request
 	os.gatewayMux = runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{
-			EmitDefaults: true,
-			Indent:       "",
-			OrigName:     true,
-		}),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, newJSONMarshaler()),
 	)
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithNoProxy()}
-	err := types.RegisterOracleHandlerFromEndpoint(ctx, os.gatewayMux, ln.Addr().String(), opts)
-	if err != nil {
-		return err
+	if os.tls.Enabled() {
+		tlsCfg, err := newTLSConfig(os.tls)
+		if err != nil {
+			return fmt.Errorf("[grpc server]: failed to load tls config: %w", err)
+		}
+		ln = tls.NewListener(ln, tlsCfg)
+
+		// the gateway cannot dial the server over TLS when the server requires client
+		// certificates, so it calls the server in-process instead.
+		if err := types.RegisterOracleHandlerServer(ctx, os.gatewayMux, os); err != nil {
+			return err
+		}
+	} else {
+		opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithNoProxy()}
+		err := types.RegisterOracleHandlerFromEndpoint(ctx, os.gatewayMux, ln.Addr().String(), opts)
+		if err != nil {
+			return err
+		}
 	}
 
 	router := http.NewServeMux()
 	router.HandleFunc("/", os.routeRequest)
+	router.HandleFunc(StreamPricesPath, os.streamPricesHandler)
+	router.HandleFunc(HealthzPath, os.healthzHandler)
+	router.HandleFunc(ReadyzPath, os.readyzHandler)
 	os.httpSrv.Handler = h2c.NewHandler(router, &http2.Server{})
 
 	eg, ctx := errgroup.WithContext(ctx)
@@ -177,21 +213,36 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 		return nil, ErrOracleNotRunning
 	}
 
+	// check that the prices are recent enough, if requested
+	if req.MaxAge != nil {
+		if *req.MaxAge < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "max age cannot be negative: %s", *req.MaxAge)
+		}
+
+		// Stale prices are reported as unavailable, so that clients can retry the request or
+		// fail over to another oracle.
+		lastSyncTime := os.o.GetLastSyncTime()
+		if lastSyncTime.IsZero() {
+			return nil, status.Errorf(codes.Unavailable, "%s: the oracle has not updated its prices", ErrStalePrices)
+		}
+
+		if age := time.Since(lastSyncTime); age > *req.MaxAge {
+			return nil, status.Errorf(
+				codes.Unavailable,
+				"%s: prices were last updated %s ago, which exceeds the max age of %s",
+				ErrStalePrices,
+				age,
+				*req.MaxAge,
+			)
+		}
+	}
+
+	filter := pricesFilter(req)
 	resCh := make(chan *types.QueryPricesResponse)
 
 	// run the request in a goroutine, to unblock server + ctx cancellation
 	go func() {
-		// get the prices
-		prices := os.o.GetPrices()
-
-		// get the latest timestamp of the latest update from the oracle
-		timestamp := os.o.GetLastSyncTime()
-
-		resCh <- &types.QueryPricesResponse{
-			Prices:    ToReqPrices(prices),
-			Timestamp: timestamp,
-			Version:   build.Build,
-		}
+		resCh <- os.pricesResponse(filter)
 	}()
 
 	// defer to context closure
@@ -204,6 +255,48 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 	}
 }
 
+// StreamPrices streams the oracle's prices, optionally restricted to the requested tickers. The
+// current prices are sent immediately if the oracle has synced, and the updated prices are sent
+// after every price update until the client cancels the stream or the server is closed.
+func (os *OracleServer) StreamPrices(req *types.QueryStreamPricesRequest, stream types.Oracle_StreamPricesServer) error {
+	if req == nil {
+		return ErrNilRequest
+	}
+
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return ErrOracleNotRunning
+	}
+
+	os.logger.Debug("opened price stream", zap.Strings("tickers", req.Tickers))
+	defer os.logger.Debug("closed price stream", zap.Strings("tickers", req.Tickers))
+
+	return os.streamPrices(stream.Context(), tickersFilter(req.Tickers), stream.Send)
+}
+
+// pricesResponse returns the oracle's latest prices of the tickers selected by the filter.
+func (os *OracleServer) pricesResponse(filter tickerFilter) *types.QueryPricesResponse {
+	// get the prices
+	prices := os.o.GetPrices()
+
+	// get the dispersion of the provider prices used for each price
+	dispersions := os.o.GetPriceDispersions()
+
+	// get the priority tier of the provider configs used for each price
+	priorityTiers := os.o.GetPriceTiers()
+
+	// get the latest timestamp of the latest update from the oracle
+	timestamp := os.o.GetLastSyncTime()
+
+	return &types.QueryPricesResponse{
+		Prices:        ToReqPrices(filterTickers(prices, filter)),
+		Timestamp:     timestamp,
+		Version:       build.Build,
+		Dispersions:   ToReqDispersions(filterTickers(dispersions, filter)),
+		PriorityTiers: filterTickers(priorityTiers, filter),
+	}
+}
+
 // MarketMap returns the current market map from the Oracle.
 func (os *OracleServer) MarketMap(_ context.Context, _ *types.QueryMarketMapRequest) (*types.QueryMarketMapResponse, error) {
 	mm := os.o.GetMarketMap()
@@ -215,6 +308,81 @@ func (os *OracleServer) Version(_ context.Context, _ *types.QueryVersionRequest)
 	return &types.QueryVersionResponse{Version: build.Build}, nil
 }
 
+// ProviderHealth returns the health of every (provider, ticker) pair tracked by the oracle.
+func (os *OracleServer) ProviderHealth(
+	_ context.Context,
+	req *types.QueryProviderHealthRequest,
+) (*types.QueryProviderHealthResponse, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	return &types.QueryProviderHealthResponse{
+		ProviderHealth: ToReqProviderHealth(os.o.GetProviderHealth()),
+	}, nil
+}
+
+// PriceHistory returns the price records retained by the oracle that match the request.
+func (os *OracleServer) PriceHistory(
+	_ context.Context,
+	req *types.QueryPriceHistoryRequest,
+) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	filter := oracletypes.PriceHistoryFilter{
+		Ticker:   req.Ticker,
+		Provider: req.Provider,
+	}
+	if req.Start != nil {
+		filter.Start = *req.Start
+	}
+	if req.End != nil {
+		filter.End = *req.End
+	}
+
+	if !filter.Start.IsZero() && !filter.End.IsZero() && filter.End.Before(filter.Start) {
+		return nil, status.Errorf(codes.InvalidArgument, "price history end %s is before start %s", filter.End, filter.Start)
+	}
+
+	return &types.QueryPriceHistoryResponse{
+		Records: ToReqPriceRecords(os.o.GetPriceHistory(filter)),
+	}, nil
+}
+
+// ProviderStatus returns the status of every provider run by the oracle.
+func (os *OracleServer) ProviderStatus(
+	_ context.Context,
+	req *types.QueryProviderStatusRequest,
+) (*types.QueryProviderStatusResponse, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	return &types.QueryProviderStatusResponse{
+		Providers: ToReqProviderStatuses(os.o.GetProviderStatuses()),
+	}, nil
+}
+
+// ProviderPrices returns the price of each provider config of every ticker in the oracle's latest
+// aggregation round, optionally restricted to the requested tickers.
+func (os *OracleServer) ProviderPrices(
+	_ context.Context,
+	req *types.QueryProviderPricesRequest,
+) (*types.QueryProviderPricesResponse, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	details := filterTickers(os.o.GetProviderPriceDetails(), tickersFilter(req.Tickers))
+
+	return &types.QueryProviderPricesResponse{
+		ProviderPrices: ToReqProviderPriceDetails(details),
+		Timestamp:      os.o.GetLastSyncTime(),
+	}, nil
+}
+
 // Close closes the underlying oracle server, and blocks until all open requests have been satisfied.
 func (os *OracleServer) Close() error {
 	// close + close server if necessary
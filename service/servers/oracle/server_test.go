@@ -1,12 +1,14 @@
 package oracle_test
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
 	"math/big"
 	"net"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,10 +16,14 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/mocks"
 	"github.com/skip-mev/connect/v2/oracle/types"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
 	client "github.com/skip-mev/connect/v2/service/clients/oracle"
 	"github.com/skip-mev/connect/v2/service/metrics"
 	server "github.com/skip-mev/connect/v2/service/servers/oracle"
@@ -32,6 +38,11 @@ const (
 	grpcErrPrefix = "rpc error: code = Unknown desc = "
 )
 
+var readinessCfg = config.ReadinessConfig{
+	MaxSyncAge:        time.Minute,
+	MinPricedFraction: 0.5,
+}
+
 type ServerTestSuite struct {
 	suite.Suite
 
@@ -53,7 +64,7 @@ func (s *ServerTestSuite) SetupTest() {
 	logger := zap.NewExample()
 
 	s.mockOracle = mocks.NewOracle(s.T())
-	s.srv = server.NewOracleServer(s.mockOracle, logger)
+	s.srv = server.NewOracleServer(s.mockOracle, logger, server.WithReadinessConfig(readinessCfg))
 
 	// listen on a random port and extract that port number
 	ln, err := net.Listen("tcp", localhost+":0")
@@ -159,6 +170,19 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 		cp1.String(): big.NewFloat(100.1),
 		cp2.String(): big.NewFloat(200.1),
 	})
+	s.mockOracle.On("GetPriceDispersions").Return(types.Dispersions{
+		cp1.String(): {
+			StdDev:             big.NewFloat(1.5),
+			InterquartileRange: big.NewFloat(2.5),
+			Min:                big.NewFloat(99),
+			Max:                big.NewFloat(101),
+			ProviderCount:      3,
+		},
+	})
+	s.mockOracle.On("GetPriceTiers").Return(types.PriorityTiers{
+		cp1.String(): 0,
+		cp2.String(): 1,
+	})
 	ts := time.Now()
 	s.mockOracle.On("GetLastSyncTime").Return(ts)
 
@@ -169,6 +193,23 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	// check response
 	s.Require().Equal(resp.Prices[cp1.String()], big.NewInt(100).String())
 	s.Require().Equal(resp.Prices[cp2.String()], big.NewInt(200).String())
+
+	// check dispersions
+	s.Require().Equal(stypes.PriceDispersion{
+		StdDev:             "1",
+		InterquartileRange: "2",
+		ProviderCount:      3,
+		Min:                "99",
+		Max:                "101",
+	}, resp.Dispersions[cp1.String()])
+	s.Require().NotContains(resp.Dispersions, cp2.String())
+
+	// check priority tiers
+	s.Require().Equal(map[string]uint32{
+		cp1.String(): 0,
+		cp2.String(): 1,
+	}, resp.PriorityTiers)
+
 	// check timestamp
 
 	s.Require().Equal(resp.Timestamp, ts.UTC())
@@ -184,6 +225,129 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	s.Require().Contains(string(respBz), fmt.Sprintf(`{"prices":{"%s":"100","%s":"200"},"timestamp":`, cp1.String(), cp2.String()))
 }
 
+func (s *ServerTestSuite) TestOracleServerPricesFilters() {
+	s.mockOracle.EXPECT().IsRunning().Return(true)
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		"BTC/USD":  big.NewFloat(100),
+		"BTC/USDT": big.NewFloat(101),
+		"ETH/USD":  big.NewFloat(200),
+		"ETH/BTC":  big.NewFloat(2),
+	})
+	s.mockOracle.On("GetPriceDispersions").Return(types.Dispersions{})
+	s.mockOracle.On("GetPriceTiers").Return(types.PriorityTiers{})
+	s.mockOracle.On("GetLastSyncTime").Return(time.Now().Add(-30 * time.Second))
+
+	minute := time.Minute
+	second := time.Second
+	negative := -time.Second
+
+	testCases := []struct {
+		name     string
+		req      *stypes.QueryPricesRequest
+		expected map[string]string
+		err      error
+		code     codes.Code
+	}{
+		{
+			name: "returns every price without filters",
+			req:  &stypes.QueryPricesRequest{},
+			expected: map[string]string{
+				"BTC/USD":  "100",
+				"BTC/USDT": "101",
+				"ETH/USD":  "200",
+				"ETH/BTC":  "2",
+			},
+		},
+		{
+			name: "filters by tickers",
+			req:  &stypes.QueryPricesRequest{Tickers: []string{"BTC/USD", "ETH/BTC", "FOO/USD"}},
+			expected: map[string]string{
+				"BTC/USD": "100",
+				"ETH/BTC": "2",
+			},
+		},
+		{
+			name: "filters by ticker prefix",
+			req:  &stypes.QueryPricesRequest{TickerPrefix: "BTC/USD"},
+			expected: map[string]string{
+				"BTC/USD":  "100",
+				"BTC/USDT": "101",
+			},
+		},
+		{
+			name: "filters by base",
+			req:  &stypes.QueryPricesRequest{Base: "eth"},
+			expected: map[string]string{
+				"ETH/USD": "200",
+				"ETH/BTC": "2",
+			},
+		},
+		{
+			name: "filters by quote",
+			req:  &stypes.QueryPricesRequest{Quote: "BTC"},
+			expected: map[string]string{
+				"ETH/BTC": "2",
+			},
+		},
+		{
+			name: "combines filters",
+			req:  &stypes.QueryPricesRequest{Tickers: []string{"BTC/USD", "ETH/USD"}, Base: "BTC"},
+			expected: map[string]string{
+				"BTC/USD": "100",
+			},
+		},
+		{
+			name: "returns no prices if no ticker matches",
+			req:  &stypes.QueryPricesRequest{Base: "FOO"},
+		},
+		{
+			name: "returns prices within the max age",
+			req:  &stypes.QueryPricesRequest{Quote: "BTC", MaxAge: &minute},
+			expected: map[string]string{
+				"ETH/BTC": "2",
+			},
+		},
+		{
+			name: "rejects prices older than the max age",
+			req:  &stypes.QueryPricesRequest{MaxAge: &second},
+			err:  server.ErrStalePrices,
+			code: codes.Unavailable,
+		},
+		{
+			name: "rejects a negative max age",
+			req:  &stypes.QueryPricesRequest{MaxAge: &negative},
+			err:  fmt.Errorf("max age cannot be negative"),
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, err := s.client.Prices(context.Background(), tc.req)
+			if tc.err != nil {
+				s.Require().ErrorContains(err, tc.err.Error())
+				s.Require().Equal(tc.code, status.Code(err))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, resp.Prices)
+		})
+	}
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/connect/oracle/v2/prices?base=BTC&tickers=BTC%%2FUSDT&max_age=2m", localhost, s.port))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `{"prices":{"BTC/USDT":"101"},"timestamp":`)
+
+	httpResp, err = s.httpClient.Get(fmt.Sprintf("http://%s:%s/connect/oracle/v2/prices?max_age=10s", localhost, s.port))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusServiceUnavailable, httpResp.StatusCode)
+}
+
 func (s *ServerTestSuite) TestOracleMarketMap() {
 	dummyMarketMap := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
 		"foo": {
@@ -216,6 +380,423 @@ func (s *ServerTestSuite) TestOracleMarketMap() {
 	s.Require().Equal(*res.GetMarketMap(), dummyMarketMap)
 }
 
+func (s *ServerTestSuite) TestOracleProviderHealth() {
+	since := time.Now().UTC()
+	s.mockOracle.On("GetProviderHealth").Return([]types.ProviderHealth{
+		{
+			Provider: "coinbase_api",
+			Ticker:   "BTC/USD",
+			Score:    1,
+			Samples:  10,
+		},
+		{
+			Provider:         "kucoin_ws",
+			Ticker:           "BTC/USD",
+			Score:            0.2,
+			ErrorRate:        0.5,
+			StaleRate:        0.1,
+			DeviationRate:    0.3,
+			Samples:          10,
+			Quarantined:      true,
+			QuarantinedSince: since,
+		},
+	}).Once()
+
+	res, err := s.client.ProviderHealth(context.Background(), &stypes.QueryProviderHealthRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]stypes.ProviderHealth{
+		{
+			Provider: "coinbase_api",
+			Ticker:   "BTC/USD",
+			Score:    1,
+			Samples:  10,
+		},
+		{
+			Provider:         "kucoin_ws",
+			Ticker:           "BTC/USD",
+			Score:            0.2,
+			ErrorRate:        0.5,
+			StaleRate:        0.1,
+			DeviationRate:    0.3,
+			Samples:          10,
+			Quarantined:      true,
+			QuarantinedSince: &since,
+		},
+	}, res.ProviderHealth)
+}
+
+func (s *ServerTestSuite) TestOraclePriceHistory() {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Minute)
+	filter := types.PriceHistoryFilter{
+		Ticker:   "BTC/USD",
+		Provider: "coinbase_api",
+		Start:    start,
+		End:      end,
+	}
+	records := []types.PriceRecord{
+		{
+			Ticker:    "BTC/USD",
+			Timestamp: start,
+			Price:     big.NewFloat(100),
+			ProviderPrices: []types.ProviderPrice{
+				{Provider: "coinbase_api", OffChainTicker: "BTC-USD", Price: big.NewFloat(101)},
+			},
+		},
+		{
+			Ticker:    "BTC/USD",
+			Timestamp: end,
+		},
+	}
+	s.mockOracle.On("GetPriceHistory", filter).Return(records).Twice()
+
+	res, err := s.client.PriceHistory(context.Background(), &stypes.QueryPriceHistoryRequest{
+		Ticker:   filter.Ticker,
+		Provider: filter.Provider,
+		Start:    &start,
+		End:      &end,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]stypes.PriceRecord{
+		{
+			Ticker:    "BTC/USD",
+			Timestamp: start,
+			Price:     "100",
+			ProviderPrices: []stypes.ProviderPrice{
+				{Provider: "coinbase_api", OffChainTicker: "BTC-USD", Price: "101"},
+			},
+		},
+		{
+			Ticker:    "BTC/USD",
+			Timestamp: end,
+		},
+	}, res.Records)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf(
+		"http://%s:%s/connect/oracle/v2/price_history?ticker=BTC%%2FUSD&provider=coinbase_api&start=%s&end=%s",
+		localhost,
+		s.port,
+		start.Format(time.RFC3339),
+		end.Format(time.RFC3339),
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `"price":"100","provider_prices":[{"provider":"coinbase_api","off_chain_ticker":"BTC-USD","price":"101"}]`)
+
+	// an end before the start is rejected
+	_, err = s.client.PriceHistory(context.Background(), &stypes.QueryPriceHistoryRequest{
+		Start: &end,
+		End:   &start,
+	})
+	s.Require().ErrorContains(err, "is before start")
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ServerTestSuite) TestOracleProviderPrices() {
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	usdtusd := connecttypes.NewCurrencyPair("USDT", "USD")
+	details := types.ProviderPriceDetails{
+		"BTC/USD": {
+			{
+				Provider:          "kucoin_ws",
+				OffChainTicker:    "BTC-USDT",
+				RawPrice:          big.NewFloat(100.5),
+				ConvertedPrice:    big.NewFloat(10_050_000_000),
+				TWAPPrice:         big.NewFloat(10_025_000_000),
+				Timestamp:         ts,
+				NormalizationPath: []mmtypes.NormalizationPair{{CurrencyPair: usdtusd}},
+				Included:          true,
+			},
+			{
+				Provider:        "binance_api",
+				OffChainTicker:  "BTCUSDC",
+				ExclusionReason: types.ExclusionReasonMissingPrice,
+			},
+		},
+		"ETH/USD": {
+			{
+				Provider:        "coinbase_api",
+				OffChainTicker:  "ETH-USD",
+				RawPrice:        big.NewFloat(2000),
+				ExclusionReason: types.ExclusionReasonConversionFailed,
+			},
+		},
+	}
+	s.mockOracle.On("GetProviderPriceDetails").Return(details).Twice()
+	s.mockOracle.On("GetLastSyncTime").Return(ts).Twice()
+
+	res, err := s.client.ProviderPrices(context.Background(), &stypes.QueryProviderPricesRequest{
+		Tickers: []string{"BTC/USD", "FOO/USD"},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(ts, res.Timestamp)
+	s.Require().Equal(map[string]stypes.ProviderPriceDetails{
+		"BTC/USD": {
+			Details: []stypes.ProviderPriceDetail{
+				{
+					Provider:          "kucoin_ws",
+					OffChainTicker:    "BTC-USDT",
+					RawPrice:          "100.5",
+					ConvertedPrice:    "10050000000",
+					TwapPrice:         "10025000000",
+					Timestamp:         &ts,
+					NormalizationPath: []mmtypes.NormalizationPair{{CurrencyPair: usdtusd}},
+					Included:          true,
+				},
+				{
+					Provider:        "binance_api",
+					OffChainTicker:  "BTCUSDC",
+					ExclusionReason: types.ExclusionReasonMissingPrice,
+				},
+			},
+		},
+	}, res.ProviderPrices)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/connect/oracle/v2/provider_prices?tickers=ETH%%2FUSD", localhost, s.port))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `"ETH/USD":{"details":[{"provider":"coinbase_api","off_chain_ticker":"ETH-USD","raw_price":"2000"`)
+	s.Require().NotContains(string(respBz), "BTC/USD")
+}
+
+func (s *ServerTestSuite) TestOracleStreamPrices() {
+	btcusd := connecttypes.NewCurrencyPair("BTC", "USD")
+	ethusd := connecttypes.NewCurrencyPair("ETH", "USD")
+
+	s.mockOracle.EXPECT().IsRunning().Return(true)
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		btcusd.String(): big.NewFloat(100),
+		ethusd.String(): big.NewFloat(200),
+	})
+	s.mockOracle.On("GetPriceDispersions").Return(types.Dispersions{})
+	s.mockOracle.On("GetPriceTiers").Return(types.PriorityTiers{
+		btcusd.String(): 0,
+		ethusd.String(): 1,
+	})
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+
+	grpcUpdates := make(chan time.Time, 1)
+	s.mockOracle.On("SubscribePrices").Return((<-chan time.Time)(grpcUpdates), func() {}).Once()
+	sseUpdates := make(chan time.Time, 1)
+	s.mockOracle.On("SubscribePrices").Return((<-chan time.Time)(sseUpdates), func() {}).Once()
+
+	// stream from the grpc client
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := s.client.StreamPrices(ctx, &stypes.QueryStreamPricesRequest{
+		Tickers: []string{btcusd.String()},
+	})
+	s.Require().NoError(err)
+
+	// the current prices are sent when the stream is opened, and again on every update
+	for i := 0; i < 2; i++ {
+		res, err := stream.Recv()
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]string{btcusd.String(): "100"}, res.Prices)
+		s.Require().Equal(map[string]uint32{btcusd.String(): 0}, res.PriorityTiers)
+		s.Require().Equal(ts, res.Timestamp)
+
+		grpcUpdates <- ts
+	}
+
+	// stream from the http client as server-sent events
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s%s?tickers=ETH%%2FUSD", localhost, s.port, server.StreamPricesPath))
+	s.Require().NoError(err)
+	defer httpResp.Body.Close()
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	s.Require().Equal("text/event-stream", httpResp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(httpResp.Body)
+	for i := 0; i < 2; i++ {
+		event, err := reader.ReadString('\n')
+		s.Require().NoError(err)
+		s.Require().True(strings.HasPrefix(event, `data: {"prices":{"ETH/USD":"200"},"timestamp":`), event)
+
+		// events are separated by an empty line
+		line, err := reader.ReadString('\n')
+		s.Require().NoError(err)
+		s.Require().Equal("\n", line)
+
+		sseUpdates <- ts
+	}
+
+	// closing the server ends the open streams
+	s.srv.Close()
+	select {
+	case <-s.srv.Done():
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("server failed to stop")
+	}
+
+	_, err = io.ReadAll(reader)
+	s.Require().NoError(err)
+}
+
+func (s *ServerTestSuite) TestOracleProviderStatus() {
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.mockOracle.On("GetProviderStatuses").Return([]types.ProviderStatus{
+		{
+			Name:        "binance_api",
+			Type:        providertypes.API,
+			Running:     true,
+			TickerCount: 2,
+			LastUpdated: ts,
+			RecentErrors: []providertypes.ProviderError{
+				{ID: "BTC/USD", Code: providertypes.ErrorRateLimitExceeded, Timestamp: ts},
+			},
+		},
+		{
+			Name:        "dydx_migration_api",
+			Type:        providertypes.API,
+			MarketMap:   true,
+			TickerCount: 1,
+		},
+	}).Twice()
+
+	res, err := s.client.ProviderStatus(context.Background(), &stypes.QueryProviderStatusRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]stypes.ProviderStatus{
+		{
+			Name:        "binance_api",
+			Type:        "api",
+			Running:     true,
+			TickerCount: 2,
+			LastUpdated: &ts,
+			RecentErrors: []stypes.ProviderError{
+				{
+					Ticker:    "BTC/USD",
+					Code:      int64(providertypes.ErrorRateLimitExceeded),
+					Error:     "rate limit exceeded",
+					Timestamp: ts,
+				},
+			},
+		},
+		{
+			Name:        "dydx_migration_api",
+			Type:        "api",
+			MarketMap:   true,
+			TickerCount: 1,
+		},
+	}, res.Providers)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/connect/oracle/v2/provider_status", localhost, s.port))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `{"providers":[{"name":"binance_api","type":"api","market_map":false,"running":true,"ticker_count":"2"`)
+}
+
+func (s *ServerTestSuite) TestOracleHealthz() {
+	s.mockOracle.EXPECT().IsRunning().Return(true).Once()
+	s.requireHTTPStatus(server.HealthzPath, http.StatusOK, "ok")
+
+	s.mockOracle.EXPECT().IsRunning().Return(false).Once()
+	s.requireHTTPStatus(server.HealthzPath, http.StatusServiceUnavailable, server.ErrOracleNotRunning.Error())
+}
+
+func (s *ServerTestSuite) TestOracleReadyz() {
+	btcusd := mmtypes.Ticker{CurrencyPair: connecttypes.NewCurrencyPair("BTC", "USD"), Enabled: true}
+	ethusd := mmtypes.Ticker{CurrencyPair: connecttypes.NewCurrencyPair("ETH", "USD"), Enabled: true}
+	solusd := mmtypes.Ticker{CurrencyPair: connecttypes.NewCurrencyPair("SOL", "USD")}
+	marketMap := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
+		btcusd.String(): {Ticker: btcusd},
+		ethusd.String(): {Ticker: ethusd},
+		solusd.String(): {Ticker: solusd},
+	}}
+
+	testCases := []struct {
+		name         string
+		running      bool
+		lastSyncTime time.Time
+		marketMap    mmtypes.MarketMap
+		prices       types.Prices
+		status       int
+		body         []string
+	}{
+		{
+			name:   "not ready if the oracle is not running",
+			status: http.StatusServiceUnavailable,
+			body:   []string{server.ErrOracleNotRunning.Error()},
+		},
+		{
+			name:         "ready if enough enabled markets have recent prices",
+			running:      true,
+			lastSyncTime: time.Now().Add(-time.Second),
+			marketMap:    marketMap,
+			// the disabled market does not count towards the priced fraction
+			prices: types.Prices{btcusd.String(): big.NewFloat(1), solusd.String(): big.NewFloat(1)},
+			status: http.StatusOK,
+			body:   []string{"ok"},
+		},
+		{
+			name:    "not ready if the oracle has not synced or loaded a market map",
+			running: true,
+			status:  http.StatusServiceUnavailable,
+			body: []string{
+				"the oracle has not updated its prices",
+				"the market map is not loaded",
+			},
+		},
+		{
+			name:         "not ready if the prices are stale",
+			running:      true,
+			lastSyncTime: time.Now().Add(-2 * readinessCfg.MaxSyncAge),
+			marketMap:    marketMap,
+			prices:       types.Prices{btcusd.String(): big.NewFloat(1)},
+			status:       http.StatusServiceUnavailable,
+			body:         []string{"exceeds the max sync age of 1m0s"},
+		},
+		{
+			name:         "not ready if too few enabled markets have prices",
+			running:      true,
+			lastSyncTime: time.Now(),
+			marketMap:    marketMap,
+			prices:       types.Prices{solusd.String(): big.NewFloat(1)},
+			status:       http.StatusServiceUnavailable,
+			body:         []string{"0 of 2 enabled markets have prices, which is below the min priced fraction of 0.5"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockOracle.EXPECT().IsRunning().Return(tc.running).Once()
+			if tc.running {
+				s.mockOracle.EXPECT().GetLastSyncTime().Return(tc.lastSyncTime).Once()
+				s.mockOracle.EXPECT().GetMarketMap().Return(tc.marketMap).Once()
+			}
+			if tc.prices != nil {
+				s.mockOracle.EXPECT().GetPrices().Return(tc.prices).Once()
+			}
+
+			s.requireHTTPStatus(server.ReadyzPath, tc.status, tc.body...)
+		})
+	}
+}
+
+// requireHTTPStatus requires that a GET request to the path responds with the status and a body
+// that contains each of the given strings.
+func (s *ServerTestSuite) requireHTTPStatus(path string, status int, contains ...string) {
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s%s", localhost, s.port, path))
+	s.Require().NoError(err)
+	defer httpResp.Body.Close()
+
+	s.Require().Equal(status, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	for _, str := range contains {
+		s.Require().Contains(string(respBz), str)
+	}
+}
+
 // test that the oracle server closes when expected.
 func (s *ServerTestSuite) TestOracleServerClose() {
 	// close the server, and check that no requests are received
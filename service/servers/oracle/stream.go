@@ -0,0 +1,107 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	gateway "github.com/cosmos/gogogateway"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/service/servers/oracle/types"
+)
+
+// StreamPricesPath is the HTTP path at which the StreamPrices method is served as server-sent
+// events.
+const StreamPricesPath = "/connect/oracle/v2/stream_prices"
+
+// newJSONMarshaler returns the marshaler used to encode the JSON responses of the HTTP server.
+func newJSONMarshaler() *gateway.JSONPb {
+	return &gateway.JSONPb{
+		EmitDefaults: true,
+		Indent:       "",
+		OrigName:     true,
+	}
+}
+
+// streamPrices sends the oracle's prices of the tickers selected by the filter. The
+// current prices are sent immediately if the oracle has synced, and the updated prices are sent
+// after every price update. This blocks until the context is cancelled, the server is closed, or
+// sending fails.
+func (os *OracleServer) streamPrices(
+	ctx context.Context,
+	filter tickerFilter,
+	send func(*types.QueryPricesResponse) error,
+) error {
+	updates, unsubscribe := os.o.SubscribePrices()
+	defer unsubscribe()
+
+	if !os.o.GetLastSyncTime().IsZero() {
+		if err := send(os.pricesResponse(filter)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-os.streamsCtx.Done():
+			return nil
+		case <-updates:
+			if err := send(os.pricesResponse(filter)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// streamPricesHandler serves the StreamPrices method over HTTP as server-sent events. The data of
+// each event is a JSON encoded QueryPricesResponse. The tickers to stream can be given with the
+// repeated "tickers" query parameter, e.g. "?tickers=BTC/USD&tickers=ETH/USD".
+func (os *OracleServer) streamPricesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		http.Error(w, ErrOracleNotRunning.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	tickers := r.URL.Query()["tickers"]
+	os.logger.Debug("opened price event stream", zap.Strings("tickers", tickers))
+	defer os.logger.Debug("closed price event stream", zap.Strings("tickers", tickers))
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	marshaler := newJSONMarshaler()
+	err := os.streamPrices(r.Context(), tickersFilter(tickers), func(resp *types.QueryPricesResponse) error {
+		bz, err := marshaler.Marshal(resp)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", bz); err != nil {
+			return err
+		}
+
+		flusher.Flush()
+		return nil
+	})
+	if err != nil && r.Context().Err() == nil {
+		os.logger.Error("failed to stream prices", zap.Error(err))
+	}
+}
@@ -0,0 +1,49 @@
+package oracle
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	connecttls "github.com/skip-mev/connect/v2/pkg/tls"
+)
+
+// newTLSConfig returns the TLS configuration of the server. The server's certificate and the CAs
+// used to verify client certificates are reloaded from disk when they change, so that they can be
+// rotated without restarting the server.
+func newTLSConfig(cfg config.ServerTLSConfig) (*tls.Config, error) {
+	certs, err := connecttls.NewCertReloader(cfg.CertFile, cfg.KeyFile, cfg.ReloadInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCfg := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: certs.GetCertificate,
+		// gRPC requests are served over HTTP/2, while the gateway also serves HTTP/1.1.
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	if len(cfg.ClientCAFile) == 0 {
+		return tlsCfg, nil
+	}
+
+	clientCAs, err := connecttls.NewCertPoolReloader(cfg.ClientCAFile, cfg.ReloadInterval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client cas: %w", err)
+	}
+	verifyClient := connecttls.VerifyAllowedNames(cfg.AllowedClients)
+
+	// the client CAs are resolved on every handshake so that reloaded CAs take effect.
+	tlsCfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		return &tls.Config{
+			MinVersion:       tlsCfg.MinVersion,
+			GetCertificate:   tlsCfg.GetCertificate,
+			NextProtos:       tlsCfg.NextProtos,
+			ClientAuth:       tls.RequireAndVerifyClientCert,
+			ClientCAs:        clientCAs.CertPool(),
+			VerifyConnection: verifyClient,
+		}, nil
+	}
+
+	return tlsCfg, nil
+}
@@ -0,0 +1,199 @@
+package oracle_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/mocks"
+	connecttls "github.com/skip-mev/connect/v2/pkg/tls"
+	"github.com/skip-mev/connect/v2/pkg/tls/testutils"
+	client "github.com/skip-mev/connect/v2/service/clients/oracle"
+	"github.com/skip-mev/connect/v2/service/metrics"
+	server "github.com/skip-mev/connect/v2/service/servers/oracle"
+	stypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
+)
+
+func TestServerTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := testutils.NewCA(t, "oracle")
+	caFile := ca.WriteCert(t, dir)
+	serverCert, serverKey := ca.Issue(t, dir, "server")
+	validator1Cert, validator1Key := ca.Issue(t, dir, "validator-1")
+	validator2Cert, validator2Key := ca.Issue(t, dir, "validator-2")
+
+	// a client certificate with an allowed name, issued by an untrusted CA
+	untrustedDir := t.TempDir()
+	untrustedCert, untrustedKey := testutils.NewCA(t, "untrusted").Issue(t, untrustedDir, "validator-1")
+
+	serverTLS := config.ServerTLSConfig{
+		CertFile: serverCert,
+		KeyFile:  serverKey,
+	}
+	mutualTLS := serverTLS
+	mutualTLS.ClientCAFile = caFile
+
+	allowList := mutualTLS
+	allowList.AllowedClients = []string{"validator-1"}
+
+	// startServer starts an oracle server with the given TLS config, and returns its address.
+	startServer := func(t *testing.T, cfg config.ServerTLSConfig) string {
+		t.Helper()
+
+		ln, err := net.Listen("tcp", localhost+":0")
+		require.NoError(t, err)
+
+		srv := server.NewOracleServer(mocks.NewOracle(t), zap.NewNop(), server.WithTLSConfig(cfg))
+		go srv.StartServerWithListener(context.Background(), ln)
+		t.Cleanup(func() {
+			srv.Close()
+			select {
+			case <-srv.Done():
+			case <-time.After(2 * time.Second):
+				t.Fatal("server failed to stop")
+			}
+		})
+
+		_, port, err := net.SplitHostPort(ln.Addr().String())
+		require.NoError(t, err)
+		return fmt.Sprintf("%s:%s", localhost, port)
+	}
+
+	testCases := []struct {
+		name   string
+		server config.ServerTLSConfig
+		client config.ClientTLSConfig
+		expErr bool
+	}{
+		{
+			name:   "tls client connects to a tls server",
+			server: serverTLS,
+			client: config.ClientTLSConfig{Enabled: true, CAFile: caFile},
+		},
+		{
+			name:   "plaintext client is rejected by a tls server",
+			server: serverTLS,
+			client: config.ClientTLSConfig{},
+			expErr: true,
+		},
+		{
+			name:   "tls client rejects a server signed by an untrusted ca",
+			server: serverTLS,
+			client: config.ClientTLSConfig{Enabled: true},
+			expErr: true,
+		},
+		{
+			name:   "client with a certificate connects to a mutual tls server",
+			server: mutualTLS,
+			client: config.ClientTLSConfig{
+				Enabled:  true,
+				CAFile:   caFile,
+				CertFile: validator2Cert,
+				KeyFile:  validator2Key,
+			},
+		},
+		{
+			name:   "client without a certificate is rejected by a mutual tls server",
+			server: mutualTLS,
+			client: config.ClientTLSConfig{Enabled: true, CAFile: caFile},
+			expErr: true,
+		},
+		{
+			name:   "client with an untrusted certificate is rejected by a mutual tls server",
+			server: mutualTLS,
+			client: config.ClientTLSConfig{
+				Enabled:  true,
+				CAFile:   caFile,
+				CertFile: untrustedCert,
+				KeyFile:  untrustedKey,
+			},
+			expErr: true,
+		},
+		{
+			name:   "allowed client connects to a server with an allow list",
+			server: allowList,
+			client: config.ClientTLSConfig{
+				Enabled:  true,
+				CAFile:   caFile,
+				CertFile: validator1Cert,
+				KeyFile:  validator1Key,
+			},
+		},
+		{
+			name:   "client that is not allowed is rejected by a server with an allow list",
+			server: allowList,
+			client: config.ClientTLSConfig{
+				Enabled:  true,
+				CAFile:   caFile,
+				CertFile: validator2Cert,
+				KeyFile:  validator2Key,
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr := startServer(t, tc.server)
+
+			c, err := client.NewClient(
+				log.NewTestLogger(t),
+				addr,
+				timeout,
+				metrics.NewNopMetrics(),
+				client.WithTLSConfig(tc.client),
+			)
+			require.NoError(t, err)
+			require.NoError(t, c.Start(context.Background()))
+			t.Cleanup(func() { require.NoError(t, c.Stop()) })
+
+			_, err = c.Version(context.Background(), &stypes.QueryVersionRequest{})
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("serves the gateway over mutual tls", func(t *testing.T) {
+		addr := startServer(t, allowList)
+
+		rootCAs, err := connecttls.LoadCertPool(caFile)
+		require.NoError(t, err)
+		cert, err := tls.LoadX509KeyPair(validator1Cert, validator1Key)
+		require.NoError(t, err)
+
+		httpClient := &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					MinVersion:   tls.VersionTLS12,
+					RootCAs:      rootCAs,
+					Certificates: []tls.Certificate{cert},
+				},
+			},
+			Timeout: timeout,
+		}
+		defer httpClient.CloseIdleConnections()
+
+		resp, err := httpClient.Get(fmt.Sprintf("https://%s/connect/oracle/v2/version", addr))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// plaintext requests are rejected
+		plaintextResp, err := http.Get(fmt.Sprintf("http://%s/connect/oracle/v2/version", addr))
+		require.NoError(t, err)
+		defer plaintextResp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, plaintextResp.StatusCode)
+	})
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -16,6 +17,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -35,8 +37,23 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// QueryPricesRequest defines the request type for the the Prices method.
+// QueryPricesRequest defines the request type for the the Prices method. The
+// returned prices are restricted to the tickers that match every filter that is
+// set, and include every ticker if no filter is set.
 type QueryPricesRequest struct {
+	// Tickers defines the tickers to return the prices of, e.g. "BTC/USD".
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+	// TickerPrefix defines a prefix that the returned tickers must start with,
+	// e.g. "BTC/".
+	TickerPrefix string `protobuf:"bytes,2,opt,name=ticker_prefix,json=tickerPrefix,proto3" json:"ticker_prefix,omitempty"`
+	// Base defines the base currency of the returned tickers, e.g. "BTC".
+	Base string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// Quote defines the quote currency of the returned tickers, e.g. "USD".
+	Quote string `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	// MaxAge defines the maximum age of the prices. If set, the request fails
+	// with codes.Unavailable if the oracle last updated its prices longer ago
+	// than this.
+	MaxAge *time.Duration `protobuf:"bytes,5,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age,omitempty"`
 }
 
 func (m *QueryPricesRequest) Reset()         { *m = QueryPricesRequest{} }
@@ -72,6 +89,41 @@ func (m *QueryPricesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPricesRequest proto.InternalMessageInfo
 
+func (m *QueryPricesRequest) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+func (m *QueryPricesRequest) GetTickerPrefix() string {
+	if m != nil {
+		return m.TickerPrefix
+	}
+	return ""
+}
+
+func (m *QueryPricesRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *QueryPricesRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *QueryPricesRequest) GetMaxAge() *time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return nil
+}
+
 // QueryPricesResponse defines the response type for the Prices method.
 type QueryPricesResponse struct {
 	// Prices defines the list of prices.
@@ -80,6 +132,13 @@ type QueryPricesResponse struct {
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 	// Version defines the version of the oracle service that provided the prices.
 	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Dispersions defines the dispersion of the provider prices that were used to
+	// calculate each price. Each value is scaled by the respective ticker's
+	// decimals, in the same manner as the prices.
+	Dispersions map[string]PriceDispersion `protobuf:"bytes,4,rep,name=dispersions,proto3" json:"dispersions" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// PriorityTiers defines the lowest priority tier of the provider configs that
+	// were used to calculate each price. Tier 0 is the highest priority.
+	PriorityTiers map[string]uint32 `protobuf:"bytes,5,rep,name=priority_tiers,json=priorityTiers,proto3" json:"priority_tiers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
@@ -136,6 +195,152 @@ func (m *QueryPricesResponse) GetVersion() string {
 	return ""
 }
 
+func (m *QueryPricesResponse) GetDispersions() map[string]PriceDispersion {
+	if m != nil {
+		return m.Dispersions
+	}
+	return nil
+}
+
+func (m *QueryPricesResponse) GetPriorityTiers() map[string]uint32 {
+	if m != nil {
+		return m.PriorityTiers
+	}
+	return nil
+}
+
+// QueryStreamPricesRequest defines the request type for the StreamPrices
+// method.
+type QueryStreamPricesRequest struct {
+	// Tickers defines the tickers to stream the prices of, e.g. "BTC/USD". The
+	// prices of every ticker are streamed if this is empty.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+}
+
+func (m *QueryStreamPricesRequest) Reset()         { *m = QueryStreamPricesRequest{} }
+func (m *QueryStreamPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamPricesRequest) ProtoMessage()    {}
+func (*QueryStreamPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{2}
+}
+func (m *QueryStreamPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamPricesRequest.Merge(m, src)
+}
+func (m *QueryStreamPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamPricesRequest proto.InternalMessageInfo
+
+func (m *QueryStreamPricesRequest) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+// PriceDispersion defines how closely the provider prices used to calculate an
+// aggregated price agree with each other.
+type PriceDispersion struct {
+	// StdDev defines the population standard deviation of the provider prices.
+	StdDev string `protobuf:"bytes,1,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	// InterquartileRange defines the difference between the third and first
+	// quartiles of the provider prices.
+	InterquartileRange string `protobuf:"bytes,2,opt,name=interquartile_range,json=interquartileRange,proto3" json:"interquartile_range,omitempty"`
+	// ProviderCount defines the number of provider prices.
+	ProviderCount uint64 `protobuf:"varint,3,opt,name=provider_count,json=providerCount,proto3" json:"provider_count,omitempty"`
+	// Min defines the minimum provider price.
+	Min string `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`
+	// Max defines the maximum provider price.
+	Max string `protobuf:"bytes,5,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *PriceDispersion) Reset()         { *m = PriceDispersion{} }
+func (m *PriceDispersion) String() string { return proto.CompactTextString(m) }
+func (*PriceDispersion) ProtoMessage()    {}
+func (*PriceDispersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{3}
+}
+func (m *PriceDispersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceDispersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceDispersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceDispersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceDispersion.Merge(m, src)
+}
+func (m *PriceDispersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceDispersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceDispersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceDispersion proto.InternalMessageInfo
+
+func (m *PriceDispersion) GetStdDev() string {
+	if m != nil {
+		return m.StdDev
+	}
+	return ""
+}
+
+func (m *PriceDispersion) GetInterquartileRange() string {
+	if m != nil {
+		return m.InterquartileRange
+	}
+	return ""
+}
+
+func (m *PriceDispersion) GetProviderCount() uint64 {
+	if m != nil {
+		return m.ProviderCount
+	}
+	return 0
+}
+
+func (m *PriceDispersion) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *PriceDispersion) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
 // QueryMarketMapRequest defines the request type for the MarketMap method.
 type QueryMarketMapRequest struct {
 }
@@ -144,7 +349,7 @@ func (m *QueryMarketMapRequest) Reset()         { *m = QueryMarketMapRequest{} }
 func (m *QueryMarketMapRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapRequest) ProtoMessage()    {}
 func (*QueryMarketMapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{2}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{4}
 }
 func (m *QueryMarketMapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +388,7 @@ func (m *QueryMarketMapResponse) Reset()         { *m = QueryMarketMapResponse{}
 func (m *QueryMarketMapResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapResponse) ProtoMessage()    {}
 func (*QueryMarketMapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{3}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{5}
 }
 func (m *QueryMarketMapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +432,7 @@ func (m *QueryVersionRequest) Reset()         { *m = QueryVersionRequest{} }
 func (m *QueryVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionRequest) ProtoMessage()    {}
 func (*QueryVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{4}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{6}
 }
 func (m *QueryVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +471,7 @@ func (m *QueryVersionResponse) Reset()         { *m = QueryVersionResponse{} }
 func (m *QueryVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionResponse) ProtoMessage()    {}
 func (*QueryVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{5}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{7}
 }
 func (m *QueryVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,505 +507,6160 @@ func (m *QueryVersionResponse) GetVersion() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryPricesRequest)(nil), "connect.service.v2.QueryPricesRequest")
-	proto.RegisterType((*QueryPricesResponse)(nil), "connect.service.v2.QueryPricesResponse")
-	proto.RegisterMapType((map[string]string)(nil), "connect.service.v2.QueryPricesResponse.PricesEntry")
-	proto.RegisterType((*QueryMarketMapRequest)(nil), "connect.service.v2.QueryMarketMapRequest")
-	proto.RegisterType((*QueryMarketMapResponse)(nil), "connect.service.v2.QueryMarketMapResponse")
-	proto.RegisterType((*QueryVersionRequest)(nil), "connect.service.v2.QueryVersionRequest")
-	proto.RegisterType((*QueryVersionResponse)(nil), "connect.service.v2.QueryVersionResponse")
+// QueryProviderHealthRequest defines the request type for the ProviderHealth
+// method.
+type QueryProviderHealthRequest struct {
 }
 
-func init() { proto.RegisterFile("connect/service/v2/oracle.proto", fileDescriptor_9b4d2eaa50661ccd) }
-
-var fileDescriptor_9b4d2eaa50661ccd = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x5b, 0xe8, 0xa8, 0x7b, 0x41, 0xa6, 0x83, 0x2e, 0x54, 0x49, 0x17, 0x21, 0x28, 0x48,
-	0x38, 0x28, 0xbb, 0xf0, 0x43, 0xe2, 0x50, 0x89, 0xe3, 0x04, 0x8b, 0x00, 0x21, 0x2e, 0x93, 0x1b,
-	0x99, 0x10, 0xb5, 0x89, 0x4d, 0xec, 0x44, 0xaa, 0xc4, 0x01, 0x38, 0x71, 0x9c, 0x04, 0x7f, 0xd4,
-	0x8e, 0x93, 0xb8, 0x70, 0x02, 0xd4, 0xf2, 0x87, 0xa0, 0xd8, 0x4e, 0xb6, 0x8e, 0x4e, 0xdb, 0xa9,
-	0x7e, 0x7e, 0xdf, 0x7b, 0xef, 0xfb, 0xbe, 0xe7, 0x14, 0x3a, 0x21, 0x4b, 0x53, 0x1a, 0x4a, 0x4f,
-	0xd0, 0xac, 0x88, 0x43, 0xea, 0x15, 0xbe, 0xc7, 0x32, 0x12, 0xce, 0x28, 0xe6, 0x19, 0x93, 0x0c,
-	0x21, 0x03, 0xc0, 0x06, 0x80, 0x0b, 0xdf, 0xea, 0x45, 0x2c, 0x62, 0x2a, 0xed, 0x95, 0x27, 0x8d,
-	0xb4, 0x06, 0x11, 0x63, 0xd1, 0x8c, 0x7a, 0x84, 0xc7, 0x1e, 0x49, 0x53, 0x26, 0x89, 0x8c, 0x59,
-	0x2a, 0x4c, 0xd6, 0x31, 0x59, 0x15, 0x4d, 0xf2, 0x77, 0x9e, 0x8c, 0x13, 0x2a, 0x24, 0x49, 0xb8,
-	0x01, 0x6c, 0x85, 0x4c, 0x24, 0x4c, 0xec, 0xeb, 0xbe, 0x3a, 0x30, 0xa9, 0xed, 0x8a, 0x64, 0x42,
-	0xb2, 0x29, 0x95, 0x09, 0xe1, 0x25, 0x4d, 0x1d, 0x68, 0x88, 0xdb, 0x83, 0x68, 0x2f, 0xa7, 0xd9,
-	0xfc, 0x45, 0x16, 0x87, 0x54, 0x04, 0xf4, 0x43, 0x4e, 0x85, 0x74, 0x3f, 0x37, 0xe1, 0xb5, 0x95,
-	0x6b, 0xc1, 0x59, 0x2a, 0x28, 0xda, 0x83, 0x6d, 0xae, 0x6e, 0xfa, 0x60, 0xd8, 0x1a, 0x75, 0xfd,
-	0x1d, 0xfc, 0xbf, 0x4a, 0xbc, 0xa6, 0x10, 0xeb, 0xf0, 0x59, 0x2a, 0xb3, 0xf9, 0xf8, 0xd2, 0xe1,
-	0x2f, 0xa7, 0x11, 0x98, 0x46, 0x68, 0x0c, 0x3b, 0xb5, 0xa2, 0x7e, 0x73, 0x08, 0x46, 0x5d, 0xdf,
-	0xc2, 0x5a, 0x33, 0xae, 0x34, 0xe3, 0x97, 0x15, 0x62, 0x7c, 0xa5, 0x2c, 0x3e, 0xf8, 0xed, 0x80,
-	0xe0, 0xb8, 0x0c, 0xf5, 0xe1, 0x46, 0x41, 0x33, 0x11, 0xb3, 0xb4, 0xdf, 0x1a, 0x82, 0x51, 0x27,
-	0xa8, 0x42, 0xeb, 0x11, 0xec, 0x9e, 0x18, 0x8d, 0xae, 0xc2, 0xd6, 0x94, 0xce, 0xfb, 0x40, 0x81,
-	0xca, 0x23, 0xea, 0xc1, 0xcb, 0x05, 0x99, 0xe5, 0x54, 0x8d, 0xee, 0x04, 0x3a, 0x78, 0xdc, 0x7c,
-	0x08, 0xdc, 0x1b, 0x70, 0x53, 0x29, 0xd9, 0x55, 0x76, 0xed, 0x12, 0x5e, 0x99, 0xf3, 0x06, 0x5e,
-	0x3f, 0x9d, 0x30, 0xf6, 0x3c, 0x85, 0x50, 0x9b, 0xbb, 0x9f, 0x10, 0xae, 0xa6, 0x74, 0x7d, 0xa7,
-	0xb6, 0xa8, 0x5e, 0x42, 0x69, 0xd2, 0x71, 0x71, 0x27, 0xa9, 0x8e, 0xee, 0xa6, 0x71, 0xfd, 0xb5,
-	0x66, 0x5f, 0x0d, 0x7c, 0x00, 0x7b, 0xab, 0xd7, 0x66, 0xdc, 0x09, 0xd9, 0x60, 0x45, 0xb6, 0xff,
-	0xbd, 0x05, 0xdb, 0xcf, 0xd5, 0x6b, 0x44, 0x1f, 0x61, 0x5b, 0x3b, 0x80, 0x6e, 0x9f, 0xbb, 0x2c,
-	0x35, 0xce, 0xba, 0x73, 0xc1, 0xa5, 0xba, 0xdb, 0x5f, 0x7e, 0xfc, 0xfd, 0xd6, 0xbc, 0x89, 0xb6,
-	0xbc, 0xea, 0x9d, 0xe9, 0x2f, 0xa0, 0x7c, 0x64, 0x66, 0xbb, 0x5f, 0x01, 0xec, 0xd4, 0x52, 0xd1,
-	0xdd, 0x33, 0x3b, 0x9f, 0x36, 0xd9, 0xba, 0x77, 0x11, 0xa8, 0xe1, 0x71, 0x4b, 0xf1, 0xb0, 0xd1,
-	0x60, 0x0d, 0x8f, 0xda, 0x74, 0xf4, 0x09, 0xc0, 0x0d, 0xe3, 0x20, 0x3a, 0x5b, 0xe2, 0xaa, 0xf5,
-	0xd6, 0xe8, 0x7c, 0xa0, 0x21, 0xe1, 0x2a, 0x12, 0x03, 0x64, 0xad, 0x21, 0x61, 0xd6, 0x32, 0x7e,
-	0x75, 0xb8, 0xb0, 0xc1, 0xd1, 0xc2, 0x06, 0x7f, 0x16, 0x36, 0x38, 0x58, 0xda, 0x8d, 0xa3, 0xa5,
-	0xdd, 0xf8, 0xb9, 0xb4, 0x1b, 0x6f, 0x9f, 0x44, 0xb1, 0x7c, 0x9f, 0x4f, 0x70, 0xc8, 0x12, 0x4f,
-	0x4c, 0x63, 0x7e, 0x3f, 0xa1, 0x45, 0xdd, 0xa8, 0xf0, 0xeb, 0x7f, 0x99, 0xf2, 0x97, 0x66, 0xa2,
-	0xea, 0x2d, 0xe7, 0x9c, 0x8a, 0x49, 0x5b, 0x7d, 0x27, 0x3b, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x88, 0x1b, 0xe4, 0x95, 0x94, 0x04, 0x00, 0x00,
+func (m *QueryProviderHealthRequest) Reset()         { *m = QueryProviderHealthRequest{} }
+func (m *QueryProviderHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderHealthRequest) ProtoMessage()    {}
+func (*QueryProviderHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{8}
+}
+func (m *QueryProviderHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderHealthRequest.Merge(m, src)
+}
+func (m *QueryProviderHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderHealthRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryProviderHealthRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryProviderHealthResponse defines the response type for the ProviderHealth
+// method.
+type QueryProviderHealthResponse struct {
+	// ProviderHealth defines the health of every (provider, ticker) pair. This is
+	// empty if health tracking is disabled.
+	ProviderHealth []ProviderHealth `protobuf:"bytes,1,rep,name=provider_health,json=providerHealth,proto3" json:"provider_health"`
+}
 
-// OracleClient is the client API for Oracle service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type OracleClient interface {
-	// Prices defines a method for fetching the latest prices.
-	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
-	// MarketMap defines a method for fetching the latest market map
-	// configuration.
-	MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error)
-	// Version defines a method for fetching the current version of the oracle
-	// service.
-	Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error)
+func (m *QueryProviderHealthResponse) Reset()         { *m = QueryProviderHealthResponse{} }
+func (m *QueryProviderHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderHealthResponse) ProtoMessage()    {}
+func (*QueryProviderHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{9}
+}
+func (m *QueryProviderHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderHealthResponse.Merge(m, src)
+}
+func (m *QueryProviderHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderHealthResponse.DiscardUnknown(m)
 }
 
-type oracleClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryProviderHealthResponse proto.InternalMessageInfo
+
+func (m *QueryProviderHealthResponse) GetProviderHealth() []ProviderHealth {
+	if m != nil {
+		return m.ProviderHealth
+	}
+	return nil
 }
 
-func NewOracleClient(cc grpc1.ClientConn) OracleClient {
-	return &oracleClient{cc}
+// ProviderHealth defines the health of the price of a (provider, ticker) pair
+// over the most recent aggregation rounds.
+type ProviderHealth struct {
+	// Provider defines the name of the provider.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Ticker defines the ticker the provider's price is converted to.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Score defines the fraction of healthy samples.
+	Score float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	// ErrorRate defines the fraction of samples in which the provider did not
+	// report a price.
+	ErrorRate float64 `protobuf:"fixed64,4,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	// StaleRate defines the fraction of samples in which the provider's price was
+	// stale.
+	StaleRate float64 `protobuf:"fixed64,5,opt,name=stale_rate,json=staleRate,proto3" json:"stale_rate,omitempty"`
+	// DeviationRate defines the fraction of samples in which the provider's price
+	// deviated from the index price.
+	DeviationRate float64 `protobuf:"fixed64,6,opt,name=deviation_rate,json=deviationRate,proto3" json:"deviation_rate,omitempty"`
+	// Samples defines the number of samples the rates are computed over.
+	Samples uint64 `protobuf:"varint,7,opt,name=samples,proto3" json:"samples,omitempty"`
+	// Quarantined defines whether the pair is excluded from aggregation.
+	Quarantined bool `protobuf:"varint,8,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	// QuarantinedSince defines the time at which the pair was quarantined. This
+	// is unset if the pair is not quarantined.
+	QuarantinedSince *time.Time `protobuf:"bytes,9,opt,name=quarantined_since,json=quarantinedSince,proto3,stdtime" json:"quarantined_since,omitempty"`
 }
 
-func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
-	out := new(QueryPricesResponse)
-	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/Prices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProviderHealth) Reset()         { *m = ProviderHealth{} }
+func (m *ProviderHealth) String() string { return proto.CompactTextString(m) }
+func (*ProviderHealth) ProtoMessage()    {}
+func (*ProviderHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{10}
+}
+func (m *ProviderHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ProviderHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderHealth.Merge(m, src)
+}
+func (m *ProviderHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderHealth.DiscardUnknown(m)
 }
 
-func (c *oracleClient) MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error) {
-	out := new(QueryMarketMapResponse)
-	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/MarketMap", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProviderHealth proto.InternalMessageInfo
+
+func (m *ProviderHealth) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return out, nil
+	return ""
 }
 
-func (c *oracleClient) Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error) {
-	out := new(QueryVersionResponse)
-	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/Version", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProviderHealth) GetTicker() string {
+	if m != nil {
+		return m.Ticker
 	}
-	return out, nil
+	return ""
 }
 
-// OracleServer is the server API for Oracle service.
-type OracleServer interface {
-	// Prices defines a method for fetching the latest prices.
-	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
-	// MarketMap defines a method for fetching the latest market map
-	// configuration.
-	MarketMap(context.Context, *QueryMarketMapRequest) (*QueryMarketMapResponse, error)
-	// Version defines a method for fetching the current version of the oracle
-	// service.
-	Version(context.Context, *QueryVersionRequest) (*QueryVersionResponse, error)
+func (m *ProviderHealth) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
 }
 
-// UnimplementedOracleServer can be embedded to have forward compatible implementations.
-type UnimplementedOracleServer struct {
+func (m *ProviderHealth) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
+	}
+	return 0
 }
 
-func (*UnimplementedOracleServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+func (m *ProviderHealth) GetStaleRate() float64 {
+	if m != nil {
+		return m.StaleRate
+	}
+	return 0
 }
-func (*UnimplementedOracleServer) MarketMap(ctx context.Context, req *QueryMarketMapRequest) (*QueryMarketMapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+
+func (m *ProviderHealth) GetDeviationRate() float64 {
+	if m != nil {
+		return m.DeviationRate
+	}
+	return 0
 }
-func (*UnimplementedOracleServer) Version(ctx context.Context, req *QueryVersionRequest) (*QueryVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+
+func (m *ProviderHealth) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
 }
 
-func RegisterOracleServer(s grpc1.Server, srv OracleServer) {
-	s.RegisterService(&_Oracle_serviceDesc, srv)
+func (m *ProviderHealth) GetQuarantined() bool {
+	if m != nil {
+		return m.Quarantined
+	}
+	return false
 }
 
-func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(OracleServer).Prices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.service.v2.Oracle/Prices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OracleServer).Prices(ctx, req.(*QueryPricesRequest))
+func (m *ProviderHealth) GetQuarantinedSince() *time.Time {
+	if m != nil {
+		return m.QuarantinedSince
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Oracle_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarketMapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(OracleServer).MarketMap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.service.v2.Oracle/MarketMap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OracleServer).MarketMap(ctx, req.(*QueryMarketMapRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPriceHistoryRequest defines the request type for the PriceHistory
+// method. Unset fields match every record.
+type QueryPriceHistoryRequest struct {
+	// Ticker defines the ticker to return the records of, e.g. "BTC/USD".
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Provider defines the provider to return the prices of. The aggregated
+	// price is always returned.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Start defines the earliest time to return records from.
+	Start *time.Time `protobuf:"bytes,3,opt,name=start,proto3,stdtime" json:"start,omitempty"`
+	// End defines the latest time to return records from.
+	End *time.Time `protobuf:"bytes,4,opt,name=end,proto3,stdtime" json:"end,omitempty"`
 }
 
-func _Oracle_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(OracleServer).Version(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.service.v2.Oracle/Version",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OracleServer).Version(ctx, req.(*QueryVersionRequest))
+func (m *QueryPriceHistoryRequest) Reset()         { *m = QueryPriceHistoryRequest{} }
+func (m *QueryPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{11}
+}
+func (m *QueryPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Oracle_serviceDesc = _Oracle_serviceDesc
-var _Oracle_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.service.v2.Oracle",
-	HandlerType: (*OracleServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Prices",
-			Handler:    _Oracle_Prices_Handler,
-		},
-		{
-			MethodName: "MarketMap",
-			Handler:    _Oracle_MarketMap_Handler,
-		},
-		{
-			MethodName: "Version",
-			Handler:    _Oracle_Version_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "connect/service/v2/oracle.proto",
+func (m *QueryPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPriceHistoryRequest) GetTicker() string {
+	if m != nil {
+		return m.Ticker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPriceHistoryRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
 }
 
-func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPriceHistoryRequest) GetStart() *time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return nil
 }
 
-func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPriceHistoryRequest) GetEnd() *time.Time {
+	if m != nil {
+		return m.End
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPriceHistoryResponse defines the response type for the PriceHistory
+// method.
+type QueryPriceHistoryResponse struct {
+	// Records defines the matching price records, sorted by ticker and then
+	// timestamp. This is empty if the price history is disabled.
+	Records []PriceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
 }
 
-func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Prices) > 0 {
-		for k := range m.Prices {
-			v := m.Prices[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPriceHistoryResponse) Reset()         { *m = QueryPriceHistoryResponse{} }
+func (m *QueryPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{12}
+}
+func (m *QueryPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPriceHistoryResponse) GetRecords() []PriceRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PriceRecord defines the prices of a ticker calculated in a single
+// aggregation round. Prices are scaled by the ticker's decimals, in the same
+// manner as the prices returned by the Prices method.
+type PriceRecord struct {
+	// Ticker defines the ticker the prices are for.
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Timestamp defines the time of the aggregation round.
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// Price defines the aggregated price of the ticker. This is empty if the
+	// ticker was not priced in the round.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// ProviderPrices defines the converted price of the ticker reported by each
+	// provider config, including the prices that were excluded from aggregation.
+	ProviderPrices []ProviderPrice `protobuf:"bytes,4,rep,name=provider_prices,json=providerPrices,proto3" json:"provider_prices"`
 }
 
-func (m *QueryMarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *PriceRecord) Reset()         { *m = PriceRecord{} }
+func (m *PriceRecord) String() string { return proto.CompactTextString(m) }
+func (*PriceRecord) ProtoMessage()    {}
+func (*PriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{13}
 }
-
-func (m *QueryMarketMapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRecord.Merge(m, src)
+}
+func (m *PriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRecord.DiscardUnknown(m)
 }
 
-func (m *QueryMarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PriceRecord proto.InternalMessageInfo
+
+func (m *PriceRecord) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
 }
 
-func (m *QueryMarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketMap != nil {
-		{
-			size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *PriceRecord) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceRecord) GetPrice() string {
+	if m != nil {
+		return m.Price
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PriceRecord) GetProviderPrices() []ProviderPrice {
+	if m != nil {
+		return m.ProviderPrices
+	}
+	return nil
 }
 
-func (m *QueryVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// ProviderPrice defines the converted price of a ticker reported by a single
+// provider config.
+type ProviderPrice struct {
+	// Provider defines the name of the provider.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// OffChainTicker defines the provider's ticker the price was converted from.
+	OffChainTicker string `protobuf:"bytes,2,opt,name=off_chain_ticker,json=offChainTicker,proto3" json:"off_chain_ticker,omitempty"`
+	// Price defines the converted price, scaled by the ticker's decimals.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (m *QueryVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderPrice) Reset()         { *m = ProviderPrice{} }
+func (m *ProviderPrice) String() string { return proto.CompactTextString(m) }
+func (*ProviderPrice) ProtoMessage()    {}
+func (*ProviderPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{14}
+}
+func (m *ProviderPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ProviderPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPrice.Merge(m, src)
+}
+func (m *ProviderPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPrice.DiscardUnknown(m)
 }
 
-func (m *QueryVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProviderPrice proto.InternalMessageInfo
+
+func (m *ProviderPrice) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
 }
 
-func (m *QueryVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0xa
+func (m *ProviderPrice) GetOffChainTicker() string {
+	if m != nil {
+		return m.OffChainTicker
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProviderPrice) GetPrice() string {
+	if m != nil {
+		return m.Price
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryProviderPricesRequest defines the request type for the ProviderPrices
+// method.
+type QueryProviderPricesRequest struct {
+	// Tickers defines the tickers to return the provider prices of, e.g.
+	// "BTC/USD". The provider prices of every ticker are returned if this is
+	// empty.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+}
+
+func (m *QueryProviderPricesRequest) Reset()         { *m = QueryProviderPricesRequest{} }
+func (m *QueryProviderPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPricesRequest) ProtoMessage()    {}
+func (*QueryProviderPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{15}
+}
+func (m *QueryProviderPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryProviderPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPricesRequest.Merge(m, src)
+}
+func (m *QueryProviderPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPricesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryProviderPricesRequest proto.InternalMessageInfo
+
+func (m *QueryProviderPricesRequest) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
 	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for k, v := range m.Prices {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
-			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+	return nil
+}
+
+// QueryProviderPricesResponse defines the response type for the
+// ProviderPrices method.
+type QueryProviderPricesResponse struct {
+	// ProviderPrices defines the provider prices of each ticker.
+	ProviderPrices map[string]ProviderPriceDetails `protobuf:"bytes,1,rep,name=provider_prices,json=providerPrices,proto3" json:"provider_prices" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Timestamp defines the time of the latest aggregation round.
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *QueryProviderPricesResponse) Reset()         { *m = QueryProviderPricesResponse{} }
+func (m *QueryProviderPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPricesResponse) ProtoMessage()    {}
+func (*QueryProviderPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{16}
+}
+func (m *QueryProviderPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovOracle(uint64(l))
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+}
+func (m *QueryProviderPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPricesResponse.Merge(m, src)
+}
+func (m *QueryProviderPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderPricesResponse proto.InternalMessageInfo
+
+func (m *QueryProviderPricesResponse) GetProviderPrices() map[string]ProviderPriceDetails {
+	if m != nil {
+		return m.ProviderPrices
 	}
-	return n
+	return nil
 }
 
-func (m *QueryMarketMapRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProviderPricesResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	var l int
-	_ = l
-	return n
+	return time.Time{}
 }
 
-func (m *QueryMarketMapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// ProviderPriceDetails defines the prices of a ticker reported by each of its
+// provider configs.
+type ProviderPriceDetails struct {
+	// Details defines the price of each provider config of the ticker.
+	Details []ProviderPriceDetail `protobuf:"bytes,1,rep,name=details,proto3" json:"details"`
+}
+
+func (m *ProviderPriceDetails) Reset()         { *m = ProviderPriceDetails{} }
+func (m *ProviderPriceDetails) String() string { return proto.CompactTextString(m) }
+func (*ProviderPriceDetails) ProtoMessage()    {}
+func (*ProviderPriceDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{17}
+}
+func (m *ProviderPriceDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPriceDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPriceDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.MarketMap != nil {
-		l = m.MarketMap.Size()
-		n += 1 + l + sovOracle(uint64(l))
+}
+func (m *ProviderPriceDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPriceDetails.Merge(m, src)
+}
+func (m *ProviderPriceDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPriceDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPriceDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderPriceDetails proto.InternalMessageInfo
+
+func (m *ProviderPriceDetails) GetDetails() []ProviderPriceDetail {
+	if m != nil {
+		return m.Details
 	}
-	return n
+	return nil
 }
 
-func (m *QueryVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// ProviderPriceDetail defines the price of a ticker reported by a single
+// provider config, and whether it was used to calculate the ticker's price.
+type ProviderPriceDetail struct {
+	// Provider defines the name of the provider.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// OffChainTicker defines the provider's ticker.
+	OffChainTicker string `protobuf:"bytes,2,opt,name=off_chain_ticker,json=offChainTicker,proto3" json:"off_chain_ticker,omitempty"`
+	// RawPrice defines the decimal price reported by the provider for the
+	// off-chain ticker. This is empty if the provider did not report a price.
+	RawPrice string `protobuf:"bytes,3,opt,name=raw_price,json=rawPrice,proto3" json:"raw_price,omitempty"`
+	// ConvertedPrice defines the raw price converted to the ticker, scaled by
+	// the ticker's decimals in the same manner as the prices returned by the
+	// Prices method. This is empty if the price could not be converted.
+	ConvertedPrice string `protobuf:"bytes,4,opt,name=converted_price,json=convertedPrice,proto3" json:"converted_price,omitempty"`
+	// Timestamp defines the time at which the provider reported the price. This
+	// is unset if the provider does not report timestamps.
+	Timestamp *time.Time `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp,omitempty"`
+	// Invert defines whether the raw price is inverted before it is normalized.
+	Invert bool `protobuf:"varint,6,opt,name=invert,proto3" json:"invert,omitempty"`
+	// NormalizationPath defines the path of index prices the raw price is
+	// normalized by.
+	NormalizationPath []types.NormalizationPair `protobuf:"bytes,7,rep,name=normalization_path,json=normalizationPath,proto3" json:"normalization_path"`
+	// Included defines whether the price was used to calculate the ticker's
+	// price.
+	Included bool `protobuf:"varint,8,opt,name=included,proto3" json:"included,omitempty"`
+	// ExclusionReason defines why the price was not used to calculate the
+	// ticker's price, e.g. "missing_price", "conversion_failed", "quarantined",
+	// "outlier", "priority_tier" or "insufficient_providers". This is empty if
+	// the price was included.
+	ExclusionReason string `protobuf:"bytes,9,opt,name=exclusion_reason,json=exclusionReason,proto3" json:"exclusion_reason,omitempty"`
+	// TWAPPrice defines the time-weighted average of the converted price over
+	// the TWAP window, scaled in the same manner as the converted price. This is
+	// empty unless the oracle's aggregation mode is "twap".
+	TwapPrice string `protobuf:"bytes,10,opt,name=twap_price,json=twapPrice,proto3" json:"twap_price,omitempty"`
+}
+
+func (m *ProviderPriceDetail) Reset()         { *m = ProviderPriceDetail{} }
+func (m *ProviderPriceDetail) String() string { return proto.CompactTextString(m) }
+func (*ProviderPriceDetail) ProtoMessage()    {}
+func (*ProviderPriceDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{18}
+}
+func (m *ProviderPriceDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPriceDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPriceDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderPriceDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPriceDetail.Merge(m, src)
+}
+func (m *ProviderPriceDetail) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPriceDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPriceDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderPriceDetail proto.InternalMessageInfo
+
+func (m *ProviderPriceDetail) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetail) GetOffChainTicker() string {
+	if m != nil {
+		return m.OffChainTicker
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetail) GetRawPrice() string {
+	if m != nil {
+		return m.RawPrice
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetail) GetConvertedPrice() string {
+	if m != nil {
+		return m.ConvertedPrice
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetail) GetTimestamp() *time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *ProviderPriceDetail) GetInvert() bool {
+	if m != nil {
+		return m.Invert
+	}
+	return false
+}
+
+func (m *ProviderPriceDetail) GetNormalizationPath() []types.NormalizationPair {
+	if m != nil {
+		return m.NormalizationPath
+	}
+	return nil
+}
+
+func (m *ProviderPriceDetail) GetIncluded() bool {
+	if m != nil {
+		return m.Included
+	}
+	return false
+}
+
+func (m *ProviderPriceDetail) GetExclusionReason() string {
+	if m != nil {
+		return m.ExclusionReason
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetail) GetTwapPrice() string {
+	if m != nil {
+		return m.TwapPrice
+	}
+	return ""
+}
+
+// QueryProviderStatusRequest defines the request type for the ProviderStatus
+// method.
+type QueryProviderStatusRequest struct {
+}
+
+func (m *QueryProviderStatusRequest) Reset()         { *m = QueryProviderStatusRequest{} }
+func (m *QueryProviderStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderStatusRequest) ProtoMessage()    {}
+func (*QueryProviderStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{19}
+}
+func (m *QueryProviderStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderStatusRequest.Merge(m, src)
+}
+func (m *QueryProviderStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderStatusRequest proto.InternalMessageInfo
+
+// QueryProviderStatusResponse defines the response type for the ProviderStatus
+// method.
+type QueryProviderStatusResponse struct {
+	// Providers defines the status of every provider, sorted by name.
+	Providers []ProviderStatus `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers"`
+}
+
+func (m *QueryProviderStatusResponse) Reset()         { *m = QueryProviderStatusResponse{} }
+func (m *QueryProviderStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderStatusResponse) ProtoMessage()    {}
+func (*QueryProviderStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{20}
+}
+func (m *QueryProviderStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderStatusResponse.Merge(m, src)
+}
+func (m *QueryProviderStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderStatusResponse proto.InternalMessageInfo
+
+func (m *QueryProviderStatusResponse) GetProviders() []ProviderStatus {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+// ProviderStatus defines the status of a provider run by the oracle.
+type ProviderStatus struct {
+	// Name defines the name of the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type defines the type of the provider's data handler, i.e. "api" or
+	// "websocket".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// MarketMap defines whether the provider fetches the market map rather than
+	// prices.
+	MarketMap bool `protobuf:"varint,3,opt,name=market_map,json=marketMap,proto3" json:"market_map,omitempty"`
+	// Running defines whether the provider is running.
+	Running bool `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+	// TickerCount defines the number of tickers the provider fetches prices for,
+	// or the number of chains for a market map provider.
+	TickerCount uint64 `protobuf:"varint,5,opt,name=ticker_count,json=tickerCount,proto3" json:"ticker_count,omitempty"`
+	// LastUpdated defines the time at which the provider last successfully
+	// fetched data. This is unset if it has not yet.
+	LastUpdated *time.Time `protobuf:"bytes,6,opt,name=last_updated,json=lastUpdated,proto3,stdtime" json:"last_updated,omitempty"`
+	// RecentErrors defines the most recent errors the provider encountered, from
+	// oldest to newest.
+	RecentErrors []ProviderError `protobuf:"bytes,7,rep,name=recent_errors,json=recentErrors,proto3" json:"recent_errors"`
+}
+
+func (m *ProviderStatus) Reset()         { *m = ProviderStatus{} }
+func (m *ProviderStatus) String() string { return proto.CompactTextString(m) }
+func (*ProviderStatus) ProtoMessage()    {}
+func (*ProviderStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{21}
+}
+func (m *ProviderStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderStatus.Merge(m, src)
+}
+func (m *ProviderStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderStatus proto.InternalMessageInfo
+
+func (m *ProviderStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProviderStatus) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ProviderStatus) GetMarketMap() bool {
+	if m != nil {
+		return m.MarketMap
+	}
+	return false
+}
+
+func (m *ProviderStatus) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+func (m *ProviderStatus) GetTickerCount() uint64 {
+	if m != nil {
+		return m.TickerCount
+	}
+	return 0
+}
+
+func (m *ProviderStatus) GetLastUpdated() *time.Time {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return nil
+}
+
+func (m *ProviderStatus) GetRecentErrors() []ProviderError {
+	if m != nil {
+		return m.RecentErrors
+	}
+	return nil
+}
+
+// ProviderError defines an error that a provider encountered while fetching the
+// data of a ticker.
+type ProviderError struct {
+	// Ticker defines the ticker, or chain, whose data the provider failed to
+	// fetch.
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Code defines the provider error code.
+	Code int64 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Error defines the description of the error code.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Timestamp defines the time at which the error was encountered.
+	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *ProviderError) Reset()         { *m = ProviderError{} }
+func (m *ProviderError) String() string { return proto.CompactTextString(m) }
+func (*ProviderError) ProtoMessage()    {}
+func (*ProviderError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{22}
+}
+func (m *ProviderError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderError.Merge(m, src)
+}
+func (m *ProviderError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderError proto.InternalMessageInfo
+
+func (m *ProviderError) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+func (m *ProviderError) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ProviderError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ProviderError) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*QueryPricesRequest)(nil), "connect.service.v2.QueryPricesRequest")
+	proto.RegisterType((*QueryPricesResponse)(nil), "connect.service.v2.QueryPricesResponse")
+	proto.RegisterMapType((map[string]PriceDispersion)(nil), "connect.service.v2.QueryPricesResponse.DispersionsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "connect.service.v2.QueryPricesResponse.PricesEntry")
+	proto.RegisterMapType((map[string]uint32)(nil), "connect.service.v2.QueryPricesResponse.PriorityTiersEntry")
+	proto.RegisterType((*QueryStreamPricesRequest)(nil), "connect.service.v2.QueryStreamPricesRequest")
+	proto.RegisterType((*PriceDispersion)(nil), "connect.service.v2.PriceDispersion")
+	proto.RegisterType((*QueryMarketMapRequest)(nil), "connect.service.v2.QueryMarketMapRequest")
+	proto.RegisterType((*QueryMarketMapResponse)(nil), "connect.service.v2.QueryMarketMapResponse")
+	proto.RegisterType((*QueryVersionRequest)(nil), "connect.service.v2.QueryVersionRequest")
+	proto.RegisterType((*QueryVersionResponse)(nil), "connect.service.v2.QueryVersionResponse")
+	proto.RegisterType((*QueryProviderHealthRequest)(nil), "connect.service.v2.QueryProviderHealthRequest")
+	proto.RegisterType((*QueryProviderHealthResponse)(nil), "connect.service.v2.QueryProviderHealthResponse")
+	proto.RegisterType((*ProviderHealth)(nil), "connect.service.v2.ProviderHealth")
+	proto.RegisterType((*QueryPriceHistoryRequest)(nil), "connect.service.v2.QueryPriceHistoryRequest")
+	proto.RegisterType((*QueryPriceHistoryResponse)(nil), "connect.service.v2.QueryPriceHistoryResponse")
+	proto.RegisterType((*PriceRecord)(nil), "connect.service.v2.PriceRecord")
+	proto.RegisterType((*ProviderPrice)(nil), "connect.service.v2.ProviderPrice")
+	proto.RegisterType((*QueryProviderPricesRequest)(nil), "connect.service.v2.QueryProviderPricesRequest")
+	proto.RegisterType((*QueryProviderPricesResponse)(nil), "connect.service.v2.QueryProviderPricesResponse")
+	proto.RegisterMapType((map[string]ProviderPriceDetails)(nil), "connect.service.v2.QueryProviderPricesResponse.ProviderPricesEntry")
+	proto.RegisterType((*ProviderPriceDetails)(nil), "connect.service.v2.ProviderPriceDetails")
+	proto.RegisterType((*ProviderPriceDetail)(nil), "connect.service.v2.ProviderPriceDetail")
+	proto.RegisterType((*QueryProviderStatusRequest)(nil), "connect.service.v2.QueryProviderStatusRequest")
+	proto.RegisterType((*QueryProviderStatusResponse)(nil), "connect.service.v2.QueryProviderStatusResponse")
+	proto.RegisterType((*ProviderStatus)(nil), "connect.service.v2.ProviderStatus")
+	proto.RegisterType((*ProviderError)(nil), "connect.service.v2.ProviderError")
+}
+
+func init() { proto.RegisterFile("connect/service/v2/oracle.proto", fileDescriptor_9b4d2eaa50661ccd) }
+
+var fileDescriptor_9b4d2eaa50661ccd = []byte{
+	// 1694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0x77, 0xdb, 0xf3, 0xf9, 0xc6, 0x5f, 0x29, 0x3b, 0x9b, 0xf6, 0xd8, 0x8c, 0xed, 0x4e, 0xc2,
+	0x4e, 0x22, 0x32, 0x13, 0x4d, 0x50, 0x94, 0x04, 0xb4, 0xc0, 0xda, 0x81, 0x1c, 0x58, 0x70, 0x7a,
+	0x37, 0x08, 0xa1, 0x48, 0x4d, 0xb9, 0xbb, 0x3c, 0x6e, 0x79, 0xfa, 0x63, 0xab, 0x6a, 0x66, 0x6d,
+	0xc4, 0x01, 0x71, 0xe2, 0x18, 0x09, 0x45, 0xe2, 0x92, 0x2b, 0xfc, 0x0f, 0x20, 0x2e, 0x9c, 0x56,
+	0x9c, 0x16, 0x71, 0xe1, 0xb4, 0xa0, 0x5d, 0xae, 0x9c, 0xb9, 0xa2, 0xfa, 0xe8, 0x9e, 0xee, 0x99,
+	0xb6, 0xa7, 0x17, 0xed, 0x69, 0xfa, 0x7d, 0x55, 0xbd, 0xf7, 0xea, 0xbd, 0xdf, 0xab, 0x1a, 0xd8,
+	0x77, 0xa3, 0x30, 0x24, 0x2e, 0xef, 0x33, 0x42, 0x27, 0xbe, 0x4b, 0xfa, 0x93, 0x41, 0x3f, 0xa2,
+	0xd8, 0x1d, 0x91, 0x5e, 0x4c, 0x23, 0x1e, 0x21, 0xa4, 0x15, 0x7a, 0x5a, 0xa1, 0x37, 0x19, 0xb4,
+	0xb7, 0x87, 0xd1, 0x30, 0x92, 0xe2, 0xbe, 0xf8, 0x52, 0x9a, 0xed, 0xbd, 0x61, 0x14, 0x0d, 0x47,
+	0xa4, 0x8f, 0x63, 0xbf, 0x8f, 0xc3, 0x30, 0xe2, 0x98, 0xfb, 0x51, 0xc8, 0xb4, 0xb4, 0xa3, 0xa5,
+	0x92, 0x3a, 0x1d, 0x9f, 0xf5, 0xbd, 0x31, 0x95, 0x0a, 0x5a, 0xbe, 0x3f, 0x2b, 0xe7, 0x7e, 0x40,
+	0x18, 0xc7, 0x41, 0xac, 0x15, 0x76, 0xdc, 0x88, 0x05, 0x11, 0x73, 0xd4, 0xbe, 0x8a, 0xd0, 0xa2,
+	0xc3, 0x24, 0x88, 0x00, 0xd3, 0x0b, 0xc2, 0x03, 0x1c, 0x8b, 0x30, 0x14, 0xa1, 0x54, 0xac, 0x3f,
+	0x19, 0x80, 0x3e, 0x1d, 0x13, 0x7a, 0x75, 0x42, 0x7d, 0x97, 0x30, 0x9b, 0x3c, 0x1c, 0x13, 0xc6,
+	0x91, 0x09, 0x75, 0xee, 0xbb, 0x17, 0x84, 0x32, 0xd3, 0x38, 0x58, 0xe9, 0x36, 0xed, 0x84, 0x44,
+	0xaf, 0xc3, 0x9a, 0xfa, 0x74, 0x62, 0x4a, 0xce, 0xfc, 0x4b, 0x73, 0xf9, 0xc0, 0xe8, 0x36, 0xed,
+	0x55, 0xc5, 0x3c, 0x91, 0x3c, 0x84, 0xa0, 0x72, 0x8a, 0x19, 0x31, 0x57, 0xa4, 0x4c, 0x7e, 0xa3,
+	0x6d, 0xa8, 0x3e, 0x1c, 0x47, 0x9c, 0x98, 0x15, 0xc9, 0x54, 0x04, 0xfa, 0x36, 0xd4, 0x03, 0x7c,
+	0xe9, 0xe0, 0x21, 0x31, 0xab, 0x07, 0x46, 0xb7, 0x35, 0xd8, 0xe9, 0xa9, 0x80, 0x7b, 0x49, 0xc0,
+	0xbd, 0x63, 0x9d, 0x90, 0xbb, 0x8d, 0xc7, 0x4f, 0xf7, 0x8d, 0xdf, 0xfd, 0x73, 0xdf, 0xb0, 0x6b,
+	0x01, 0xbe, 0xfc, 0xde, 0x90, 0x58, 0xff, 0xa9, 0xc0, 0x56, 0xce, 0x7b, 0x16, 0x47, 0x21, 0x23,
+	0xe8, 0x53, 0xa8, 0xc5, 0x92, 0x23, 0xbd, 0x6f, 0x0d, 0xde, 0xeb, 0xcd, 0x9f, 0x56, 0xaf, 0xc0,
+	0xb0, 0xa7, 0xc8, 0x8f, 0x43, 0x4e, 0xaf, 0xee, 0x56, 0x1e, 0x3f, 0xdd, 0x5f, 0xb2, 0xf5, 0x42,
+	0xe8, 0x2e, 0x34, 0xd3, 0xcc, 0xcb, 0x98, 0x5b, 0x83, 0xf6, 0x9c, 0xab, 0x0f, 0x12, 0x0d, 0xe9,
+	0xeb, 0xd2, 0x17, 0xc2, 0xd7, 0xa9, 0x99, 0xc8, 0xea, 0x84, 0x50, 0xe6, 0x47, 0xa1, 0xce, 0x4c,
+	0x42, 0xa2, 0x9f, 0x43, 0xcb, 0xf3, 0x59, 0xac, 0x28, 0x66, 0x56, 0xa4, 0xd7, 0x1f, 0x94, 0xf5,
+	0xfa, 0x78, 0x6a, 0x9a, 0x75, 0x3d, 0xbb, 0x24, 0xc2, 0xb0, 0x1e, 0x53, 0x3f, 0xa2, 0x3e, 0xbf,
+	0x72, 0xb8, 0x2f, 0x0e, 0xb6, 0x2a, 0x37, 0xf9, 0xe8, 0x05, 0x52, 0x23, 0xad, 0x1f, 0x08, 0x63,
+	0xb9, 0x8d, 0xbd, 0x16, 0x67, 0x79, 0xed, 0x0f, 0xa1, 0x95, 0xc9, 0x1f, 0xda, 0x84, 0x95, 0x0b,
+	0x72, 0x65, 0x1a, 0x32, 0x52, 0xf1, 0x29, 0x4a, 0x60, 0x82, 0x47, 0x63, 0xa2, 0x6b, 0x46, 0x11,
+	0x1f, 0x2d, 0x7f, 0x60, 0xb4, 0x5d, 0xd8, 0x9c, 0x0d, 0xa2, 0xc0, 0xfe, 0xc3, 0xac, 0x7d, 0x6b,
+	0xf0, 0x7a, 0x91, 0xeb, 0xd2, 0x83, 0xe9, 0x5a, 0xd9, 0x4d, 0xbe, 0x0b, 0x68, 0x3e, 0x88, 0x45,
+	0x6e, 0xae, 0x65, 0x56, 0xb0, 0xbe, 0x09, 0xa6, 0x4c, 0xcd, 0x7d, 0x4e, 0x09, 0x0e, 0x4a, 0xb6,
+	0x8c, 0xf5, 0x7b, 0x03, 0x36, 0x66, 0xdc, 0x42, 0xaf, 0x41, 0x9d, 0x71, 0xcf, 0xf1, 0xc8, 0x44,
+	0xef, 0x5c, 0x63, 0xdc, 0x3b, 0x26, 0x13, 0xd4, 0x87, 0x2d, 0x3f, 0xe4, 0x84, 0x3e, 0x1c, 0x63,
+	0xca, 0xfd, 0x11, 0x71, 0x28, 0x0e, 0x87, 0x49, 0xc6, 0x50, 0x4e, 0x64, 0x0b, 0x09, 0x7a, 0x53,
+	0x1c, 0x6c, 0x34, 0xf1, 0x3d, 0x42, 0x1d, 0x37, 0x1a, 0x87, 0x5c, 0xd6, 0x56, 0xc5, 0x5e, 0x4b,
+	0xb8, 0x47, 0x82, 0x29, 0xc2, 0x0c, 0xfc, 0x50, 0x37, 0x9f, 0xf8, 0x94, 0x1c, 0x7c, 0x69, 0x56,
+	0x35, 0x07, 0x5f, 0x5a, 0xaf, 0xc1, 0xab, 0x32, 0xbc, 0x7b, 0x12, 0x21, 0xee, 0xe1, 0x58, 0xc7,
+	0x66, 0xfd, 0x14, 0x6e, 0xcd, 0x0a, 0x74, 0xa7, 0xdd, 0x01, 0x50, 0x78, 0xe2, 0x04, 0x38, 0x96,
+	0xa1, 0xb4, 0x06, 0xfb, 0xe9, 0xb9, 0xa4, 0xb8, 0x23, 0x4e, 0x66, 0x6a, 0xdc, 0x0c, 0x92, 0x4f,
+	0xeb, 0x55, 0xdd, 0xc0, 0x3f, 0xd1, 0xc7, 0xa5, 0x37, 0x7c, 0x17, 0xb6, 0xf3, 0x6c, 0xbd, 0x5d,
+	0xa6, 0x83, 0x8c, 0x5c, 0x07, 0x59, 0x7b, 0xd0, 0xd6, 0x55, 0xab, 0xa2, 0xfe, 0x84, 0xe0, 0x11,
+	0x3f, 0x4f, 0xd6, 0x8b, 0x61, 0xb7, 0x50, 0x9a, 0xe2, 0xc5, 0x46, 0x9a, 0xc3, 0x73, 0x29, 0xd2,
+	0xc0, 0x61, 0x15, 0x97, 0x58, 0x76, 0x11, 0xdd, 0x6c, 0xeb, 0x71, 0x8e, 0x6b, 0xfd, 0x75, 0x19,
+	0xd6, 0xf3, 0x8a, 0xa8, 0x0d, 0x8d, 0x44, 0x49, 0x7b, 0x9f, 0xd2, 0xe8, 0x16, 0xd4, 0x54, 0xb9,
+	0xe8, 0x93, 0xd6, 0x94, 0xa8, 0x45, 0xe6, 0x46, 0x54, 0x41, 0xa9, 0x61, 0x2b, 0x02, 0x7d, 0x0d,
+	0x80, 0x50, 0x1a, 0x51, 0x87, 0x62, 0x0d, 0xa8, 0x86, 0xdd, 0x94, 0x1c, 0x1b, 0x73, 0x29, 0x66,
+	0x1c, 0xcb, 0xda, 0xe1, 0x0a, 0x57, 0x0d, 0xbb, 0x29, 0x39, 0x52, 0xfc, 0x26, 0xac, 0x7b, 0x64,
+	0xe2, 0x4b, 0x50, 0x55, 0x2a, 0x35, 0xa9, 0xb2, 0x96, 0x72, 0xa5, 0x9a, 0x09, 0x75, 0x86, 0x83,
+	0x78, 0x44, 0x98, 0x59, 0x97, 0x15, 0x95, 0x90, 0xe8, 0x00, 0x5a, 0xa2, 0x06, 0x71, 0xc8, 0xfd,
+	0x90, 0x78, 0x66, 0xe3, 0xc0, 0xe8, 0x36, 0xec, 0x2c, 0x0b, 0xdd, 0x83, 0x57, 0x32, 0xa4, 0xc3,
+	0xfc, 0xd0, 0x25, 0x66, 0x73, 0x21, 0x6a, 0x56, 0x24, 0x62, 0x6e, 0x66, 0x4c, 0xef, 0x0b, 0x4b,
+	0xeb, 0x8f, 0x86, 0x6e, 0x3c, 0xd9, 0x46, 0x9f, 0xf8, 0x8c, 0x47, 0xf4, 0x2a, 0x69, 0xbc, 0x69,
+	0xea, 0x8c, 0x5c, 0xea, 0xb2, 0xe9, 0x5e, 0x9e, 0x49, 0xf7, 0xfb, 0x50, 0x65, 0x1c, 0x53, 0xd5,
+	0x2b, 0x65, 0x7c, 0x52, 0xea, 0x68, 0x00, 0x2b, 0x24, 0xf4, 0xcc, 0x4a, 0x49, 0x2b, 0xa1, 0x6c,
+	0x7d, 0x0e, 0x3b, 0x05, 0xbe, 0xeb, 0xca, 0xfb, 0x0e, 0xd4, 0x29, 0x71, 0x23, 0xea, 0x25, 0xa3,
+	0x6a, 0xff, 0x5a, 0x50, 0xb3, 0xa5, 0x9e, 0x2e, 0xb7, 0xc4, 0xca, 0xfa, 0x9b, 0x01, 0xad, 0x8c,
+	0xf8, 0xda, 0x6c, 0xbc, 0x8c, 0xf9, 0xb5, 0x0d, 0x55, 0x39, 0x0d, 0xf5, 0xf4, 0x52, 0x04, 0x3a,
+	0xc9, 0x34, 0x8f, 0x9e, 0xba, 0x6a, 0x7e, 0x1d, 0xde, 0xd4, 0x3c, 0xd2, 0xe7, 0xd9, 0xde, 0x91,
+	0x4c, 0x66, 0x5d, 0xc0, 0x5a, 0x4e, 0xed, 0xc6, 0xce, 0xe9, 0xc2, 0x66, 0x74, 0x76, 0xe6, 0xb8,
+	0xe7, 0xd8, 0x0f, 0x9d, 0x5c, 0x0f, 0xad, 0x47, 0x67, 0x67, 0x47, 0x82, 0xfd, 0x20, 0xed, 0xa5,
+	0x79, 0xf7, 0xad, 0xf7, 0x67, 0x80, 0xa3, 0x2c, 0xaa, 0xff, 0x65, 0x19, 0x76, 0x0b, 0x0d, 0xf5,
+	0xc9, 0xd2, 0xf9, 0xb4, 0xa8, 0x13, 0x3e, 0xba, 0x61, 0xe2, 0x16, 0xad, 0x94, 0x4f, 0x59, 0x6e,
+	0xc2, 0xcf, 0x24, 0xee, 0x65, 0x1c, 0x72, 0xfb, 0x02, 0xb6, 0x0a, 0x36, 0x2c, 0x18, 0x93, 0x77,
+	0xf2, 0xd3, 0xb8, 0xbb, 0xf0, 0xb4, 0x8f, 0x09, 0xc7, 0xfe, 0x88, 0x65, 0x07, 0xaa, 0x03, 0xdb,
+	0x45, 0x2a, 0xe8, 0x07, 0x50, 0xf7, 0xd4, 0xa7, 0x4e, 0xda, 0xed, 0x92, 0xab, 0x27, 0xed, 0xa1,
+	0xad, 0xad, 0x3f, 0xaf, 0xc0, 0x56, 0x81, 0xda, 0x4b, 0xaa, 0xa8, 0x5d, 0x68, 0x52, 0xfc, 0xc8,
+	0xc9, 0x56, 0x55, 0x83, 0xe2, 0x47, 0xaa, 0x68, 0x6f, 0xc3, 0x86, 0x1b, 0x85, 0x13, 0x42, 0x39,
+	0xf1, 0xb4, 0x8a, 0x9a, 0xbe, 0xeb, 0x29, 0x5b, 0x29, 0xde, 0xc9, 0x9e, 0x5a, 0xb5, 0x24, 0xb4,
+	0x64, 0xda, 0xf2, 0x16, 0xd4, 0x7c, 0xb9, 0xa0, 0xc4, 0xf1, 0x86, 0xad, 0x29, 0xf4, 0x39, 0xa0,
+	0x30, 0xa2, 0x01, 0x1e, 0xf9, 0xbf, 0x50, 0x58, 0x1f, 0x63, 0x7e, 0x6e, 0xd6, 0x67, 0xf2, 0x99,
+	0x9b, 0xd1, 0x3f, 0xca, 0xea, 0x9f, 0x60, 0x9f, 0xea, 0x7c, 0xbe, 0x12, 0xe6, 0x05, 0x6a, 0x9a,
+	0xf9, 0xa1, 0x3b, 0x1a, 0x7b, 0xe9, 0x04, 0x48, 0x69, 0xf4, 0x16, 0x6c, 0x92, 0x4b, 0x77, 0x34,
+	0x16, 0x93, 0xd9, 0xa1, 0x04, 0xb3, 0x28, 0x94, 0xe8, 0xdf, 0xb4, 0x37, 0x52, 0xbe, 0x2d, 0xd9,
+	0x62, 0x56, 0xf1, 0x47, 0x38, 0xd6, 0x09, 0x02, 0xa9, 0xd4, 0x14, 0x1c, 0x99, 0x9b, 0xb9, 0xb1,
+	0x7e, 0x9f, 0x63, 0x3e, 0x4e, 0xba, 0xd3, 0x22, 0xb0, 0x5b, 0x28, 0xd5, 0x2d, 0xf8, 0x7d, 0x68,
+	0x26, 0x87, 0xca, 0xca, 0x0c, 0x74, 0x65, 0xae, 0x43, 0x9e, 0x9a, 0x5a, 0x7f, 0xc8, 0xcc, 0x72,
+	0xa5, 0x23, 0x5e, 0x38, 0x21, 0x0e, 0x88, 0xae, 0x1d, 0xf9, 0x2d, 0x78, 0xfc, 0x2a, 0x4e, 0xee,
+	0x6a, 0xf2, 0x5b, 0x84, 0x97, 0xb9, 0x1f, 0xad, 0xc8, 0x3c, 0x4d, 0xaf, 0x3f, 0x02, 0x5e, 0xe8,
+	0x38, 0x0c, 0xfd, 0x70, 0x28, 0x6b, 0xa3, 0x61, 0x27, 0x24, 0x3a, 0x04, 0xfd, 0xa4, 0xd2, 0x97,
+	0xba, 0xaa, 0x1c, 0xc1, 0x2d, 0xc5, 0x53, 0x57, 0xba, 0x23, 0x58, 0x1d, 0x61, 0xc6, 0x9d, 0x71,
+	0xec, 0x61, 0x4e, 0x3c, 0xb3, 0x56, 0xb2, 0x74, 0x5a, 0xc2, 0xea, 0x33, 0x65, 0x84, 0x7e, 0x08,
+	0x6b, 0x94, 0xb8, 0x24, 0xe4, 0x8e, 0xbc, 0x3f, 0x30, 0xb3, 0xbe, 0x18, 0xbb, 0x3f, 0x16, 0x9a,
+	0x3a, 0x4d, 0xab, 0xca, 0x5a, 0xb2, 0x98, 0xf5, 0xa5, 0x31, 0x85, 0x6e, 0xc9, 0xba, 0x76, 0x1e,
+	0x21, 0xa8, 0xb8, 0x91, 0xa7, 0x92, 0xb5, 0x62, 0xcb, 0x6f, 0x01, 0xd0, 0xd2, 0x89, 0x04, 0xa0,
+	0x25, 0x91, 0x07, 0xb5, 0xca, 0xff, 0x05, 0x6a, 0x83, 0xff, 0x36, 0xa0, 0xf6, 0x63, 0xf9, 0x7c,
+	0x47, 0xbf, 0x84, 0x9a, 0x46, 0xcb, 0xaf, 0x2f, 0x7c, 0xfa, 0xc8, 0x2a, 0x6b, 0xdf, 0x2e, 0xf9,
+	0x44, 0xb2, 0x0e, 0x7f, 0xfd, 0xf7, 0x7f, 0xff, 0x76, 0x79, 0x17, 0xed, 0xf4, 0x93, 0x87, 0xb7,
+	0xfa, 0xcb, 0x40, 0xbc, 0xba, 0xf5, 0x33, 0xd2, 0x87, 0xd5, 0xec, 0xe3, 0x01, 0x7d, 0xe3, 0xda,
+	0xb5, 0x0b, 0xde, 0x18, 0xe5, 0x3d, 0x59, 0x7a, 0xd7, 0x40, 0xbf, 0x31, 0xa0, 0x99, 0xde, 0xb9,
+	0xd1, 0x5b, 0xd7, 0x9a, 0xce, 0xde, 0xf6, 0xdb, 0x6f, 0x97, 0x51, 0xd5, 0x1b, 0xbd, 0x21, 0x43,
+	0xee, 0xa0, 0xbd, 0x82, 0x90, 0x53, 0x64, 0x41, 0xbf, 0x32, 0xa0, 0xae, 0xaf, 0xf2, 0xe8, 0xfa,
+	0x18, 0xf2, 0x6f, 0x80, 0x76, 0x77, 0xb1, 0xa2, 0x76, 0xc2, 0x92, 0x4e, 0xec, 0xa1, 0x76, 0x81,
+	0x13, 0xc9, 0x0b, 0xfb, 0x2b, 0x63, 0xee, 0x3e, 0xde, 0x5b, 0x38, 0x88, 0x73, 0x8f, 0x88, 0x76,
+	0xbf, 0xb4, 0xbe, 0xf6, 0xeb, 0x6d, 0xe9, 0xd7, 0x1b, 0xc8, 0x2a, 0xac, 0x87, 0xdc, 0x7b, 0x03,
+	0x7d, 0x69, 0xc0, 0x6a, 0xf6, 0x86, 0x78, 0x43, 0x65, 0x14, 0x5c, 0x82, 0xdb, 0xef, 0x94, 0xd4,
+	0xd6, 0x9e, 0x75, 0xa5, 0x67, 0x16, 0x3a, 0xb8, 0xae, 0x52, 0x9d, 0x73, 0xed, 0x46, 0x36, 0x6f,
+	0xba, 0x66, 0x7b, 0xa5, 0x2f, 0x30, 0x65, 0xf3, 0x36, 0x53, 0xbd, 0xa5, 0xf2, 0xa6, 0x1b, 0xea,
+	0x2b, 0x63, 0x0e, 0x9b, 0x17, 0xfb, 0x97, 0x9b, 0x22, 0xed, 0x7e, 0x69, 0xfd, 0x17, 0xf1, 0x8f,
+	0xa9, 0x61, 0xf2, 0xd9, 0xe3, 0x67, 0x1d, 0xe3, 0xc9, 0xb3, 0x8e, 0xf1, 0xaf, 0x67, 0x1d, 0xe3,
+	0x8b, 0xe7, 0x9d, 0xa5, 0x27, 0xcf, 0x3b, 0x4b, 0xff, 0x78, 0xde, 0x59, 0xfa, 0xd9, 0xb7, 0x86,
+	0x3e, 0x3f, 0x1f, 0x9f, 0xf6, 0xdc, 0x28, 0xe8, 0xb3, 0x0b, 0x3f, 0x7e, 0x27, 0x20, 0x93, 0x74,
+	0xc1, 0xc9, 0x20, 0xfd, 0xe7, 0x51, 0xfc, 0x12, 0xca, 0x92, 0x3d, 0xc4, 0x58, 0x61, 0xa7, 0x35,
+	0x89, 0x7c, 0xef, 0xfd, 0x6f, 0x00, 0x5b, 0x2b, 0xce, 0x2b, 0xa8, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// OracleClient is the client API for Oracle service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OracleClient interface {
+	// Prices defines a method for fetching the latest prices.
+	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. The current
+	// prices are sent immediately, if the oracle has any, and the updated prices
+	// are sent after every price update. Updates are coalesced, so a slow client
+	// only receives the latest prices. Over HTTP, the stream is served as
+	// server-sent events at "/connect/oracle/v2/stream_prices".
+	StreamPrices(ctx context.Context, in *QueryStreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error)
+	// MarketMap defines a method for fetching the latest market map
+	// configuration.
+	MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error)
+	// Version defines a method for fetching the current version of the oracle
+	// service.
+	Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error)
+	// ProviderHealth defines a method for fetching the health of the price of
+	// every (provider, ticker) pair, including whether the pair is quarantined.
+	ProviderHealth(ctx context.Context, in *QueryProviderHealthRequest, opts ...grpc.CallOption) (*QueryProviderHealthResponse, error)
+	// PriceHistory defines a method for fetching the recent aggregated and
+	// provider prices retained by the oracle, filtered by ticker, provider and
+	// time range.
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
+	// ProviderPrices defines a method for fetching the price of each provider
+	// config of every ticker in the latest aggregation round, including how each
+	// price was converted and whether it was used to calculate the ticker's
+	// price.
+	ProviderPrices(ctx context.Context, in *QueryProviderPricesRequest, opts ...grpc.CallOption) (*QueryProviderPricesResponse, error)
+	// ProviderStatus defines a method for fetching the status of every provider
+	// run by the oracle, including whether it is running, when it last fetched
+	// data and the errors it recently encountered.
+	ProviderStatus(ctx context.Context, in *QueryProviderStatusRequest, opts ...grpc.CallOption) (*QueryProviderStatusResponse, error)
+}
+
+type oracleClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewOracleClient(cc grpc1.ClientConn) OracleClient {
+	return &oracleClient{cc}
+}
+
+func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
+	out := new(QueryPricesResponse)
+	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/Prices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) StreamPrices(ctx context.Context, in *QueryStreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Oracle_serviceDesc.Streams[0], "/connect.service.v2.Oracle/StreamPrices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oracleStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Oracle_StreamPricesClient interface {
+	Recv() (*QueryPricesResponse, error)
+	grpc.ClientStream
+}
+
+type oracleStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *oracleStreamPricesClient) Recv() (*QueryPricesResponse, error) {
+	m := new(QueryPricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *oracleClient) MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error) {
+	out := new(QueryMarketMapResponse)
+	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/MarketMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error) {
+	out := new(QueryVersionResponse)
+	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/Version", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) ProviderHealth(ctx context.Context, in *QueryProviderHealthRequest, opts ...grpc.CallOption) (*QueryProviderHealthResponse, error) {
+	out := new(QueryProviderHealthResponse)
+	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/ProviderHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/PriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) ProviderPrices(ctx context.Context, in *QueryProviderPricesRequest, opts ...grpc.CallOption) (*QueryProviderPricesResponse, error) {
+	out := new(QueryProviderPricesResponse)
+	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/ProviderPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) ProviderStatus(ctx context.Context, in *QueryProviderStatusRequest, opts ...grpc.CallOption) (*QueryProviderStatusResponse, error) {
+	out := new(QueryProviderStatusResponse)
+	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/ProviderStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OracleServer is the server API for Oracle service.
+type OracleServer interface {
+	// Prices defines a method for fetching the latest prices.
+	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. The current
+	// prices are sent immediately, if the oracle has any, and the updated prices
+	// are sent after every price update. Updates are coalesced, so a slow client
+	// only receives the latest prices. Over HTTP, the stream is served as
+	// server-sent events at "/connect/oracle/v2/stream_prices".
+	StreamPrices(*QueryStreamPricesRequest, Oracle_StreamPricesServer) error
+	// MarketMap defines a method for fetching the latest market map
+	// configuration.
+	MarketMap(context.Context, *QueryMarketMapRequest) (*QueryMarketMapResponse, error)
+	// Version defines a method for fetching the current version of the oracle
+	// service.
+	Version(context.Context, *QueryVersionRequest) (*QueryVersionResponse, error)
+	// ProviderHealth defines a method for fetching the health of the price of
+	// every (provider, ticker) pair, including whether the pair is quarantined.
+	ProviderHealth(context.Context, *QueryProviderHealthRequest) (*QueryProviderHealthResponse, error)
+	// PriceHistory defines a method for fetching the recent aggregated and
+	// provider prices retained by the oracle, filtered by ticker, provider and
+	// time range.
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
+	// ProviderPrices defines a method for fetching the price of each provider
+	// config of every ticker in the latest aggregation round, including how each
+	// price was converted and whether it was used to calculate the ticker's
+	// price.
+	ProviderPrices(context.Context, *QueryProviderPricesRequest) (*QueryProviderPricesResponse, error)
+	// ProviderStatus defines a method for fetching the status of every provider
+	// run by the oracle, including whether it is running, when it last fetched
+	// data and the errors it recently encountered.
+	ProviderStatus(context.Context, *QueryProviderStatusRequest) (*QueryProviderStatusResponse, error)
+}
+
+// UnimplementedOracleServer can be embedded to have forward compatible implementations.
+type UnimplementedOracleServer struct {
+}
+
+func (*UnimplementedOracleServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+}
+func (*UnimplementedOracleServer) StreamPrices(req *QueryStreamPricesRequest, srv Oracle_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
+func (*UnimplementedOracleServer) MarketMap(ctx context.Context, req *QueryMarketMapRequest) (*QueryMarketMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+}
+func (*UnimplementedOracleServer) Version(ctx context.Context, req *QueryVersionRequest) (*QueryVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+func (*UnimplementedOracleServer) ProviderHealth(ctx context.Context, req *QueryProviderHealthRequest) (*QueryProviderHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderHealth not implemented")
+}
+func (*UnimplementedOracleServer) PriceHistory(ctx context.Context, req *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
+func (*UnimplementedOracleServer) ProviderPrices(ctx context.Context, req *QueryProviderPricesRequest) (*QueryProviderPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPrices not implemented")
+}
+func (*UnimplementedOracleServer) ProviderStatus(ctx context.Context, req *QueryProviderStatusRequest) (*QueryProviderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderStatus not implemented")
+}
+
+func RegisterOracleServer(s grpc1.Server, srv OracleServer) {
+	s.RegisterService(&_Oracle_serviceDesc, srv)
+}
+
+func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).Prices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.service.v2.Oracle/Prices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).Prices(ctx, req.(*QueryPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryStreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OracleServer).StreamPrices(m, &oracleStreamPricesServer{stream})
+}
+
+type Oracle_StreamPricesServer interface {
+	Send(*QueryPricesResponse) error
+	grpc.ServerStream
+}
+
+type oracleStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *oracleStreamPricesServer) Send(m *QueryPricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Oracle_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).MarketMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.service.v2.Oracle/MarketMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).MarketMap(ctx, req.(*QueryMarketMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.service.v2.Oracle/Version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).Version(ctx, req.(*QueryVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_ProviderHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).ProviderHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.service.v2.Oracle/ProviderHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).ProviderHealth(ctx, req.(*QueryProviderHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.service.v2.Oracle/PriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_ProviderPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).ProviderPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.service.v2.Oracle/ProviderPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).ProviderPrices(ctx, req.(*QueryProviderPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_ProviderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).ProviderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.service.v2.Oracle/ProviderStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).ProviderStatus(ctx, req.(*QueryProviderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Oracle_serviceDesc = _Oracle_serviceDesc
+var _Oracle_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.service.v2.Oracle",
+	HandlerType: (*OracleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Prices",
+			Handler:    _Oracle_Prices_Handler,
+		},
+		{
+			MethodName: "MarketMap",
+			Handler:    _Oracle_MarketMap_Handler,
+		},
+		{
+			MethodName: "Version",
+			Handler:    _Oracle_Version_Handler,
+		},
+		{
+			MethodName: "ProviderHealth",
+			Handler:    _Oracle_ProviderHealth_Handler,
+		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Oracle_PriceHistory_Handler,
+		},
+		{
+			MethodName: "ProviderPrices",
+			Handler:    _Oracle_ProviderPrices_Handler,
+		},
+		{
+			MethodName: "ProviderStatus",
+			Handler:    _Oracle_ProviderStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _Oracle_StreamPrices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "connect/service/v2/oracle.proto",
+}
+
+func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAge != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxAge):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintOracle(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TickerPrefix) > 0 {
+		i -= len(m.TickerPrefix)
+		copy(dAtA[i:], m.TickerPrefix)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.TickerPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriorityTiers) > 0 {
+		for k := range m.PriorityTiers {
+			v := m.PriorityTiers[k]
+			baseI := i
+			i = encodeVarintOracle(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Dispersions) > 0 {
+		for k := range m.Dispersions {
+			v := m.Dispersions[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Prices) > 0 {
+		for k := range m.Prices {
+			v := m.Prices[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceDispersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceDispersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceDispersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProviderCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ProviderCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InterquartileRange) > 0 {
+		i -= len(m.InterquartileRange)
+		copy(dAtA[i:], m.InterquartileRange)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.InterquartileRange)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StdDev) > 0 {
+		i -= len(m.StdDev)
+		copy(dAtA[i:], m.StdDev)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.StdDev)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketMapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketMap != nil {
+		{
+			size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderHealth) > 0 {
+		for iNdEx := len(m.ProviderHealth) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderHealth[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuarantinedSince != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.QuarantinedSince, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuarantinedSince):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintOracle(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Quarantined {
+		i--
+		if m.Quarantined {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Samples != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DeviationRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DeviationRate))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.StaleRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.StaleRate))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.ErrorRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ErrorRate))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.End):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintOracle(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Start != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Start):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintOracle(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderPrices) > 0 {
+		for iNdEx := len(m.ProviderPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintOracle(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OffChainTicker) > 0 {
+		i -= len(m.OffChainTicker)
+		copy(dAtA[i:], m.OffChainTicker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.OffChainTicker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintOracle(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProviderPrices) > 0 {
+		for k := range m.ProviderPrices {
+			v := m.ProviderPrices[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPriceDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPriceDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPriceDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		for iNdEx := len(m.Details) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Details[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPriceDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPriceDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPriceDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TwapPrice) > 0 {
+		i -= len(m.TwapPrice)
+		copy(dAtA[i:], m.TwapPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.TwapPrice)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ExclusionReason) > 0 {
+		i -= len(m.ExclusionReason)
+		copy(dAtA[i:], m.ExclusionReason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ExclusionReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Included {
+		i--
+		if m.Included {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.NormalizationPath) > 0 {
+		for iNdEx := len(m.NormalizationPath) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NormalizationPath[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Invert {
+		i--
+		if m.Invert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Timestamp != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Timestamp):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintOracle(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConvertedPrice) > 0 {
+		i -= len(m.ConvertedPrice)
+		copy(dAtA[i:], m.ConvertedPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ConvertedPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RawPrice) > 0 {
+		i -= len(m.RawPrice)
+		copy(dAtA[i:], m.RawPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.RawPrice)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OffChainTicker) > 0 {
+		i -= len(m.OffChainTicker)
+		copy(dAtA[i:], m.OffChainTicker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.OffChainTicker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecentErrors) > 0 {
+		for iNdEx := len(m.RecentErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.LastUpdated != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUpdated, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdated):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintOracle(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TickerCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TickerCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Running {
+		i--
+		if m.Running {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MarketMap {
+		i--
+		if m.MarketMap {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintOracle(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.TickerPrefix)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MaxAge != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxAge)
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for k, v := range m.Prices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Dispersions) > 0 {
+		for k, v := range m.Dispersions {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + l + sovOracle(uint64(l))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PriorityTiers) > 0 {
+		for k, v := range m.PriorityTiers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + sovOracle(uint64(v))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryStreamPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceDispersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StdDev)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.InterquartileRange)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ProviderCount != 0 {
+		n += 1 + sovOracle(uint64(m.ProviderCount))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketMapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMarketMapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketMap != nil {
+		l = m.MarketMap.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderHealth) > 0 {
+		for _, e := range m.ProviderHealth {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if m.ErrorRate != 0 {
+		n += 9
+	}
+	if m.StaleRate != 0 {
+		n += 9
+	}
+	if m.DeviationRate != 0 {
+		n += 9
+	}
+	if m.Samples != 0 {
+		n += 1 + sovOracle(uint64(m.Samples))
+	}
+	if m.Quarantined {
+		n += 2
+	}
+	if m.QuarantinedSince != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuarantinedSince)
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Start != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Start)
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.End != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.End)
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.ProviderPrices) > 0 {
+		for _, e := range m.ProviderPrices {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.OffChainTicker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderPrices) > 0 {
+		for k, v := range m.ProviderPrices {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + l + sovOracle(uint64(l))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ProviderPriceDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		for _, e := range m.Details {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderPriceDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.OffChainTicker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.RawPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ConvertedPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Timestamp)
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Invert {
+		n += 2
+	}
+	if len(m.NormalizationPath) > 0 {
+		for _, e := range m.NormalizationPath {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.Included {
+		n += 2
+	}
+	l = len(m.ExclusionReason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.TwapPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MarketMap {
+		n += 2
+	}
+	if m.Running {
+		n += 2
+	}
+	if m.TickerCount != 0 {
+		n += 1 + sovOracle(uint64(m.TickerCount))
+	}
+	if m.LastUpdated != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdated)
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.RecentErrors) > 0 {
+		for _, e := range m.RecentErrors {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovOracle(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickerPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickerPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxAge == nil {
+				m.MaxAge = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prices == nil {
+				m.Prices = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Prices[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dispersions == nil {
+				m.Dispersions = make(map[string]PriceDispersion)
+			}
+			var mapkey string
+			mapvalue := &PriceDispersion{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PriceDispersion{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Dispersions[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriorityTiers == nil {
+				m.PriorityTiers = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PriorityTiers[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceDispersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceDispersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceDispersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdDev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StdDev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterquartileRange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterquartileRange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderCount", wireType)
+			}
+			m.ProviderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketMapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketMapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketMapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketMapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketMap == nil {
+				m.MarketMap = &types.MarketMap{}
+			}
+			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderHealth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderHealth = append(m.ProviderHealth, ProviderHealth{})
+			if err := m.ProviderHealth[len(m.ProviderHealth)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ErrorRate = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.StaleRate = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DeviationRate = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantined", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quarantined = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuarantinedSince == nil {
+				m.QuarantinedSince = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.QuarantinedSince, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, PriceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderPrices = append(m.ProviderPrices, ProviderPrice{})
+			if err := m.ProviderPrices[len(m.ProviderPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffChainTicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderPrices == nil {
+				m.ProviderPrices = make(map[string]ProviderPriceDetails)
+			}
+			var mapkey string
+			mapvalue := &ProviderPriceDetails{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ProviderPriceDetails{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ProviderPrices[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPriceDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPriceDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPriceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = append(m.Details, ProviderPriceDetail{})
+			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPriceDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPriceDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPriceDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffChainTicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invert = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizationPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizationPath = append(m.NormalizationPath, types.NormalizationPair{})
+			if err := m.NormalizationPath[len(m.NormalizationPath)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Included = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExclusionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -823,10 +6683,10 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -850,7 +6710,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -873,15 +6733,15 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -908,107 +6768,187 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prices == nil {
-				m.Prices = make(map[string]string)
+			m.Providers = append(m.Providers, ProviderStatus{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOracle
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOracle
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthOracle
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthOracle
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOracle
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthOracle
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthOracle
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipOracle(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthOracle
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MarketMap = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Prices[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+			m.Running = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickerCount", wireType)
+			}
+			m.TickerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickerCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1035,15 +6975,18 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastUpdated == nil {
+				m.LastUpdated = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastUpdated, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentErrors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1053,74 +6996,26 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
+			m.RecentErrors = append(m.RecentErrors, ProviderError{})
+			if err := m.RecentErrors[len(m.RecentErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMarketMapRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketMapRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1142,7 +7037,7 @@ func (m *QueryMarketMapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketMapResponse) Unmarshal(dAtA []byte) error {
+func (m *ProviderError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1165,17 +7060,17 @@ func (m *QueryMarketMapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketMapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1185,133 +7080,80 @@ func (m *QueryMarketMapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MarketMap == nil {
-				m.MarketMap = &types.MarketMap{}
-			}
-			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVersionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVersionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVersionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVersionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1321,23 +7163,24 @@ func (m *QueryVersionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -335,6 +335,9 @@ func NewSimApp(
 		app.Logger(),
 		app.StakingKeeper,
 		voteweighted.DefaultPowerThreshold,
+		// discard stale prices and weight prices by their confidence once extended vote
+		// extensions are enabled in the x/oracle params
+		voteweighted.WithParamsKeeper(app.OracleKeeper),
 	)
 
 	// Create the pre-finalize block hook that will be used to apply oracle data
@@ -353,6 +356,10 @@ func NewSimApp(
 			compression.NewDefaultExtendedCommitCodec(),
 			compression.NewZStdCompressor(),
 		),
+		oraclepreblock.WithPerformanceKeeper(app.OracleKeeper),
+		oraclepreblock.WithSlashingHandler(
+			oraclekeeper.NewSlashingHandler(app.OracleKeeper, app.SlashingKeeper, app.StakingKeeper),
+		),
 	)
 
 	app.SetPreBlocker(oraclePreBlockHandler.WrappedPreBlocker(app.ModuleManager))
@@ -388,6 +395,13 @@ func NewSimApp(
 			app.Logger(),
 		),
 		oracleMetrics,
+		ve.WithMaxPriceAge(cfg.PriceTTL),
+		ve.WithParamsKeeper(app.OracleKeeper),
+		ve.WithSizeBudget(ve.SizeBudget{
+			MaxSize:           cfg.MaxVoteExtensionSize,
+			MovementThreshold: cfg.PriceMovementThreshold,
+			PriceKeeper:       app.OracleKeeper,
+		}),
 	)
 	app.SetExtendVoteHandler(voteExtensionsHandler.ExtendVoteHandler())
 	app.SetVerifyVoteExtensionHandler(voteExtensionsHandler.VerifyVoteExtensionHandler())
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/skip-mev/connect/v2/cmd/constants/marketmaps"
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
@@ -115,3 +116,37 @@ func (s *KeeperTestSuite) TestInitExportGenesisValid() {
 		s.Require().Equal(gs, gotState)
 	})
 }
+
+func (s *KeeperTestSuite) TestInitGenesisBundledMarketMaps() {
+	marketMaps := map[string]types.MarketMap{
+		"coinmarketcap": marketmaps.CoinMarketCapMarketMap,
+		"raydium":       marketmaps.RaydiumMarketMap,
+		"core":          marketmaps.CoreMarketMap,
+		"uniswapv3":     marketmaps.UniswapV3BaseMarketMap,
+		"coingecko":     marketmaps.CoinGeckoMarketMap,
+		"osmosis":       marketmaps.OsmosisMarketMap,
+		"polymarket":    marketmaps.PolymarketMarketMap,
+		"forex":         marketmaps.ForexMarketMap,
+	}
+
+	for name, mm := range marketMaps {
+		s.Run(name, func() {
+			// x/oracle's genesis is not initialized with these markets, so skip its hooks
+			s.keeper = s.initKeeperWithHooks(types.MultiMarketMapHooks{})
+
+			gs := types.DefaultGenesisState()
+			gs.MarketMap = mm
+
+			// the market maps that chains launch with must still be valid genesis
+			s.Require().NotPanics(func() {
+				s.keeper.InitGenesis(s.ctx, *gs)
+			})
+			s.Require().Equal(gs, s.keeper.ExportGenesis(s.ctx))
+
+			// and updating any of their markets must leave the state valid
+			for _, market := range mm.Markets {
+				s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{market}))
+			}
+		})
+	}
+}
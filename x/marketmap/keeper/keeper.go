@@ -233,7 +233,54 @@ func (k *Keeper) ValidateState(ctx sdk.Context, updates []types.Market) error {
 		}
 	}
 
-	return nil
+	// the updates may have introduced a cycle between normalization pairs of different markets. Only
+	// the markets that the updated markets depend on can be part of such a cycle, so the rest of the
+	// market map is not loaded.
+	markets, err := k.getNormalizationDependencies(ctx, updates)
+	if err != nil {
+		return err
+	}
+
+	mm := types.MarketMap{Markets: markets}
+	return mm.ValidateNormalizationCycles()
+}
+
+// getNormalizationDependencies returns the given markets as they are stored in state, along with
+// every market that they transitively depend on through the normalization pairs of their provider
+// configs. Markets that are not in state are skipped.
+func (k *Keeper) getNormalizationDependencies(ctx sdk.Context, markets []types.Market) (map[string]types.Market, error) {
+	dependencies := make(map[string]types.Market)
+
+	queue := make([]string, 0, len(markets))
+	for _, market := range markets {
+		queue = append(queue, market.Ticker.String())
+	}
+
+	for len(queue) > 0 {
+		ticker := queue[0]
+		queue = queue[1:]
+
+		if _, seen := dependencies[ticker]; seen {
+			continue
+		}
+
+		market, err := k.markets.Get(ctx, types.TickerString(ticker))
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("failed to get market for ticker %s: %w", ticker, err)
+		}
+
+		dependencies[ticker] = market
+		for _, providerConfig := range market.ProviderConfigs {
+			for _, pair := range providerConfig.NormalizationPath() {
+				queue = append(queue, pair.CurrencyPair.String())
+			}
+		}
+	}
+
+	return dependencies, nil
 }
 
 // IsMarketValid checks if a market is valid by statefully checking if each of the currency pairs
@@ -241,17 +288,17 @@ func (k *Keeper) ValidateState(ctx sdk.Context, updates []types.Market) error {
 func (k *Keeper) IsMarketValid(ctx sdk.Context, market types.Market) error {
 	// check that all markets already exist in the keeper store:
 	for _, providerConfig := range market.ProviderConfigs {
-		if providerConfig.NormalizeByPair != nil {
-			norm, err := k.markets.Get(ctx, types.TickerString(providerConfig.NormalizeByPair.String()))
+		for _, pair := range providerConfig.NormalizationPath() {
+			norm, err := k.markets.Get(ctx, types.TickerString(pair.CurrencyPair.String()))
 			if err != nil {
 				return fmt.Errorf("unable to get normalize market %s for market %s: %w",
-					providerConfig.NormalizeByPair.String(), market.Ticker.String(), err)
+					pair.CurrencyPair.String(), market.Ticker.String(), err)
 			}
 
 			// if the new market is enabled, its normalize by market must also be enabled
 			if market.Ticker.Enabled && !norm.Ticker.Enabled {
 				return fmt.Errorf("needed normalize market %s for market %s is not enabled",
-					providerConfig.NormalizeByPair.String(), market.Ticker.String())
+					pair.CurrencyPair.String(), market.Ticker.String())
 			}
 		}
 	}
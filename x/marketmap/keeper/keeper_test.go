@@ -346,6 +346,104 @@ func (s *KeeperTestSuite) TestInvalidCreateDisabledNormalizeBy() {
 	s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
 }
 
+func (s *KeeperTestSuite) TestValidUpdateMultiHopNormalizeBy() {
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, ethusdt))
+
+	// valid market normalized by BITCOIN/USDT and then by USDT/USD
+	validMarket := ethusdt
+	validMarket.ProviderConfigs = append(validMarket.ProviderConfigs, types.ProviderConfig{
+		Name:           "huobi",
+		OffChainTicker: "eth-btc",
+		NormalizeByPairs: []types.NormalizationPair{
+			{CurrencyPair: btcusdt.Ticker.CurrencyPair},
+			{CurrencyPair: usdtusd.Ticker.CurrencyPair, Invert: true},
+		},
+	})
+
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, validMarket))
+	s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{validMarket}))
+}
+
+func (s *KeeperTestSuite) TestInvalidUpdateNormalizeByCycle() {
+	// BITCOIN/USDT can only be priced through ETHEREUM/USDT
+	marketBTCUSDT := btcusdt
+	marketBTCUSDT.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "huobi",
+			OffChainTicker:  "btc-eth",
+			NormalizeByPair: &ethusdt.Ticker.CurrencyPair,
+		},
+	}
+
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, marketBTCUSDT))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, ethusdt))
+
+	// each update is valid on its own, but ETHEREUM/USDT can now only be priced through
+	// BITCOIN/USDT, which can only be priced through ETHEREUM/USDT
+	invalidMarket := ethusdt
+	invalidMarket.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:           "huobi",
+			OffChainTicker: "eth-btc",
+			NormalizeByPairs: []types.NormalizationPair{
+				{CurrencyPair: marketBTCUSDT.Ticker.CurrencyPair},
+			},
+		},
+	}
+
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, invalidMarket))
+	s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
+}
+
+func (s *KeeperTestSuite) TestValidateStateIgnoresUnrelatedCycles() {
+	// BITCOIN/USDT and ETHEREUM/USDT can only be priced through each other. This state predates
+	// the cycle check, so it is written to the store directly.
+	marketBTCUSDT := btcusdt
+	marketBTCUSDT.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "huobi",
+			OffChainTicker:  "btc-eth",
+			NormalizeByPair: &ethusdt.Ticker.CurrencyPair,
+		},
+	}
+	marketETHUSDT := ethusdt
+	marketETHUSDT.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "huobi",
+			OffChainTicker:  "eth-btc",
+			NormalizeByPair: &btcusdt.Ticker.CurrencyPair,
+		},
+	}
+
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, marketBTCUSDT))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, marketETHUSDT))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+
+	// updates to markets that do not depend on the cycle are still valid
+	validMarket := usdtusd
+	validMarket.Ticker.MinProviderCount = 2
+	validMarket.ProviderConfigs = append(validMarket.ProviderConfigs, types.ProviderConfig{
+		Name:           "okx",
+		OffChainTicker: "usdt-usd",
+	})
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, validMarket))
+	s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{validMarket}))
+
+	// markets that can only be priced through the cycle are not
+	invalidMarket := usdcusd
+	invalidMarket.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "huobi",
+			OffChainTicker:  "usdc-btc",
+			NormalizeByPair: &btcusdt.Ticker.CurrencyPair,
+		},
+	}
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, invalidMarket))
+	s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
+}
+
 func (s *KeeperTestSuite) TestDeleteMarket() {
 	// create a valid markets
 	btcCopy := btcusdt
@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 )
 
 // ValidateBasic validates the market map configuration and its expected configuration.
@@ -12,6 +15,7 @@ import (
 //		   markets are supported by the market map.
 //		2. Ensure that each provider config has a valid corresponding ticker.
 //	 	3. Ensure that all normalization markets are enabled.
+//		4. Ensure that every market can be priced without a cycle of normalization pairs.
 func (mm *MarketMap) ValidateBasic() error {
 	for ticker, market := range mm.Markets {
 		if err := market.ValidateBasic(); err != nil {
@@ -24,10 +28,10 @@ func (mm *MarketMap) ValidateBasic() error {
 		}
 
 		for _, providerConfig := range market.ProviderConfigs {
-			if providerConfig.NormalizeByPair != nil {
-				normalizeMarket, found := mm.Markets[providerConfig.NormalizeByPair.String()]
+			for _, pair := range providerConfig.NormalizationPath() {
+				normalizeMarket, found := mm.Markets[pair.CurrencyPair.String()]
 				if !found {
-					return fmt.Errorf("provider's (%s) pair for normalization (%s) was not found in the marketmap", providerConfig.Name, providerConfig.NormalizeByPair.String())
+					return fmt.Errorf("provider's (%s) pair for normalization (%s) was not found in the marketmap", providerConfig.Name, pair.CurrencyPair.String())
 				}
 
 				if !normalizeMarket.Ticker.Enabled && market.Ticker.Enabled {
@@ -37,9 +41,78 @@ func (mm *MarketMap) ValidateBasic() error {
 		}
 	}
 
+	return mm.ValidateNormalizationCycles()
+}
+
+// ValidateNormalizationCycles ensures that every market can be priced without depending on
+// itself through the normalization pairs of its provider configs. Cycles are permitted, e.g.
+// BTC/USD normalized by USDT/USD and USDT/USD normalized by BTC/USD, so long as at least one
+// market in the cycle has a provider config that does not depend on the cycle, e.g. a direct
+// USDT/USD provider. Normalization pairs that are not in the market map are ignored.
+func (mm *MarketMap) ValidateNormalizationCycles() error {
+	if unresolvable := unresolvableMarkets(mm.Markets); len(unresolvable) > 0 {
+		return fmt.Errorf(
+			"markets can only be priced through a cycle of normalization pairs: %s",
+			strings.Join(unresolvable, ", "),
+		)
+	}
+
 	return nil
 }
 
+// unresolvableMarkets returns the sorted tickers of the markets that cannot be priced without
+// a cycle of normalization pairs. A market can be priced if it has no provider configs, or if
+// any of its provider configs is only normalized by markets that can be priced.
+func unresolvableMarkets(markets map[string]Market) []string {
+	resolved := make(map[string]struct{}, len(markets))
+	isResolved := func(cp string) bool {
+		if _, found := markets[cp]; !found {
+			return true
+		}
+
+		_, ok := resolved[cp]
+		return ok
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		for ticker, market := range markets {
+			if isResolved(ticker) {
+				continue
+			}
+
+			if len(market.ProviderConfigs) == 0 {
+				resolved[ticker] = struct{}{}
+				changed = true
+				continue
+			}
+
+			for _, providerConfig := range market.ProviderConfigs {
+				if slices.ContainsFunc(providerConfig.NormalizationPath(), func(pair NormalizationPair) bool {
+					return !isResolved(pair.CurrencyPair.String())
+				}) {
+					continue
+				}
+
+				resolved[ticker] = struct{}{}
+				changed = true
+				break
+			}
+		}
+	}
+
+	var unresolvable []string
+	for ticker := range markets {
+		if !isResolved(ticker) {
+			unresolvable = append(unresolvable, ticker)
+		}
+	}
+	sort.Strings(unresolvable)
+
+	return unresolvable
+}
+
 // GetValidSubset outputs a MarketMap which contains the maximal valid subset of this MarketMap.
 //
 //	In particular, this will eliminate anything which would otherwise cause a failure in ValidateBasic.
@@ -47,19 +120,24 @@ func (mm *MarketMap) ValidateBasic() error {
 func (mm *MarketMap) GetValidSubset() (MarketMap, error) {
 	validSubset := MarketMap{Markets: make(map[string]Market)}
 
-	// Operates in 2 passes:
+	// Operates in 3 passes:
 	// 1. Remove invalid ProviderConfigs
 	for ticker, market := range mm.Markets {
 		var validProviderConfigs []ProviderConfig
+	providerConfigs:
 		for _, providerConfig := range market.ProviderConfigs {
-			if providerConfig.NormalizeByPair != nil {
-				normalizeMarket, found := mm.Markets[providerConfig.NormalizeByPair.String()]
+			if err := validateNormalizationPath(market.Ticker, providerConfig); err != nil {
+				continue
+			}
+
+			for _, pair := range providerConfig.NormalizationPath() {
+				normalizeMarket, found := mm.Markets[pair.CurrencyPair.String()]
 				if !found {
-					continue
+					continue providerConfigs
 				}
 
 				if !normalizeMarket.Ticker.Enabled && market.Ticker.Enabled {
-					continue
+					continue providerConfigs
 				}
 			}
 			validProviderConfigs = append(validProviderConfigs, providerConfig)
@@ -67,7 +145,18 @@ func (mm *MarketMap) GetValidSubset() (MarketMap, error) {
 		market.ProviderConfigs = validProviderConfigs
 		validSubset.Markets[ticker] = market
 	}
-	// 2. Remove ValidateBasic failures on all included markets
+	// 2. Remove the provider configs that depend on markets that can only be priced through a cycle
+	if unresolvable := unresolvableMarkets(validSubset.Markets); len(unresolvable) > 0 {
+		for ticker, market := range validSubset.Markets {
+			market.ProviderConfigs = slices.DeleteFunc(slices.Clone(market.ProviderConfigs), func(providerConfig ProviderConfig) bool {
+				return slices.ContainsFunc(providerConfig.NormalizationPath(), func(pair NormalizationPair) bool {
+					return slices.Contains(unresolvable, pair.CurrencyPair.String())
+				})
+			})
+			validSubset.Markets[ticker] = market
+		}
+	}
+	// 3. Remove ValidateBasic failures on all included markets
 	for ticker, market := range validSubset.Markets {
 		if err := market.ValidateBasic(); err != nil {
 			delete(validSubset.Markets, ticker)
@@ -115,6 +204,10 @@ func (m *Market) ValidateBasic() error {
 			return err
 		}
 
+		if err := validateNormalizationPath(m.Ticker, providerConfig); err != nil {
+			return err
+		}
+
 		// check for duplicate providers
 		key := providerConfig.Name + providerConfig.OffChainTicker
 		if _, seen := seenProviders[key]; seen {
@@ -127,6 +220,25 @@ func (m *Market) ValidateBasic() error {
 	return nil
 }
 
+// validateNormalizationPath ensures that the provider config is not normalized by the ticker
+// itself and that no pair appears more than once in its normalization path.
+func validateNormalizationPath(ticker Ticker, providerConfig ProviderConfig) error {
+	seenPairs := make(map[string]struct{})
+	for _, pair := range providerConfig.NormalizationPath() {
+		cp := pair.CurrencyPair.String()
+		if cp == ticker.String() {
+			return fmt.Errorf("provider's (%s) pair for normalization (%s) cannot be the ticker itself", providerConfig.Name, cp)
+		}
+
+		if _, seen := seenPairs[cp]; seen {
+			return fmt.Errorf("provider's (%s) pair for normalization (%s) is repeated", providerConfig.Name, cp)
+		}
+		seenPairs[cp] = struct{}{}
+	}
+
+	return nil
+}
+
 // String returns the string representation of the market.
 func (m *Market) String() string {
 	return fmt.Sprintf(
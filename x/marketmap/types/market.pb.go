@@ -183,6 +183,18 @@ type ProviderConfig struct {
 	// Invert is a boolean indicating if the BASE and QUOTE of the market should
 	// be inverted. i.e. BASE -> QUOTE, QUOTE -> BASE
 	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// NormalizeByPairs is an ordered list of currency pairs for this ticker to be
+	// normalized by. Each pair is applied in order, which allows a ticker to be
+	// reached through several conversions. For example, if the desired Ticker is
+	// FOO/USD, this market could be reached using: OffChainTicker = FOO/ATOM
+	// NormalizeByPairs = [ATOM/USDT, USDT/USD]. This field is optional and cannot
+	// be set alongside NormalizeByPair.
+	NormalizeByPairs []NormalizationPair `protobuf:"bytes,5,rep,name=normalize_by_pairs,json=normalizeByPairs,proto3" json:"normalize_by_pairs"`
+	// Priority is the priority tier of the provider config. Tier 0 is the highest
+	// priority. The oracle only uses the prices of lower priority tiers when the
+	// higher priority tiers do not report enough prices to meet the ticker's
+	// MinProviderCount. Provider configs default to tier 0.
+	Priority uint32 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given provider config.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
@@ -249,6 +261,20 @@ func (m *ProviderConfig) GetInvert() bool {
 	return false
 }
 
+func (m *ProviderConfig) GetNormalizeByPairs() []NormalizationPair {
+	if m != nil {
+		return m.NormalizeByPairs
+	}
+	return nil
+}
+
+func (m *ProviderConfig) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 func (m *ProviderConfig) GetMetadata_JSON() string {
 	if m != nil {
 		return m.Metadata_JSON
@@ -256,6 +282,65 @@ func (m *ProviderConfig) GetMetadata_JSON() string {
 	return ""
 }
 
+// NormalizationPair is a single conversion in a provider config's
+// normalization path.
+type NormalizationPair struct {
+	// CurrencyPair is the currency pair whose index price the price is
+	// normalized by.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Invert is a boolean indicating if the price should be divided by, rather
+	// than multiplied by, the index price of the currency pair. i.e. the price
+	// is normalized by QUOTE/BASE rather than BASE/QUOTE.
+	Invert bool `protobuf:"varint,2,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (m *NormalizationPair) Reset()         { *m = NormalizationPair{} }
+func (m *NormalizationPair) String() string { return proto.CompactTextString(m) }
+func (*NormalizationPair) ProtoMessage()    {}
+func (*NormalizationPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54627e801f077fe4, []int{3}
+}
+func (m *NormalizationPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NormalizationPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NormalizationPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NormalizationPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NormalizationPair.Merge(m, src)
+}
+func (m *NormalizationPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *NormalizationPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_NormalizationPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NormalizationPair proto.InternalMessageInfo
+
+func (m *NormalizationPair) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *NormalizationPair) GetInvert() bool {
+	if m != nil {
+		return m.Invert
+	}
+	return false
+}
+
 // MarketMap maps ticker strings to their Markets.
 type MarketMap struct {
 	// Markets is the full list of tickers and their associated configurations
@@ -266,7 +351,7 @@ type MarketMap struct {
 func (m *MarketMap) Reset()      { *m = MarketMap{} }
 func (*MarketMap) ProtoMessage() {}
 func (*MarketMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54627e801f077fe4, []int{3}
+	return fileDescriptor_54627e801f077fe4, []int{4}
 }
 func (m *MarketMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,6 +391,7 @@ func init() {
 	proto.RegisterType((*Market)(nil), "connect.marketmap.v2.Market")
 	proto.RegisterType((*Ticker)(nil), "connect.marketmap.v2.Ticker")
 	proto.RegisterType((*ProviderConfig)(nil), "connect.marketmap.v2.ProviderConfig")
+	proto.RegisterType((*NormalizationPair)(nil), "connect.marketmap.v2.NormalizationPair")
 	proto.RegisterType((*MarketMap)(nil), "connect.marketmap.v2.MarketMap")
 	proto.RegisterMapType((map[string]Market)(nil), "connect.marketmap.v2.MarketMap.MarketsEntry")
 }
@@ -313,42 +399,45 @@ func init() {
 func init() { proto.RegisterFile("connect/marketmap/v2/market.proto", fileDescriptor_54627e801f077fe4) }
 
 var fileDescriptor_54627e801f077fe4 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xd2, 0xad, 0x5e, 0xd7, 0x16, 0x6b, 0x42, 0x51, 0x85, 0xb2, 0x52, 0x76, 0xc8,
-	0x61, 0x24, 0x28, 0x5c, 0x50, 0x8f, 0xad, 0x38, 0x50, 0x31, 0x98, 0x02, 0x48, 0x88, 0x4b, 0xe4,
-	0xa6, 0x6e, 0x67, 0xb5, 0xb1, 0x23, 0xc7, 0x8d, 0x28, 0x27, 0x7e, 0x02, 0x47, 0x8e, 0x5c, 0xf8,
-	0x19, 0xdc, 0x77, 0xdc, 0x0d, 0x0e, 0x08, 0xa1, 0x56, 0xe2, 0x77, 0xa0, 0x38, 0x4e, 0xd7, 0x4a,
-	0x45, 0xda, 0xed, 0xbd, 0xe7, 0xcf, 0xdf, 0xf3, 0xf7, 0xf9, 0x3d, 0xf8, 0x20, 0xe4, 0x8c, 0x91,
-	0x50, 0xba, 0x11, 0x16, 0x53, 0x22, 0x23, 0x1c, 0xbb, 0xa9, 0xa7, 0x13, 0x27, 0x16, 0x5c, 0x72,
-	0x74, 0xac, 0x21, 0xce, 0x1a, 0xe2, 0xa4, 0x5e, 0xeb, 0x78, 0xc2, 0x27, 0x5c, 0x01, 0xdc, 0x2c,
-	0xca, 0xb1, 0xad, 0xd3, 0x82, 0x4e, 0x2e, 0x62, 0x92, 0x64, 0x54, 0xe1, 0x5c, 0x08, 0xc2, 0xc2,
-	0x45, 0x10, 0x63, 0x2a, 0x72, 0x54, 0xe7, 0x1b, 0x80, 0x95, 0x73, 0x45, 0x86, 0xba, 0xb0, 0x22,
-	0x69, 0x38, 0x25, 0xc2, 0x04, 0x6d, 0x60, 0x1f, 0x7a, 0xf7, 0x9d, 0x5d, 0xdd, 0x9c, 0x37, 0x0a,
-	0xd3, 0x2b, 0x5f, 0xfd, 0x3e, 0x31, 0x7c, 0x7d, 0x03, 0xbd, 0x85, 0xcd, 0x58, 0xf0, 0x94, 0x8e,
-	0x88, 0x08, 0x42, 0xce, 0xc6, 0x74, 0x92, 0x98, 0x7b, 0xed, 0x92, 0x7d, 0xe8, 0x9d, 0xee, 0x66,
-	0xb9, 0xd0, 0xe8, 0xbe, 0x02, 0x6b, 0xb6, 0x46, 0xbc, 0x55, 0x4d, 0xba, 0x07, 0x5f, 0xbe, 0x9e,
-	0x18, 0x9f, 0x7e, 0xb5, 0x8d, 0xce, 0x5f, 0x00, 0x2b, 0x79, 0x67, 0xf4, 0x1c, 0x1e, 0x6d, 0x29,
-	0xd1, 0xcf, 0xb5, 0xd6, 0x8d, 0x94, 0xe0, 0xac, 0x49, 0x5f, 0xc3, 0x2e, 0x30, 0x2d, 0x1e, 0x5c,
-	0x0b, 0x37, 0x6a, 0xa8, 0x05, 0x0f, 0x46, 0x24, 0xa4, 0x11, 0x9e, 0x65, 0xcf, 0x05, 0x76, 0xd9,
-	0x5f, 0xe7, 0xe8, 0x0c, 0xa2, 0x88, 0xb2, 0x60, 0x43, 0xd6, 0x9c, 0x49, 0xb3, 0xa4, 0x50, 0xcd,
-	0x88, 0xb2, 0x1b, 0x05, 0x73, 0x26, 0x91, 0x09, 0xf7, 0x09, 0xc3, 0xc3, 0x19, 0x19, 0x99, 0xf5,
-	0x36, 0xb0, 0x0f, 0xfc, 0x22, 0x45, 0x0f, 0xe1, 0x51, 0x44, 0x24, 0x1e, 0x61, 0x89, 0x83, 0xc1,
-	0xeb, 0x57, 0x2f, 0xcd, 0x46, 0x1b, 0xd8, 0x55, 0xbf, 0x56, 0x14, 0xb3, 0xda, 0x86, 0xd0, 0x1f,
-	0x00, 0xd6, 0xb7, 0xcd, 0x41, 0x08, 0x96, 0x19, 0x8e, 0x88, 0xd2, 0x59, 0xf5, 0x55, 0x8c, 0x6c,
-	0xd8, 0xe4, 0xe3, 0x71, 0x10, 0x5e, 0x62, 0xca, 0x02, 0xfd, 0x6d, 0x7b, 0xea, 0xbc, 0xce, 0xc7,
-	0xe3, 0x7e, 0x56, 0xd6, 0x76, 0x0d, 0xe0, 0x5d, 0xc6, 0x45, 0x84, 0x67, 0xf4, 0x23, 0x09, 0x86,
-	0xda, 0xb2, 0xd2, 0x6d, 0x2c, 0xf3, 0x1b, 0xeb, 0x8b, 0xbd, 0xdc, 0xaf, 0x7b, 0xb0, 0x42, 0x59,
-	0x4a, 0x84, 0x34, 0xcb, 0x4a, 0xa4, 0xce, 0x6e, 0xa5, 0xb1, 0xf3, 0x1d, 0xc0, 0x6a, 0x3e, 0x6a,
-	0xe7, 0x38, 0x46, 0x2f, 0xe0, 0x7e, 0x3e, 0x10, 0x89, 0x09, 0xd4, 0xa0, 0x9c, 0xed, 0x1e, 0x94,
-	0xf5, 0x0d, 0x1d, 0x25, 0xcf, 0x98, 0x14, 0x0b, 0xfd, 0x9b, 0x05, 0x45, 0xeb, 0x1d, 0xac, 0x6d,
-	0x1e, 0xa3, 0x26, 0x2c, 0x4d, 0xc9, 0x42, 0x3b, 0x96, 0x85, 0xc8, 0x83, 0x77, 0x52, 0x3c, 0x9b,
-	0x13, 0xe5, 0xd2, 0x7f, 0x87, 0x3b, 0x27, 0xf1, 0x73, 0x68, 0x77, 0xef, 0x29, 0xb8, 0xf9, 0x99,
-	0xde, 0xe0, 0x6a, 0x69, 0x81, 0xeb, 0xa5, 0x05, 0xfe, 0x2c, 0x2d, 0xf0, 0x79, 0x65, 0x19, 0xd7,
-	0x2b, 0xcb, 0xf8, 0xb9, 0xb2, 0x8c, 0xf7, 0x8f, 0x27, 0x54, 0x5e, 0xce, 0x87, 0x4e, 0xc8, 0x23,
-	0x37, 0x99, 0xd2, 0xf8, 0x51, 0x44, 0x52, 0xb7, 0x58, 0xbf, 0xd4, 0x73, 0x3f, 0x6c, 0xac, 0xb4,
-	0x72, 0x7a, 0x58, 0x51, 0xdb, 0xf7, 0xe4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0xa9, 0x16,
-	0x4f, 0xf4, 0x03, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0xdb, 0x2e, 0xdb, 0xbc, 0x5f, 0x9d, 0x35, 0xa1, 0xa8, 0x42, 0x59, 0x28, 0x93, 0xc8,
+	0x61, 0x24, 0x28, 0x5c, 0xd0, 0x8e, 0x9b, 0x38, 0x30, 0xb1, 0x31, 0x05, 0x90, 0x10, 0x1c, 0x22,
+	0x37, 0x75, 0x3b, 0xab, 0x8d, 0x1d, 0x39, 0x6e, 0x44, 0x39, 0xf1, 0x27, 0x70, 0xe4, 0xc8, 0x85,
+	0x3f, 0x83, 0xfb, 0x8e, 0x3b, 0x72, 0x40, 0x08, 0x6d, 0x12, 0x7f, 0x07, 0x8a, 0xe3, 0x64, 0x19,
+	0xab, 0xd0, 0x0e, 0xdc, 0xde, 0x7b, 0xfe, 0xfc, 0x3e, 0x7f, 0x9f, 0x9f, 0x0d, 0xef, 0x45, 0x9c,
+	0x31, 0x12, 0x49, 0x2f, 0xc6, 0x62, 0x4c, 0x64, 0x8c, 0x13, 0x2f, 0xf3, 0x75, 0xe2, 0x26, 0x82,
+	0x4b, 0x8e, 0xb6, 0x34, 0xc4, 0xad, 0x20, 0x6e, 0xe6, 0x77, 0xb7, 0x46, 0x7c, 0xc4, 0x15, 0xc0,
+	0xcb, 0xa3, 0x02, 0xdb, 0xdd, 0x29, 0xdb, 0xc9, 0x59, 0x42, 0xd2, 0xbc, 0x55, 0x34, 0x15, 0x82,
+	0xb0, 0x68, 0x16, 0x26, 0x98, 0x8a, 0x02, 0xd5, 0xfb, 0x0a, 0xa0, 0x71, 0xa4, 0x9a, 0xa1, 0x3d,
+	0x68, 0x48, 0x1a, 0x8d, 0x89, 0x30, 0x81, 0x0d, 0x9c, 0x15, 0xff, 0xae, 0x3b, 0x8f, 0xcd, 0x7d,
+	0xa5, 0x30, 0xfb, 0xed, 0xb3, 0x9f, 0xdb, 0x8d, 0x40, 0xef, 0x40, 0xaf, 0x61, 0x27, 0x11, 0x3c,
+	0xa3, 0x03, 0x22, 0xc2, 0x88, 0xb3, 0x21, 0x1d, 0xa5, 0x66, 0xd3, 0x6e, 0x39, 0x2b, 0xfe, 0xce,
+	0xfc, 0x2e, 0x27, 0x1a, 0x7d, 0xa0, 0xc0, 0xba, 0xdb, 0x46, 0x72, 0xad, 0x9a, 0xee, 0x2d, 0x7d,
+	0xfe, 0xb2, 0xdd, 0xf8, 0xf8, 0xc3, 0x6e, 0xf4, 0x7e, 0x03, 0x68, 0x14, 0xcc, 0xe8, 0x19, 0x5c,
+	0xbb, 0xa6, 0x44, 0x1f, 0xd7, 0xaa, 0x88, 0x94, 0xe0, 0x9c, 0xe4, 0x40, 0xc3, 0x4e, 0x30, 0x2d,
+	0x0f, 0xbc, 0x1a, 0xd5, 0x6a, 0xa8, 0x0b, 0x97, 0x06, 0x24, 0xa2, 0x31, 0x9e, 0xe4, 0xc7, 0x05,
+	0x4e, 0x3b, 0xa8, 0x72, 0xb4, 0x0b, 0x51, 0x4c, 0x59, 0x58, 0x93, 0x35, 0x65, 0xd2, 0x6c, 0x29,
+	0x54, 0x27, 0xa6, 0xec, 0x4a, 0xc1, 0x94, 0x49, 0x64, 0xc2, 0x45, 0xc2, 0x70, 0x7f, 0x42, 0x06,
+	0xe6, 0xba, 0x0d, 0x9c, 0xa5, 0xa0, 0x4c, 0xd1, 0x7d, 0xb8, 0x16, 0x13, 0x89, 0x07, 0x58, 0xe2,
+	0xf0, 0xf0, 0xe5, 0x8b, 0x63, 0x73, 0xc3, 0x06, 0xce, 0x72, 0xb0, 0x5a, 0x16, 0xf3, 0x5a, 0x4d,
+	0xe8, 0x79, 0x13, 0xae, 0x5f, 0x37, 0x07, 0x21, 0xd8, 0x66, 0x38, 0x26, 0x4a, 0xe7, 0x72, 0xa0,
+	0x62, 0xe4, 0xc0, 0x0e, 0x1f, 0x0e, 0xc3, 0xe8, 0x14, 0x53, 0x16, 0xea, 0x6b, 0x6b, 0xaa, 0xf5,
+	0x75, 0x3e, 0x1c, 0x1e, 0xe4, 0x65, 0x6d, 0xd7, 0x21, 0xdc, 0x64, 0x5c, 0xc4, 0x78, 0x42, 0x3f,
+	0x90, 0xb0, 0xaf, 0x2d, 0x6b, 0xdd, 0xc6, 0xb2, 0x60, 0xa3, 0xda, 0xb8, 0x5f, 0xf8, 0x75, 0x07,
+	0x1a, 0x94, 0x65, 0x44, 0x48, 0xb3, 0xad, 0x44, 0xea, 0x0c, 0xbd, 0x83, 0xe8, 0x06, 0x47, 0x6a,
+	0x2e, 0xa8, 0x01, 0x78, 0x30, 0x7f, 0x00, 0x8e, 0x35, 0x1e, 0x4b, 0xca, 0x59, 0xed, 0x82, 0x3a,
+	0x7f, 0x71, 0xa6, 0xf9, 0x25, 0x25, 0x82, 0x72, 0x41, 0xe5, 0xcc, 0x34, 0x6c, 0xe0, 0xac, 0x05,
+	0x55, 0x7e, 0x2b, 0x73, 0x7b, 0x19, 0xdc, 0xbc, 0xc1, 0xf6, 0x3f, 0xa7, 0xe8, 0xca, 0x95, 0x66,
+	0xdd, 0x95, 0xde, 0x37, 0x00, 0x97, 0x8b, 0xb7, 0x75, 0x84, 0x13, 0xf4, 0x1c, 0x2e, 0x16, 0x06,
+	0xa4, 0x26, 0x50, 0xc6, 0xec, 0xce, 0x37, 0xa6, 0xda, 0xa1, 0xa3, 0xf4, 0x29, 0x93, 0x62, 0xa6,
+	0x89, 0xcb, 0x16, 0xdd, 0x37, 0x70, 0xb5, 0xbe, 0x8c, 0x3a, 0xb0, 0x35, 0x26, 0x33, 0x3d, 0x22,
+	0x79, 0x88, 0x7c, 0xb8, 0x90, 0xe1, 0xc9, 0x94, 0x98, 0xcd, 0x7f, 0xbd, 0xe6, 0xa2, 0x49, 0x50,
+	0x40, 0xf7, 0x9a, 0x4f, 0xc0, 0xd5, 0x28, 0xee, 0x1f, 0x9e, 0x5d, 0x58, 0xe0, 0xfc, 0xc2, 0x02,
+	0xbf, 0x2e, 0x2c, 0xf0, 0xe9, 0xd2, 0x6a, 0x9c, 0x5f, 0x5a, 0x8d, 0xef, 0x97, 0x56, 0xe3, 0xed,
+	0xa3, 0x11, 0x95, 0xa7, 0xd3, 0xbe, 0x1b, 0xf1, 0xd8, 0x4b, 0xc7, 0x34, 0x79, 0x18, 0x93, 0xcc,
+	0x2b, 0xff, 0x9b, 0xcc, 0xf7, 0xde, 0xd7, 0xfe, 0x30, 0xe5, 0x63, 0xdf, 0x50, 0xdf, 0xcd, 0xe3,
+	0x3f, 0x03, 0x00, 0x44, 0x7a, 0xef, 0xbc, 0xe5, 0x04, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -485,6 +574,25 @@ func (m *ProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x7a
 	}
+	if m.Priority != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NormalizeByPairs) > 0 {
+		for iNdEx := len(m.NormalizeByPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NormalizeByPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Invert {
 		i--
 		if m.Invert {
@@ -524,6 +632,49 @@ func (m *ProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NormalizationPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NormalizationPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NormalizationPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Invert {
+		i--
+		if m.Invert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MarketMap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -644,6 +795,15 @@ func (m *ProviderConfig) Size() (n int) {
 	if m.Invert {
 		n += 2
 	}
+	if len(m.NormalizeByPairs) > 0 {
+		for _, e := range m.NormalizeByPairs {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.Priority != 0 {
+		n += 1 + sovMarket(uint64(m.Priority))
+	}
 	l = len(m.Metadata_JSON)
 	if l > 0 {
 		n += 1 + l + sovMarket(uint64(l))
@@ -651,6 +811,20 @@ func (m *ProviderConfig) Size() (n int) {
 	return n
 }
 
+func (m *NormalizationPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Invert {
+		n += 2
+	}
+	return n
+}
+
 func (m *MarketMap) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1114,6 +1288,59 @@ func (m *ProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Invert = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizeByPairs = append(m.NormalizeByPairs, NormalizationPair{})
+			if err := m.NormalizeByPairs[len(m.NormalizeByPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 15:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
@@ -1167,6 +1394,109 @@ func (m *ProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NormalizationPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NormalizationPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NormalizationPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MarketMap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
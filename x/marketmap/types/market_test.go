@@ -268,6 +268,66 @@ var (
 		usdtusd.Ticker.String():         usdtusd,
 		usdcusdDisabled.Ticker.String(): usdcusdDisabled,
 	}
+
+	atomusdt = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("ATOM", "USDT"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "atom-usdt",
+			},
+		},
+	}
+
+	// Normalized by ATOM/USDT and then by USDT/USD.
+	foousdViaATOM = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("FOO", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "osmosis",
+				OffChainTicker: "foo-atom",
+				NormalizeByPairs: []types.NormalizationPair{
+					{CurrencyPair: atomusdt.Ticker.CurrencyPair},
+					{CurrencyPair: usdtusd.Ticker.CurrencyPair},
+				},
+			},
+		},
+	}
+
+	// Normalized by BTC/USD, which is itself normalized by USDT/USD. The direct provider
+	// allows the cycle to be resolved.
+	usdtusdViaBTC = types.Market{
+		Ticker: usdtusd.Ticker,
+		ProviderConfigs: []types.ProviderConfig{
+			usdtusd.ProviderConfigs[0],
+			usdtusdOnlyViaBTC.ProviderConfigs[0],
+		},
+	}
+
+	// Only normalized by BTC/USD, which is itself normalized by USDT/USD.
+	usdtusdOnlyViaBTC = types.Market{
+		Ticker: usdtusd.Ticker,
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "btc-usdt",
+				NormalizeByPairs: []types.NormalizationPair{
+					{CurrencyPair: btcusd.Ticker.CurrencyPair},
+					{CurrencyPair: btcusdtCP, Invert: true},
+				},
+			},
+		},
+	}
 )
 
 func TestMarketMapGetValidSubset(t *testing.T) {
@@ -455,6 +515,42 @@ func TestMarketMapGetValidSubset(t *testing.T) {
 			marketMap:   types.MarketMap{Markets: partiallyValidMarkets2},
 			validSubset: types.MarketMap{Markets: validSubset2},
 		},
+		{
+			name: "invalid disabled pair in normalization path, remove entire market",
+			marketMap: types.MarketMap{Markets: map[string]types.Market{
+				atomusdt.Ticker.String():        atomusdt,
+				usdtusdDisabled.Ticker.String(): usdtusdDisabled,
+				foousdViaATOM.Ticker.String():   foousdViaATOM,
+			}},
+			validSubset: types.MarketMap{Markets: map[string]types.Market{
+				atomusdt.Ticker.String():        atomusdt,
+				usdtusdDisabled.Ticker.String(): usdtusdDisabled,
+			}},
+		},
+		{
+			name: "resolvable normalization cycle",
+			marketMap: types.MarketMap{Markets: map[string]types.Market{
+				btcusdt.Ticker.String():       btcusdt,
+				btcusd.Ticker.String():        btcusd,
+				usdtusdViaBTC.Ticker.String(): usdtusdViaBTC,
+			}},
+			validSubset: types.MarketMap{Markets: map[string]types.Market{
+				btcusdt.Ticker.String():       btcusdt,
+				btcusd.Ticker.String():        btcusd,
+				usdtusdViaBTC.Ticker.String(): usdtusdViaBTC,
+			}},
+		},
+		{
+			name: "unresolvable normalization cycle, remove markets in the cycle",
+			marketMap: types.MarketMap{Markets: map[string]types.Market{
+				btcusdt.Ticker.String():           btcusdt,
+				btcusd.Ticker.String():            btcusd,
+				usdtusdOnlyViaBTC.Ticker.String(): usdtusdOnlyViaBTC,
+			}},
+			validSubset: types.MarketMap{Markets: map[string]types.Market{
+				btcusdt.Ticker.String(): btcusdt,
+			}},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -600,6 +696,194 @@ func TestMarketMapValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid multi-hop normalization",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					atomusdt.Ticker.String():      atomusdt,
+					usdtusd.Ticker.String():       usdtusd,
+					foousdViaATOM.Ticker.String(): foousdViaATOM,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "multi-hop normalization with a missing pair",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusd.Ticker.String():       usdtusd,
+					foousdViaATOM.Ticker.String(): foousdViaATOM,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "multi-hop normalization with a disabled pair",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					atomusdt.Ticker.String():        atomusdt,
+					usdtusdDisabled.Ticker.String(): usdtusdDisabled,
+					foousdViaATOM.Ticker.String():   foousdViaATOM,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "normalization cycle with a direct provider",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusdt.Ticker.String():       btcusdt,
+					btcusd.Ticker.String():        btcusd,
+					usdtusdViaBTC.Ticker.String(): usdtusdViaBTC,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "normalization cycle without a direct provider",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusdt.Ticker.String():           btcusdt,
+					btcusd.Ticker.String():            btcusd,
+					usdtusdOnlyViaBTC.Ticker.String(): usdtusdOnlyViaBTC,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "repeated pair in normalization path",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusd.Ticker.String(): usdtusd,
+					btcusdtCP.String(): {
+						Ticker: btcusdt.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           coinbase.Name,
+								OffChainTicker: "BTC-USDT",
+								NormalizeByPairs: []types.NormalizationPair{
+									{CurrencyPair: usdtusd.Ticker.CurrencyPair},
+									{CurrencyPair: usdtusd.Ticker.CurrencyPair, Invert: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "market normalized by itself",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusdtCP.String(): {
+						Ticker: btcusdt.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:            coinbase.Name,
+								OffChainTicker:  "BTC-USDT",
+								NormalizeByPair: &btcusdtCP,
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid multi-hop normalization",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					atomusdt.Ticker.String():      atomusdt,
+					usdtusd.Ticker.String():       usdtusd,
+					foousdViaATOM.Ticker.String(): foousdViaATOM,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "multi-hop normalization with a missing pair",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusd.Ticker.String():       usdtusd,
+					foousdViaATOM.Ticker.String(): foousdViaATOM,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "multi-hop normalization with a disabled pair",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					atomusdt.Ticker.String():        atomusdt,
+					usdtusdDisabled.Ticker.String(): usdtusdDisabled,
+					foousdViaATOM.Ticker.String():   foousdViaATOM,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "normalization cycle with a direct provider",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusdt.Ticker.String():       btcusdt,
+					btcusd.Ticker.String():        btcusd,
+					usdtusdViaBTC.Ticker.String(): usdtusdViaBTC,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "normalization cycle without a direct provider",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusdt.Ticker.String():           btcusdt,
+					btcusd.Ticker.String():            btcusd,
+					usdtusdOnlyViaBTC.Ticker.String(): usdtusdOnlyViaBTC,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "repeated pair in normalization path",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusd.Ticker.String(): usdtusd,
+					btcusdtCP.String(): {
+						Ticker: btcusdt.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           coinbase.Name,
+								OffChainTicker: "BTC-USDT",
+								NormalizeByPairs: []types.NormalizationPair{
+									{CurrencyPair: usdtusd.Ticker.CurrencyPair},
+									{CurrencyPair: usdtusd.Ticker.CurrencyPair, Invert: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "market normalized by itself",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusdtCP.String(): {
+						Ticker: btcusdt.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:            coinbase.Name,
+								OffChainTicker:  "BTC-USDT",
+								NormalizeByPair: &btcusdtCP,
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "valid single provider",
 			marketMap: types.MarketMap{
@@ -21,6 +21,16 @@ func (pc *ProviderConfig) ValidateBasic() error {
 		if err := pc.NormalizeByPair.ValidateBasic(); err != nil {
 			return err
 		}
+
+		if len(pc.NormalizeByPairs) > 0 {
+			return fmt.Errorf("provider config cannot set both normalize by pair and normalize by pairs")
+		}
+	}
+
+	for _, pair := range pc.NormalizeByPairs {
+		if err := pair.CurrencyPair.ValidateBasic(); err != nil {
+			return err
+		}
 	}
 
 	if len(pc.Metadata_JSON) > MaxMetadataJSONFieldLength {
@@ -48,6 +58,10 @@ func (pc *ProviderConfig) Equal(other ProviderConfig) bool {
 		return false
 	}
 
+	if pc.Priority != other.Priority {
+		return false
+	}
+
 	if pc.NormalizeByPair == nil {
 		if other.NormalizeByPair != nil {
 			return false
@@ -62,5 +76,29 @@ func (pc *ProviderConfig) Equal(other ProviderConfig) bool {
 		}
 	}
 
+	if len(pc.NormalizeByPairs) != len(other.NormalizeByPairs) {
+		return false
+	}
+
+	for i, pair := range pc.NormalizeByPairs {
+		if pair.Invert != other.NormalizeByPairs[i].Invert {
+			return false
+		}
+
+		if !pair.CurrencyPair.Equal(other.NormalizeByPairs[i].CurrencyPair) {
+			return false
+		}
+	}
+
 	return pc.Metadata_JSON == other.Metadata_JSON
 }
+
+// NormalizationPath returns the ordered list of pairs the provider's price is normalized by.
+// A provider config that uses NormalizeByPair returns a single, non-inverted pair.
+func (pc *ProviderConfig) NormalizationPath() []NormalizationPair {
+	if pc.NormalizeByPair != nil {
+		return []NormalizationPair{{CurrencyPair: *pc.NormalizeByPair}}
+	}
+
+	return pc.NormalizeByPairs
+}
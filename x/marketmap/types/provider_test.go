@@ -52,6 +52,42 @@ func TestProviderConfigValidateBasic(t *testing.T) {
 		}
 		require.Error(t, pc.ValidateBasic())
 	})
+	t.Run("valid config with normalize by pairs - pass", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizeByPairs: []types.NormalizationPair{
+				{CurrencyPair: connecttypes.NewCurrencyPair("BASE", "QUOTE")},
+				{CurrencyPair: connecttypes.NewCurrencyPair("USD", "QUOTE"), Invert: true},
+			},
+		}
+		require.NoError(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with normalize by pairs - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizeByPairs: []types.NormalizationPair{
+				{CurrencyPair: connecttypes.NewCurrencyPair("BASE", "QUOTE")},
+				{CurrencyPair: connecttypes.CurrencyPair{Base: "BASE"}},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with normalize by pair and pairs - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizeByPair: &connecttypes.CurrencyPair{
+				Base:  "BASE",
+				Quote: "QUOTE",
+			},
+			NormalizeByPairs: []types.NormalizationPair{
+				{CurrencyPair: connecttypes.NewCurrencyPair("BASE", "QUOTE")},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
 	t.Run("invalid name - fail", func(t *testing.T) {
 		pc := types.ProviderConfig{
 			Name:           "",
@@ -145,6 +181,44 @@ func TestProviderConfigEqual(t *testing.T) {
 			},
 			exp: true,
 		},
+		{
+			name: "different normalize by pairs invert",
+			pc: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				NormalizeByPairs: []types.NormalizationPair{
+					{CurrencyPair: connecttypes.NewCurrencyPair("BASE", "QUOTE")},
+				},
+			},
+			other: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				NormalizeByPairs: []types.NormalizationPair{
+					{CurrencyPair: connecttypes.NewCurrencyPair("BASE", "QUOTE"), Invert: true},
+				},
+			},
+			exp: false,
+		},
+		{
+			name: "different normalize by pairs order",
+			pc: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				NormalizeByPairs: []types.NormalizationPair{
+					{CurrencyPair: connecttypes.NewCurrencyPair("BASE", "QUOTE")},
+					{CurrencyPair: connecttypes.NewCurrencyPair("QUOTE", "USD")},
+				},
+			},
+			other: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				NormalizeByPairs: []types.NormalizationPair{
+					{CurrencyPair: connecttypes.NewCurrencyPair("QUOTE", "USD")},
+					{CurrencyPair: connecttypes.NewCurrencyPair("BASE", "QUOTE")},
+				},
+			},
+			exp: false,
+		},
 		{
 			name: "different name",
 			pc: types.ProviderConfig{
@@ -209,6 +283,19 @@ func TestProviderConfigEqual(t *testing.T) {
 			},
 			exp: false,
 		},
+		{
+			name: "different priority",
+			pc: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				Priority:       1,
+			},
+			other: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+			},
+			exp: false,
+		},
 		{
 			name: "different metadata",
 			pc: types.ProviderConfig{
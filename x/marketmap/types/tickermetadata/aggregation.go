@@ -0,0 +1,110 @@
+package tickermetadata
+
+import "encoding/json"
+
+// AggregationMetadata is the Ticker.Metadata_JSON used to configure how the oracle sidecar
+// aggregates provider prices for a given Ticker. Every field is optional; fields that are
+// not set fall back to the sidecar's configuration.
+type AggregationMetadata struct {
+	// OutlierFilter overrides the outlier filter that is applied to the Ticker's converted
+	// prices before they are aggregated.
+	OutlierFilter *OutlierFilter `json:"outlier_filter,omitempty"`
+	// CircuitBreaker configures the circuit breaker that guards the Ticker's aggregated price
+	// against sudden jumps. The circuit breaker is disabled if this is not set.
+	CircuitBreaker *CircuitBreaker `json:"circuit_breaker,omitempty"`
+	// Strategy selects how the Ticker's converted prices are aggregated into a single price.
+	// The sidecar's default median is used if this is not set.
+	Strategy *Strategy `json:"strategy,omitempty"`
+}
+
+// OutlierFilter configures the outlier filter for a Ticker.
+type OutlierFilter struct {
+	// Method is the outlier filtering method, e.g. `mad`, `zscore` or `percent`. An
+	// empty method disables outlier filtering for the Ticker.
+	Method string `json:"method"`
+	// Threshold is the rejection threshold for the method.
+	Threshold float64 `json:"threshold"`
+}
+
+// CircuitBreaker configures the circuit breaker for a Ticker. Deviations are relative, e.g. 0.2
+// is a 20% deviation. At least one deviation limit must be set.
+type CircuitBreaker struct {
+	// MaxTickDeviation is the maximum deviation of the Ticker's index price from the previous
+	// index price. Zero disables the check.
+	MaxTickDeviation float64 `json:"max_tick_deviation,omitempty"`
+	// MaxReferenceDeviation is the maximum deviation of the Ticker's index price from its
+	// reference price, which is an exponential moving average of the accepted index prices.
+	// Zero disables the check.
+	MaxReferenceDeviation float64 `json:"max_reference_deviation,omitempty"`
+	// ReferenceSmoothing is the smoothing factor of the reference price, in (0, 1]. Smaller
+	// values make the reference price move more slowly. Defaults to 0.1.
+	ReferenceSmoothing float64 `json:"reference_smoothing,omitempty"`
+	// Cooldown is how long the circuit breaker stays tripped before the latest index price is
+	// accepted as the new reference, e.g. "5m".
+	Cooldown string `json:"cooldown"`
+	// Action is what is served while the circuit breaker is tripped. `hold` (the default)
+	// serves the last accepted price and `omit` omits the Ticker.
+	Action string `json:"action,omitempty"`
+}
+
+// Strategy configures the aggregation strategy for a Ticker.
+type Strategy struct {
+	// Name is the name of the strategy, e.g. `median`, `mean`, `trimmed_mean`,
+	// `weighted_median`, `primary`, `min` or `max`.
+	Name string `json:"name"`
+	// TrimFraction is the fraction of prices dropped from each end by `trimmed_mean`, in
+	// [0, 0.5). Defaults to 0.1 if not set; an explicit 0 disables trimming.
+	TrimFraction *float64 `json:"trim_fraction,omitempty"`
+	// Providers are the providers used by `primary`, in order of priority. The price of the
+	// first provider that reports one is used.
+	Providers []string `json:"providers,omitempty"`
+}
+
+// ProviderAggregationMetadata is the ProviderConfig.Metadata_JSON used to configure how the
+// oracle sidecar aggregates a provider's prices. These fields are read alongside any
+// provider specific metadata, such as DeFi pool configurations.
+type ProviderAggregationMetadata struct {
+	// Weight is a static weight for the provider's converted price when the weighted median
+	// is computed. If set, this takes precedence over any weight reported by the provider.
+	Weight float64 `json:"weight,omitempty"`
+}
+
+// NewAggregationMetadata returns a new AggregationMetadata instance.
+func NewAggregationMetadata(
+	outlierFilter *OutlierFilter,
+	circuitBreaker *CircuitBreaker,
+	strategy *Strategy,
+) AggregationMetadata {
+	return AggregationMetadata{
+		OutlierFilter:  outlierFilter,
+		CircuitBreaker: circuitBreaker,
+		Strategy:       strategy,
+	}
+}
+
+// MarshalAggregationMetadata returns the JSON byte encoding of the AggregationMetadata.
+func MarshalAggregationMetadata(m AggregationMetadata) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// AggregationMetadataFromJSONString returns an AggregationMetadata instance from a JSON string.
+func AggregationMetadataFromJSONString(jsonString string) (AggregationMetadata, error) {
+	var elem AggregationMetadata
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
+
+// AggregationMetadataFromJSONBytes returns an AggregationMetadata instance from JSON bytes.
+func AggregationMetadataFromJSONBytes(jsonBytes []byte) (AggregationMetadata, error) {
+	var elem AggregationMetadata
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem, err
+}
+
+// ProviderAggregationMetadataFromJSONString returns a ProviderAggregationMetadata instance from
+// a JSON string.
+func ProviderAggregationMetadataFromJSONString(jsonString string) (ProviderAggregationMetadata, error) {
+	var elem ProviderAggregationMetadata
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
@@ -0,0 +1,104 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func Test_UnmarshalAggregationMetadata(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.NewAggregationMetadata(&tickermetadata.OutlierFilter{
+			Method:    "mad",
+			Threshold: 3.5,
+		}, &tickermetadata.CircuitBreaker{
+			MaxTickDeviation:      0.2,
+			MaxReferenceDeviation: 0.3,
+			ReferenceSmoothing:    0.05,
+			Cooldown:              "5m",
+			Action:                "omit",
+		}, &tickermetadata.Strategy{
+			Name:      "primary",
+			Providers: []string{"coinbase_api", "binance_api"},
+		})
+
+		bz, err := tickermetadata.MarshalAggregationMetadata(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.AggregationMetadataFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+	})
+
+	t.Run("can unmarshal a JSON string into a struct", func(t *testing.T) {
+		elemJSON := `{"outlier_filter":{"method":"percent","threshold":5}}`
+		elem, err := tickermetadata.AggregationMetadataFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.NewAggregationMetadata(&tickermetadata.OutlierFilter{
+			Method:    "percent",
+			Threshold: 5,
+		}, nil, nil), elem)
+	})
+
+	t.Run("can unmarshal a circuit breaker", func(t *testing.T) {
+		elemJSON := `{"circuit_breaker":{"max_tick_deviation":0.2,"cooldown":"1m"}}`
+		elem, err := tickermetadata.AggregationMetadataFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.NewAggregationMetadata(nil, &tickermetadata.CircuitBreaker{
+			MaxTickDeviation: 0.2,
+			Cooldown:         "1m",
+		}, nil), elem)
+	})
+
+	t.Run("can unmarshal a strategy", func(t *testing.T) {
+		elemJSON := `{"strategy":{"name":"trimmed_mean","trim_fraction":0.2}}`
+		elem, err := tickermetadata.AggregationMetadataFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		fraction := 0.2
+		require.Equal(t, tickermetadata.NewAggregationMetadata(nil, nil, &tickermetadata.Strategy{
+			Name:         "trimmed_mean",
+			TrimFraction: &fraction,
+		}), elem)
+	})
+
+	t.Run("distinguishes a zero trim fraction from an unset one", func(t *testing.T) {
+		elem, err := tickermetadata.AggregationMetadataFromJSONString(`{"strategy":{"name":"trimmed_mean","trim_fraction":0}}`)
+		require.NoError(t, err)
+		require.NotNil(t, elem.Strategy.TrimFraction)
+		require.Zero(t, *elem.Strategy.TrimFraction)
+
+		elem, err = tickermetadata.AggregationMetadataFromJSONString(`{"strategy":{"name":"trimmed_mean"}}`)
+		require.NoError(t, err)
+		require.Nil(t, elem.Strategy.TrimFraction)
+	})
+
+	t.Run("ignores unrelated metadata fields", func(t *testing.T) {
+		elemJSON := `{"aggregate_ids":[{"venue":"coingecko","ID":"id"}]}`
+		elem, err := tickermetadata.AggregationMetadataFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.NewAggregationMetadata(nil, nil, nil), elem)
+	})
+}
+
+func Test_UnmarshalProviderAggregationMetadata(t *testing.T) {
+	t.Run("can unmarshal a static weight alongside pool metadata", func(t *testing.T) {
+		elemJSON := `{"address":"0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8","base_decimals":18,"weight":2.5}`
+		elem, err := tickermetadata.ProviderAggregationMetadataFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.ProviderAggregationMetadata{Weight: 2.5}, elem)
+	})
+
+	t.Run("weight is optional", func(t *testing.T) {
+		elem, err := tickermetadata.ProviderAggregationMetadataFromJSONString(`{}`)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.ProviderAggregationMetadata{}, elem)
+	})
+}
@@ -26,6 +26,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetPriceCmd(),
 		GetAllCurrencyPairsCmd(),
+		GetParamsCmd(),
+		GetValidatorPerformanceCmd(),
+		GetAllValidatorPerformanceCmd(),
 	)
 
 	return cmd
@@ -95,3 +98,97 @@ func GetAllCurrencyPairsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetParamsCmd returns the cli-command that queries for the parameters of the module. This is essentially a wrapper around the module's
+// QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded in the clientCtx.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query for the parameters of the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the params
+			res, err := qc.GetParams(cmd.Context(), &types.GetParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorPerformanceCmd returns the cli-command that queries for the oracle performance of a validator, given its consensus
+// address. This is essentially a wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client
+// served over a grpc-conn embedded in the clientCtx.
+func GetValidatorPerformanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performance [consensus-address]",
+		Short: "Query for the oracle performance of a validator over the performance window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the validator's performance
+			res, err := qc.GetValidatorPerformance(cmd.Context(), &types.GetValidatorPerformanceRequest{
+				Validator: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAllValidatorPerformanceCmd returns the cli-command that queries for the oracle performance of all validators. This is essentially
+// a wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn
+// embedded in the clientCtx.
+func GetAllValidatorPerformanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performances",
+		Short: "Query for the oracle performance of all validators over the performance window",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the performance of all validators
+			res, err := qc.GetAllValidatorPerformance(cmd.Context(), &types.GetAllValidatorPerformanceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
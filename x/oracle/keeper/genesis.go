@@ -38,6 +38,10 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	if err := k.numRemoves.Set(ctx, 0); err != nil {
 		panic(fmt.Errorf("error in genesis: %w", err))
 	}
+
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
 }
 
 // ExportGenesis retrieve all CurrencyPairs + QuotePrices set for the module, and return them as a genesis state.
@@ -49,10 +53,16 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("error in genesis: %w", err))
 	}
 
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	// instantiate genesis-state w/ empty array
 	gs := &types.GenesisState{
 		CurrencyPairGenesis: make([]types.CurrencyPairGenesis, 0),
 		NextId:              id,
+		Params:              params,
 	}
 
 	// next, iterate over NonceKey to retrieve any CurrencyPairs that have not yet been traversed (CurrencyPairs w/ no Price info)
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -255,4 +256,13 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 			s.Require().Equal(id, cpg.Id)
 		}
 	})
+
+	s.Run("ExportGenesis with params", func() {
+		gs := types.DefaultGenesisState()
+		gs.Params = types.NewParams(true, time.Minute)
+		s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+		egs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Equal(gs.Params, egs.Params)
+	})
 }
@@ -141,3 +141,51 @@ func (q queryServer) GetCurrencyPairMappingList(ctx context.Context, _ *types.Ge
 
 	return &types.GetCurrencyPairMappingListResponse{Mappings: pairs}, nil
 }
+
+// GetParams returns the parameters of the x/oracle module.
+func (q queryServer) GetParams(ctx context.Context, _ *types.GetParamsRequest) (*types.GetParamsResponse, error) {
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetParamsResponse{Params: params}, nil
+}
+
+// GetValidatorPerformance returns the oracle performance of a validator over its sliding window. If the request
+// is nil, the validator's consensus address is invalid, or no performance is recorded for the validator, this method
+// fails.
+func (q queryServer) GetValidatorPerformance(
+	ctx context.Context,
+	req *types.GetValidatorPerformanceRequest,
+) (*types.GetValidatorPerformanceResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	validator, err := sdk.ConsAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator consensus address: %w", err)
+	}
+
+	performance, err := q.k.GetValidatorPerformance(ctx, validator)
+	if err != nil {
+		return nil, fmt.Errorf("no performance recorded for validator: %s", req.Validator)
+	}
+
+	return &types.GetValidatorPerformanceResponse{Performance: performance}, nil
+}
+
+// GetAllValidatorPerformance returns the oracle performance of all validators over their sliding windows.
+func (q queryServer) GetAllValidatorPerformance(
+	ctx context.Context,
+	_ *types.GetAllValidatorPerformanceRequest,
+) (*types.GetAllValidatorPerformanceResponse, error) {
+	performances, err := q.k.GetAllValidatorPerformance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetAllValidatorPerformanceResponse{Performances: performances}, nil
+}
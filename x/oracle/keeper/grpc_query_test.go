@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/mock"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -273,3 +276,66 @@ func (s *KeeperTestSuite) TestGetCurrencyPairMappingListGRPC() {
 		}, res.Mappings)
 	})
 }
+
+func (s *KeeperTestSuite) TestGetParamsGRPC() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	s.Run("returns the default params", func() {
+		res, err := qs.GetParams(s.ctx, &types.GetParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultParams(), res.Params)
+	})
+
+	s.Run("returns the params set in state", func() {
+		params := types.NewParams(true, time.Minute)
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+		res, err := qs.GetParams(s.ctx, &types.GetParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(params, res.Params)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetValidatorPerformanceGRPC() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+	validator := sdk.ConsAddress("validator")
+
+	params := types.DefaultParams()
+	params.PerformanceWindow = 10
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+	s.Run("fails for a nil request", func() {
+		_, err := qs.GetValidatorPerformance(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("fails for an invalid consensus address", func() {
+		_, err := qs.GetValidatorPerformance(s.ctx, &types.GetValidatorPerformanceRequest{Validator: "invalid"})
+		s.Require().Error(err)
+	})
+
+	s.Run("fails for a validator without a recorded performance", func() {
+		_, err := qs.GetValidatorPerformance(s.ctx, &types.GetValidatorPerformanceRequest{Validator: validator.String()})
+		s.Require().Error(err)
+	})
+
+	s.Run("returns the performance of a validator", func() {
+		report := types.ValidatorReport{ReportedPrices: 1, Deviation: 10}
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, report))
+
+		res, err := qs.GetValidatorPerformance(s.ctx, &types.GetValidatorPerformanceRequest{Validator: validator.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{
+			Validator:      validator.String(),
+			Window:         10,
+			IndexOffset:    1,
+			Blocks:         1,
+			ReportedPrices: 1,
+			Deviation:      10,
+		}, res.Performance)
+
+		all, err := qs.GetAllValidatorPerformance(s.ctx, &types.GetAllValidatorPerformanceRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.ValidatorPerformance{res.Performance}, all.Performances)
+	})
+}
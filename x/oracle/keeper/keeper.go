@@ -68,6 +68,16 @@ type Keeper struct {
 	// numCPs is the number of CPs.
 	numCPs collections.Item[uint64]
 
+	// params are the module's parameters.
+	params collections.Item[types.Params]
+
+	// validatorPerformance is the oracle performance of each validator over its sliding window.
+	validatorPerformance collections.Map[sdk.ConsAddress, types.ValidatorPerformance]
+
+	// validatorReports are the reports in the sliding window of each validator, indexed by their
+	// position in the window.
+	validatorReports collections.Map[collections.Pair[sdk.ConsAddress, uint64], types.ValidatorReport]
+
 	// module authority
 	authority sdk.AccAddress
 }
@@ -96,9 +106,18 @@ func NewKeeper(
 		mmKeeper:           mmKeeper,
 		numRemoves:         collections.NewItem[uint64](sb, types.NumRemovesKeyPrefix, "removed_cps", types.CounterCodec),
 		numCPs:             collections.NewItem[uint64](sb, types.NumCPsKeyPrefix, "num_cps", types.CounterCodec),
+		params:             collections.NewItem[types.Params](sb, types.ParamsKeyPrefix, "params", codec.CollValue[types.Params](cdc)),
 		nextCurrencyPairID: collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
 		currencyPairs:      collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
 		idIndex:            idMulti,
+		validatorPerformance: collections.NewMap(
+			sb, types.ValidatorPerformanceKeyPrefix, "validator_performance",
+			sdk.ConsAddressKey, codec.CollValue[types.ValidatorPerformance](cdc),
+		),
+		validatorReports: collections.NewMap(
+			sb, types.ValidatorReportKeyPrefix, "validator_reports",
+			collections.PairKeyCodec(sdk.ConsAddressKey, collections.Uint64Key), codec.CollValue[types.ValidatorReport](cdc),
+		),
 	}
 
 	// create the schema
@@ -287,6 +306,33 @@ func (k *Keeper) GetAllCurrencyPairs(ctx context.Context) []connecttypes.Currenc
 	return cps
 }
 
+// GetAllEnabledCurrencyPairs returns all CurrencyPairs in state whose markets are enabled in x/marketmap. CurrencyPairs
+// whose markets were removed from x/marketmap are omitted. If the market map is not enabled with the x/oracle module,
+// all CurrencyPairs are returned.
+func (k *Keeper) GetAllEnabledCurrencyPairs(ctx context.Context) ([]connecttypes.CurrencyPair, error) {
+	cps := k.GetAllCurrencyPairs(ctx)
+	if k.mmKeeper == nil {
+		return cps, nil
+	}
+
+	enabled := make([]connecttypes.CurrencyPair, 0, len(cps))
+	for _, cp := range cps {
+		market, err := k.mmKeeper.GetMarket(ctx, cp.String())
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		if market.Ticker.Enabled {
+			enabled = append(enabled, cp)
+		}
+	}
+
+	return enabled, nil
+}
+
 // GetCurrencyPairMapping returns a CurrencyPair mapping by ID that have currently been stored to state.
 // NOTE: this map[] type should not be used by on-chain code.
 func (k *Keeper) GetCurrencyPairMapping(ctx context.Context) (map[uint64]connecttypes.CurrencyPair, error) {
@@ -411,3 +457,19 @@ func (k *Keeper) decrementCPCounter(ctx context.Context) error {
 func (k *Keeper) GetNumCurrencyPairs(ctx context.Context) (uint64, error) {
 	return k.numCPs.Get(ctx)
 }
+
+// SetParams sets the x/oracle module's parameters.
+func (k *Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.params.Set(ctx, params)
+}
+
+// GetParams returns the x/oracle module's parameters. The default parameters are returned if
+// none have been set, e.g. on chains that were running before the parameters were introduced.
+func (k *Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+
+	return params, err
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	"github.com/skip-mev/connect/v2/x/oracle/keeper"
 	"github.com/skip-mev/connect/v2/x/oracle/types"
 	"github.com/skip-mev/connect/v2/x/oracle/types/mocks"
@@ -182,6 +184,28 @@ func (s *KeeperTestSuite) TestGetAllCPs() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGetAllEnabledCPs() {
+	enabled := connecttypes.NewCurrencyPair("AA", "BB")
+	disabled := connecttypes.NewCurrencyPair("CC", "DD")
+	removed := connecttypes.NewCurrencyPair("EE", "FF")
+
+	for _, cp := range []connecttypes.CurrencyPair{enabled, disabled, removed} {
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	}
+
+	s.mockMarketMapKeeper.On("GetMarket", s.ctx, enabled.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{CurrencyPair: enabled, Enabled: true},
+	}, nil)
+	s.mockMarketMapKeeper.On("GetMarket", s.ctx, disabled.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{CurrencyPair: disabled},
+	}, nil)
+	s.mockMarketMapKeeper.On("GetMarket", s.ctx, removed.String()).Return(marketmaptypes.Market{}, collections.ErrNotFound)
+
+	cps, err := s.oracleKeeper.GetAllEnabledCurrencyPairs(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]connecttypes.CurrencyPair{enabled}, cps)
+}
+
 func (s *KeeperTestSuite) TestCreateCurrencyPair() {
 	cp := connecttypes.CurrencyPair{
 		Base:  "NEW",
@@ -451,3 +475,22 @@ func (s *KeeperTestSuite) TestGetCurrencyPairMappingList() {
 		}, mapping)
 	})
 }
+
+func (s *KeeperTestSuite) TestGetParams() {
+	s.Run("returns the default params if none are set", func() {
+		s.SetupWithNoMMKeeper()
+
+		params, err := s.oracleKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultParams(), params)
+	})
+
+	s.Run("returns the params that are set", func() {
+		params := types.NewParams(true, time.Minute)
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+		got, err := s.oracleKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, got)
+	})
+}
@@ -92,3 +92,28 @@ func (m *msgServer) RemoveCurrencyPairs(goCtx context.Context, req *types.MsgRem
 
 	return nil, nil
 }
+
+// UpdateParams updates the x/oracle module's params. This method fails if the message is invalid, or if the signer
+// is not the authority account of the module. Unlike the currency-pair messages, this message is enabled when using
+// x/marketmap.
+func (m *msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	// check validity of message
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	if err := req.Params.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+
+	if err := m.k.SetParams(goCtx, req.Params); err != nil {
+		return nil, fmt.Errorf("error setting params: %w", err)
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
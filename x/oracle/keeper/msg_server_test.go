@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -254,3 +255,61 @@ func (s *KeeperTestSuite) TestMsgRemoveCurrencyPairs() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	tcs := []struct {
+		name       string
+		req        *types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			"if the request is empty - fail",
+			nil,
+			false,
+		},
+		{
+			"if the authority is not the authority of the module - fail",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress("not-authority").String(),
+				Params:    types.NewParams(true, time.Minute),
+			},
+			false,
+		},
+		{
+			"if the params are invalid - fail",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress(moduleAuth).String(),
+				Params:    types.NewParams(true, -time.Minute),
+			},
+			false,
+		},
+		{
+			"if the authority is correct, and the params are valid - pass",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress(moduleAuth).String(),
+				Params:    types.NewParams(true, time.Minute),
+			},
+			true,
+		},
+	}
+
+	// the message is enabled when using x/marketmap
+	ms := keeper.NewMsgServer(s.oracleKeeper)
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			_, err := ms.UpdateParams(s.ctx, tc.req)
+
+			params, getErr := s.oracleKeeper.GetParams(s.ctx)
+			s.Require().NoError(getErr)
+
+			if !tc.expectPass {
+				s.Require().Error(err)
+				s.Require().Equal(types.DefaultParams(), params)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Params, params)
+		})
+	}
+}
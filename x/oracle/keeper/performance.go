@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// RecordValidatorReport records the oracle report of a validator for the current block in the
+// validator's sliding window, and removes the oldest report from the window if it is full. If the
+// performance_window param is zero, no report is recorded. If the param changed since the
+// validator's window was started, or no report was recorded for the validator in the previous
+// block, the window is restarted. The latter ensures that a validator that returns to the active
+// set, e.g. after it was jailed, is not penalised for the reports recorded before it left.
+func (k *Keeper) RecordValidatorReport(ctx context.Context, validator sdk.ConsAddress, report types.ValidatorReport) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.PerformanceWindow == 0 {
+		return nil
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+
+	performance, err := k.validatorPerformance.Get(ctx, validator)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		performance = types.NewValidatorPerformance(validator, params.PerformanceWindow)
+	case err != nil:
+		return err
+	case performance.Window != params.PerformanceWindow, height > performance.LastHeight+1:
+		// reports are indexed by their position in the window, so the window cannot be resized,
+		// and reports from before a validator left the active set must not count against it
+		if err := k.resetValidatorPerformance(ctx, validator); err != nil {
+			return err
+		}
+
+		performance = types.NewValidatorPerformance(validator, params.PerformanceWindow)
+	}
+
+	key := collections.Join(validator, performance.IndexOffset%performance.Window)
+	if performance.IndexOffset >= performance.Window {
+		oldest, err := k.validatorReports.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		performance.RemoveReport(oldest)
+	}
+
+	if err := k.validatorReports.Set(ctx, key, report); err != nil {
+		return err
+	}
+
+	performance.AddReport(report)
+	performance.IndexOffset++
+	performance.LastHeight = height
+
+	return k.validatorPerformance.Set(ctx, validator, performance)
+}
+
+// GetValidatorPerformance returns the oracle performance of a validator over its sliding window.
+// If no report has been recorded for the validator, this function errors.
+func (k *Keeper) GetValidatorPerformance(ctx context.Context, validator sdk.ConsAddress) (types.ValidatorPerformance, error) {
+	return k.validatorPerformance.Get(ctx, validator)
+}
+
+// GetAllValidatorPerformance returns the oracle performance of every validator that a report has
+// been recorded for.
+func (k *Keeper) GetAllValidatorPerformance(ctx context.Context) ([]types.ValidatorPerformance, error) {
+	it, err := k.validatorPerformance.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	return it.Values()
+}
+
+// resetValidatorPerformance removes the performance and all reports of a validator, so that its
+// window restarts with its next report.
+func (k *Keeper) resetValidatorPerformance(ctx context.Context, validator sdk.ConsAddress) error {
+	if err := k.validatorReports.Clear(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](validator)); err != nil {
+		return err
+	}
+
+	return k.validatorPerformance.Remove(ctx, validator)
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestRecordValidatorReport() {
+	validator := sdk.ConsAddress("validator")
+	missed := types.ValidatorReport{MissedVoteExtension: true}
+	reported := types.ValidatorReport{MissingPrices: 1, ReportedPrices: 2, Deviation: 50}
+
+	setWindow := func(window uint64) {
+		params := types.DefaultParams()
+		params.PerformanceWindow = window
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+	}
+
+	s.Run("reports are not recorded if the performance window is zero", func() {
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, missed))
+
+		_, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, validator)
+		s.Require().Error(err)
+	})
+
+	s.Run("reports are added to the window until it is full", func() {
+		setWindow(3)
+
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, missed))
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, reported))
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, validator)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{
+			Validator:            validator.String(),
+			Window:               3,
+			IndexOffset:          2,
+			Blocks:               2,
+			MissedVoteExtensions: 1,
+			MissingPrices:        1,
+			ReportedPrices:       2,
+			Deviation:            50,
+		}, performance)
+	})
+
+	s.Run("the oldest report is removed once the window is full", func() {
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, reported))
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, reported))
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, validator)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{
+			Validator:            validator.String(),
+			Window:               3,
+			IndexOffset:          4,
+			Blocks:               3,
+			MissedVoteExtensions: 0,
+			MissingPrices:        3,
+			ReportedPrices:       6,
+			Deviation:            150,
+		}, performance)
+	})
+
+	s.Run("the window is restarted if the performance window changes", func() {
+		setWindow(2)
+
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, missed))
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, validator)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{
+			Validator:            validator.String(),
+			Window:               2,
+			IndexOffset:          1,
+			Blocks:               1,
+			MissedVoteExtensions: 1,
+		}, performance)
+	})
+
+	s.Run("the window is restarted if the validator was absent from the previous block", func() {
+		s.ctx = s.ctx.WithBlockHeight(10)
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, missed))
+
+		s.ctx = s.ctx.WithBlockHeight(11)
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, missed))
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, validator)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), performance.MissedVoteExtensions)
+
+		// the validator left the active set, e.g. because it was jailed, and returned
+		s.ctx = s.ctx.WithBlockHeight(20)
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, reported))
+
+		performance, err = s.oracleKeeper.GetValidatorPerformance(s.ctx, validator)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{
+			Validator:      validator.String(),
+			Window:         2,
+			IndexOffset:    1,
+			Blocks:         1,
+			MissingPrices:  1,
+			ReportedPrices: 2,
+			Deviation:      50,
+			LastHeight:     20,
+		}, performance)
+	})
+
+	s.Run("the performance of every validator is returned", func() {
+		other := sdk.ConsAddress("other")
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, other, reported))
+
+		performances, err := s.oracleKeeper.GetAllValidatorPerformance(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(performances, 2)
+	})
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// SlashingHandler jails, and optionally slashes, validators that persistently miss their vote
+// extensions, in the manner of the x/slashing module's downtime penalties. A validator is penalised
+// once its performance window is full and it missed its vote extension in more blocks of the window
+// than the slashing params allow. Its window is then restarted, so that it is not penalised again
+// until a full window has passed.
+//
+// The handler is opt-in: it is only run if the application wires it into the oracle PreBlocker,
+// and validators are only penalised if both the performance_window param and the
+// min_valid_per_window slashing param are positive.
+type SlashingHandler struct {
+	k              *Keeper
+	slashingKeeper types.SlashingKeeper
+	stakingKeeper  types.StakingKeeper
+}
+
+// NewSlashingHandler returns a new SlashingHandler that reads the performance of validators from
+// the given oracle keeper, and penalises them with the given slashing and staking keepers.
+func NewSlashingHandler(k *Keeper, slashingKeeper types.SlashingKeeper, stakingKeeper types.StakingKeeper) *SlashingHandler {
+	return &SlashingHandler{
+		k:              k,
+		slashingKeeper: slashingKeeper,
+		stakingKeeper:  stakingKeeper,
+	}
+}
+
+// HandleValidatorPerformance penalises the given validator if it missed its vote extension in too
+// many blocks of its performance window. The power is the validator's voting power in the block
+// the window was last updated for, and is used to determine the amount slashed.
+func (h *SlashingHandler) HandleValidatorPerformance(ctx context.Context, validator sdk.ConsAddress, power int64) error {
+	params, err := h.k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.PerformanceWindow == 0 || !params.SlashingParams.Enabled() {
+		return nil
+	}
+
+	performance, err := h.k.GetValidatorPerformance(ctx, validator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// only penalise validators with a full window, so that validators that recently joined the
+	// validator set, or whose window was restarted, are not penalised prematurely
+	if performance.Blocks < performance.Window {
+		return nil
+	}
+
+	window := int64(performance.Window) //nolint:gosec
+	minValid := params.SlashingParams.MinValidPerWindow.MulInt64(window).RoundInt64()
+	maxMissed := window - minValid
+	if int64(performance.MissedVoteExtensions) <= maxMissed { //nolint:gosec
+		return nil
+	}
+
+	// validators that no longer exist, or are already jailed, cannot be penalised. Their window
+	// is restarted, so that they are not penalised for the same missed vote extensions once they
+	// return to the active set.
+	val, err := h.stakingKeeper.ValidatorByConsAddr(ctx, validator)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return h.k.resetValidatorPerformance(ctx, validator)
+	}
+	if err != nil {
+		return err
+	}
+	if val == nil || val.IsJailed() {
+		return h.k.resetValidatorPerformance(ctx, validator)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// the infraction happened over the course of the window, so slash the stake that was bonded at
+	// the current height, in the same manner as the x/slashing module does for downtime
+	slashFraction := params.SlashingParams.SlashFraction
+	if slashFraction.IsNil() {
+		slashFraction = math.LegacyZeroDec()
+	}
+	if slashFraction.IsPositive() {
+		distributionHeight := sdkCtx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+		if err := h.slashingKeeper.Slash(ctx, validator, slashFraction, power, distributionHeight); err != nil {
+			return err
+		}
+	}
+
+	if err := h.slashingKeeper.Jail(ctx, validator); err != nil {
+		return err
+	}
+
+	jailedUntil := sdkCtx.BlockHeader().Time.Add(params.SlashingParams.JailDuration)
+	if err := h.slashingKeeper.JailUntil(ctx, validator, jailedUntil); err != nil {
+		return err
+	}
+
+	if err := h.k.resetValidatorPerformance(ctx, validator); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(power, 10)),
+			sdk.NewAttribute(types.AttributeKeyMissedVoteExtensions, strconv.FormatUint(performance.MissedVoteExtensions, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, slashFraction.String()),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, jailedUntil.String()),
+		),
+	)
+
+	sdkCtx.Logger().Info(
+		"jailed validator for missing vote extensions",
+		"validator", validator.String(),
+		"missed_vote_extensions", performance.MissedVoteExtensions,
+		"max_missed_vote_extensions", maxMissed,
+		"jailed_until", jailedUntil,
+	)
+
+	return nil
+}
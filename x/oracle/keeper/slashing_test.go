@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestSlashingHandler() {
+	validator := sdk.ConsAddress("validator")
+	blockTime := time.Unix(1700000000, 0).UTC()
+	missed := types.ValidatorReport{MissedVoteExtension: true}
+	reported := types.ValidatorReport{ReportedPrices: 1}
+
+	// setup sets a performance window of 4 blocks, in which a validator must not miss its vote
+	// extension in at least 2 blocks, and records the given reports for the validator.
+	setup := func(slashingParams types.SlashingParams, reports ...types.ValidatorReport) (*keeper.SlashingHandler, *mocks.SlashingKeeper, *mocks.StakingKeeper) {
+		s.SetupTest()
+		s.ctx = s.ctx.WithBlockHeight(100).WithBlockTime(blockTime)
+
+		params := types.DefaultParams()
+		params.PerformanceWindow = 4
+		params.SlashingParams = slashingParams
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+		for _, report := range reports {
+			s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, report))
+		}
+
+		slashingKeeper := mocks.NewSlashingKeeper(s.T())
+		stakingKeeper := mocks.NewStakingKeeper(s.T())
+
+		return keeper.NewSlashingHandler(&s.oracleKeeper, slashingKeeper, stakingKeeper), slashingKeeper, stakingKeeper
+	}
+
+	slashingParams := types.NewSlashingParams(math.LegacyNewDecWithPrec(5, 1), time.Hour, math.LegacyNewDecWithPrec(1, 2))
+
+	s.Run("validators are not penalised if slashing is disabled", func() {
+		h, _, _ := setup(types.DefaultSlashingParams(), missed, missed, missed, missed)
+		s.Require().NoError(h.HandleValidatorPerformance(s.ctx, validator, 10))
+	})
+
+	s.Run("validators are not penalised before their window is full", func() {
+		h, _, _ := setup(slashingParams, missed, missed, missed)
+		s.Require().NoError(h.HandleValidatorPerformance(s.ctx, validator, 10))
+	})
+
+	s.Run("validators are not penalised within the maximum number of missed vote extensions", func() {
+		h, _, _ := setup(slashingParams, missed, missed, reported, reported)
+		s.Require().NoError(h.HandleValidatorPerformance(s.ctx, validator, 10))
+	})
+
+	s.Run("validators without a recorded performance are not penalised", func() {
+		h, _, _ := setup(slashingParams)
+		s.Require().NoError(h.HandleValidatorPerformance(s.ctx, validator, 10))
+	})
+
+	s.Run("jailed validators are not penalised again", func() {
+		h, _, stakingKeeper := setup(slashingParams, missed, missed, missed, reported)
+		stakingKeeper.On("ValidatorByConsAddr", mock.Anything, validator).Return(stakingtypes.Validator{Jailed: true}, nil)
+
+		s.Require().NoError(h.HandleValidatorPerformance(s.ctx, validator, 10))
+
+		// the validator's window is restarted
+		_, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, validator)
+		s.Require().Error(err)
+	})
+
+	s.Run("unjailed validators are not penalised for vote extensions missed before they were jailed", func() {
+		h, _, stakingKeeper := setup(slashingParams, missed, missed, missed, missed)
+
+		// the validator was jailed by another module, e.g. x/slashing for downtime
+		stakingKeeper.On("ValidatorByConsAddr", mock.Anything, validator).Return(stakingtypes.Validator{Jailed: true}, nil).Once()
+		s.Require().NoError(h.HandleValidatorPerformance(s.ctx, validator, 10))
+
+		// the validator is unjailed and reports prices in the next block it is in the commit for
+		s.ctx = s.ctx.WithBlockHeight(110)
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReport(s.ctx, validator, reported))
+		s.Require().NoError(h.HandleValidatorPerformance(s.ctx, validator, 10))
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, validator)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), performance.Blocks)
+		s.Require().Equal(uint64(0), performance.MissedVoteExtensions)
+	})
+
+	s.Run("validators that miss too many vote extensions are slashed and jailed", func() {
+		h, slashingKeeper, stakingKeeper := setup(slashingParams, missed, missed, missed, reported)
+		stakingKeeper.On("ValidatorByConsAddr", mock.Anything, validator).Return(stakingtypes.Validator{}, nil)
+		slashingKeeper.On("Slash", mock.Anything, validator, slashingParams.SlashFraction, int64(10), int64(98)).Return(nil).Once()
+		slashingKeeper.On("Jail", mock.Anything, validator).Return(nil).Once()
+		slashingKeeper.On("JailUntil", mock.Anything, validator, blockTime.Add(time.Hour)).Return(nil).Once()
+
+		s.Require().NoError(h.HandleValidatorPerformance(s.ctx, validator, 10))
+
+		// the validator's window is restarted
+		_, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, validator)
+		s.Require().Error(err)
+
+		events := s.ctx.EventManager().Events()
+		s.Require().NotEmpty(events)
+		event := events[len(events)-1]
+		s.Require().Equal(types.EventTypeOracleSlash, event.Type)
+
+		attribute, ok := event.GetAttribute(types.AttributeKeyMissedVoteExtensions)
+		s.Require().True(ok)
+		s.Require().Equal("3", attribute.Value)
+	})
+
+	s.Run("validators are only jailed if the slash fraction is zero", func() {
+		h, slashingKeeper, stakingKeeper := setup(
+			types.NewSlashingParams(math.LegacyNewDecWithPrec(5, 1), time.Hour, math.LegacyZeroDec()),
+			missed, missed, missed, missed,
+		)
+		stakingKeeper.On("ValidatorByConsAddr", mock.Anything, validator).Return(stakingtypes.Validator{}, nil)
+		slashingKeeper.On("Jail", mock.Anything, validator).Return(nil).Once()
+		slashingKeeper.On("JailUntil", mock.Anything, validator, blockTime.Add(time.Hour)).Return(nil).Once()
+
+		s.Require().NoError(h.HandleValidatorPerformance(s.ctx, validator, 10))
+	})
+}
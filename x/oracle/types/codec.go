@@ -16,6 +16,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	// register the MsgRemoveCurrencyPairs for amino serialization
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveCurrencyPairs{}, "connect/x/oracle/MsgRemoveCurrencyPairs")
+
+	// register the MsgUpdateParams for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "connect/x/oracle/MsgUpdateParams")
 }
 
 // RegisterInterfaces registers the x/oracle messages + message service w/ the InterfaceRegistry (registry).
@@ -24,6 +27,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddCurrencyPairs{},
 		&MsgRemoveCurrencyPairs{},
+		&MsgUpdateParams{},
 	)
 
 	// register the x/oracle message-service
@@ -0,0 +1,13 @@
+package types
+
+// oracle module event types
+
+const (
+	EventTypeOracleSlash = "oracle_slash"
+
+	AttributeKeyValidator            = "validator"
+	AttributeKeyPower                = "power"
+	AttributeKeyMissedVoteExtensions = "missed_vote_extensions"
+	AttributeKeySlashFraction        = "slash_fraction"
+	AttributeKeyJailedUntil          = "jailed_until"
+)
@@ -2,6 +2,11 @@ package types
 
 import (
 	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
 )
@@ -12,3 +17,21 @@ import (
 type MarketMapKeeper interface {
 	GetMarket(ctx context.Context, tickerStr string) (types.Market, error)
 }
+
+// SlashingKeeper is the expected keeper interface for the slashing keeper, which is used to jail and
+// slash validators that persistently miss their vote extensions.
+//
+//go:generate mockery --name SlashingKeeper --output ./mocks/ --case underscore
+type SlashingKeeper interface {
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+	Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64) error
+}
+
+// StakingKeeper is the expected keeper interface for the staking keeper, which is used to look up
+// validators before they are penalised.
+//
+//go:generate mockery --name StakingKeeper --output ./mocks/ --case underscore
+type StakingKeeper interface {
+	ValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error)
+}
@@ -24,11 +24,13 @@ func (cpg *CurrencyPairGenesis) ValidateBasic() error {
 	return nil
 }
 
-// NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses.
+// NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses. The module's
+// params are set to their defaults.
 func NewGenesisState(cpgs []CurrencyPairGenesis, nextID uint64) *GenesisState {
 	return &GenesisState{
 		CurrencyPairGenesis: cpgs,
 		NextId:              nextID,
+		Params:              DefaultParams(),
 	}
 }
 
@@ -38,8 +40,13 @@ func DefaultGenesisState() *GenesisState {
 }
 
 // Validate validates the currency-pair geneses that the Genesis-State is composed of
-// valid CurrencyPairGenesis, and that no ID for a currency-pair is repeated.
+// valid CurrencyPairGenesis, that no ID for a currency-pair is repeated, and that the
+// module's params are valid.
 func (gs *GenesisState) Validate() error {
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
 	ids := make(map[uint64]struct{})
 	cps := make(map[string]struct{})
 	for _, cpg := range gs.CurrencyPairGenesis {
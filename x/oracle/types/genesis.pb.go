@@ -240,6 +240,8 @@ type GenesisState struct {
 	CurrencyPairGenesis []CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// Params are the parameters of the module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -289,6 +291,13 @@ func (m *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QuotePrice)(nil), "connect.oracle.v2.QuotePrice")
 	proto.RegisterType((*CurrencyPairState)(nil), "connect.oracle.v2.CurrencyPairState")
@@ -299,39 +308,41 @@ func init() {
 func init() { proto.RegisterFile("connect/oracle/v2/genesis.proto", fileDescriptor_a688f927817fa7da) }
 
 var fileDescriptor_a688f927817fa7da = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x3b, 0xc0, 0xae, 0x3a, 0xe0, 0x1a, 0xca, 0x6e, 0x44, 0x12, 0x5b, 0x24, 0xc6, 0x90,
-	0x18, 0x66, 0x92, 0x7a, 0xf2, 0x28, 0x1e, 0x56, 0x0e, 0x26, 0xd8, 0xf5, 0xe4, 0xa5, 0x96, 0xe9,
-	0x58, 0x26, 0xd0, 0x4e, 0xd3, 0x0e, 0x64, 0xf7, 0x23, 0x78, 0xdb, 0x0f, 0xe3, 0x17, 0xf0, 0xc6,
-	0x71, 0xe3, 0xc9, 0x68, 0x82, 0x06, 0xbe, 0x88, 0xe9, 0xcc, 0x14, 0x4b, 0x96, 0xc3, 0xde, 0xfa,
-	0xce, 0xbc, 0x7f, 0x9e, 0xdf, 0x33, 0x6f, 0xa1, 0x4d, 0x78, 0x1c, 0x53, 0x22, 0x30, 0x4f, 0x7d,
-	0x32, 0xa7, 0x78, 0xe9, 0xe0, 0x90, 0xc6, 0x34, 0x63, 0x19, 0x4a, 0x52, 0x2e, 0xb8, 0xd9, 0xd4,
-	0x09, 0x48, 0x25, 0xa0, 0xa5, 0xd3, 0x39, 0x0d, 0x79, 0xc8, 0xe5, 0x2d, 0xce, 0xbf, 0x54, 0x62,
-	0xc7, 0x0e, 0x39, 0x0f, 0xe7, 0x14, 0xcb, 0x68, 0xb2, 0xf8, 0x82, 0x05, 0x8b, 0x68, 0x26, 0xfc,
-	0x28, 0xd1, 0x09, 0x4f, 0x08, 0xcf, 0x22, 0x9e, 0x79, 0xaa, 0x52, 0x05, 0xfa, 0xea, 0x79, 0xa1,
-	0x42, 0x5c, 0x25, 0x34, 0xcb, 0x45, 0x90, 0x45, 0x9a, 0xd2, 0x98, 0x5c, 0x79, 0x89, 0xcf, 0x52,
-	0x95, 0xd5, 0xfb, 0x0e, 0x20, 0xfc, 0xb0, 0xe0, 0x82, 0x8e, 0x53, 0x46, 0xa8, 0xf9, 0x06, 0x1e,
-	0x25, 0xf9, 0x47, 0x1b, 0x74, 0x41, 0xff, 0xc1, 0xf0, 0xe5, 0x6a, 0x6d, 0x1b, 0xbf, 0xd6, 0xf6,
-	0x99, 0xea, 0x9c, 0x05, 0x33, 0xc4, 0x38, 0x8e, 0x7c, 0x31, 0x45, 0xa3, 0x58, 0xfc, 0xf8, 0x36,
-	0x80, 0x7a, 0xe4, 0x28, 0x16, 0xae, 0xaa, 0x34, 0xdf, 0xc3, 0x47, 0x93, 0x39, 0x27, 0x33, 0x6f,
-	0xa7, 0xb5, 0x5d, 0xe9, 0x82, 0x7e, 0xdd, 0xe9, 0x20, 0x45, 0x83, 0x0a, 0x1a, 0xf4, 0xb1, 0xc8,
-	0x18, 0xde, 0xcf, 0x07, 0x5d, 0xff, 0xb1, 0x81, 0x7b, 0x22, 0x8b, 0x77, 0x37, 0xe6, 0x33, 0xd8,
-	0x50, 0xed, 0xa6, 0x94, 0x85, 0x53, 0xd1, 0xae, 0x76, 0x41, 0xbf, 0xe6, 0xd6, 0xe5, 0xd9, 0x3b,
-	0x79, 0xd4, 0x13, 0xb0, 0xf9, 0x56, 0xa3, 0x8d, 0x7d, 0x96, 0x5e, 0x08, 0x5f, 0x50, 0xf3, 0x75,
-	0x99, 0xa4, 0xee, 0x3c, 0x45, 0xb7, 0x3c, 0x47, 0xff, 0xb9, 0x87, 0xb5, 0xd5, 0xda, 0x06, 0x05,
-	0xc1, 0x29, 0x3c, 0x8a, 0x79, 0x4c, 0xa8, 0xd4, 0x5d, 0x73, 0x55, 0x60, 0x9e, 0xc0, 0x0a, 0x0b,
-	0xf4, 0xf8, 0x0a, 0x0b, 0x7a, 0xbf, 0x01, 0x6c, 0x95, 0xc7, 0x9e, 0xab, 0x27, 0x36, 0x47, 0xf0,
-	0xe1, 0x9e, 0xd1, 0x5a, 0x80, 0xb5, 0x13, 0x20, 0xdf, 0x23, 0x9f, 0x5f, 0xae, 0x96, 0x0a, 0x0c,
-	0xb7, 0x41, 0x4a, 0x67, 0xe6, 0x05, 0x6c, 0xed, 0xb5, 0xf2, 0x14, 0x51, 0xe5, 0xee, 0x44, 0xcd,
-	0x72, 0xbf, 0xf1, 0x3e, 0x5d, 0xf5, 0x36, 0x5d, 0x6d, 0x47, 0xf7, 0x15, 0xc0, 0x86, 0x26, 0x52,
-	0x7e, 0x7e, 0x86, 0x67, 0xfb, 0x5a, 0xf4, 0x4a, 0xb7, 0x41, 0xb7, 0xda, 0xaf, 0x3b, 0x2f, 0x0e,
-	0xa8, 0x39, 0xe0, 0x8e, 0xc6, 0x6c, 0x91, 0x03, 0xc6, 0x3d, 0x86, 0xf7, 0x62, 0x7a, 0x29, 0x3c,
-	0x16, 0x68, 0xe3, 0x8f, 0xf3, 0x70, 0x14, 0x0c, 0xcf, 0x57, 0x1b, 0x0b, 0xdc, 0x6c, 0x2c, 0xf0,
-	0x77, 0x63, 0x81, 0xeb, 0xad, 0x65, 0xdc, 0x6c, 0x2d, 0xe3, 0xe7, 0xd6, 0x32, 0x3e, 0x0d, 0x42,
-	0x26, 0xa6, 0x8b, 0x09, 0x22, 0x3c, 0xc2, 0xd9, 0x8c, 0x25, 0x83, 0x88, 0x2e, 0x71, 0xb1, 0xf7,
-	0x4b, 0x07, 0x5f, 0x16, 0xbf, 0xa0, 0xf4, 0x7c, 0x72, 0x2c, 0x37, 0xef, 0xd5, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa6, 0xbb, 0xf6, 0x48, 0xa1, 0x03, 0x00, 0x00,
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x8f, 0xd2, 0x4e,
+	0x14, 0xc7, 0x19, 0x60, 0xf9, 0xfd, 0x1c, 0x70, 0x0d, 0x65, 0x37, 0xb2, 0x24, 0xb6, 0x48, 0x8c,
+	0x21, 0x31, 0xcc, 0x24, 0xf5, 0x60, 0x3c, 0x8a, 0x87, 0x95, 0x83, 0x09, 0x76, 0x3d, 0x79, 0xa9,
+	0x65, 0x3a, 0x96, 0x09, 0xb4, 0xd3, 0xb4, 0x03, 0xd9, 0xfd, 0x2f, 0xf6, 0x8f, 0xf1, 0x1f, 0xd0,
+	0x13, 0xc7, 0x8d, 0x27, 0xa3, 0x09, 0x1a, 0xf8, 0x47, 0x4c, 0x67, 0xa6, 0x58, 0x02, 0x07, 0x6f,
+	0x9d, 0x99, 0xef, 0x7b, 0xef, 0xfb, 0x79, 0xef, 0x15, 0x5a, 0x84, 0x47, 0x11, 0x25, 0x02, 0xf3,
+	0xc4, 0x23, 0x73, 0x8a, 0x97, 0x36, 0x0e, 0x68, 0x44, 0x53, 0x96, 0xa2, 0x38, 0xe1, 0x82, 0x1b,
+	0x4d, 0x2d, 0x40, 0x4a, 0x80, 0x96, 0x76, 0xe7, 0x2c, 0xe0, 0x01, 0x97, 0xaf, 0x38, 0xfb, 0x52,
+	0xc2, 0x8e, 0x15, 0x70, 0x1e, 0xcc, 0x29, 0x96, 0xa7, 0xc9, 0xe2, 0x13, 0x16, 0x2c, 0xa4, 0xa9,
+	0xf0, 0xc2, 0x58, 0x0b, 0x2e, 0x08, 0x4f, 0x43, 0x9e, 0xba, 0x2a, 0x52, 0x1d, 0xf4, 0xd3, 0x93,
+	0xdc, 0x85, 0xb8, 0x89, 0x69, 0x9a, 0x99, 0x20, 0x8b, 0x24, 0xa1, 0x11, 0xb9, 0x71, 0x63, 0x8f,
+	0x25, 0x5a, 0x65, 0x1e, 0x7a, 0x8d, 0xbd, 0xc4, 0x0b, 0x75, 0x96, 0xde, 0x17, 0x00, 0xe1, 0xbb,
+	0x05, 0x17, 0x74, 0x9c, 0x30, 0x42, 0x8d, 0x57, 0xf0, 0x24, 0xce, 0x3e, 0xda, 0xa0, 0x0b, 0xfa,
+	0xf7, 0x86, 0xcf, 0x56, 0x6b, 0xab, 0xf4, 0x63, 0x6d, 0x9d, 0xab, 0xca, 0xa9, 0x3f, 0x43, 0x8c,
+	0xe3, 0xd0, 0x13, 0x53, 0x34, 0x8a, 0xc4, 0xb7, 0xcf, 0x03, 0xa8, 0x2d, 0x8d, 0x22, 0xe1, 0xa8,
+	0x48, 0xe3, 0x2d, 0x7c, 0x30, 0x99, 0x73, 0x32, 0x73, 0x77, 0x2c, 0xed, 0x72, 0x17, 0xf4, 0xeb,
+	0x76, 0x07, 0x29, 0x5a, 0x94, 0xd3, 0xa2, 0xf7, 0xb9, 0x62, 0xf8, 0x7f, 0x56, 0xe8, 0xf6, 0x97,
+	0x05, 0x9c, 0x53, 0x19, 0xbc, 0x7b, 0x31, 0x1e, 0xc3, 0x86, 0x4a, 0x37, 0xa5, 0x2c, 0x98, 0x8a,
+	0x76, 0xa5, 0x0b, 0xfa, 0x55, 0xa7, 0x2e, 0xef, 0xde, 0xc8, 0xab, 0x9e, 0x80, 0xcd, 0xd7, 0x1a,
+	0x7d, 0xec, 0xb1, 0xe4, 0x4a, 0x78, 0x82, 0x1a, 0x2f, 0x8b, 0x24, 0x75, 0xfb, 0x11, 0x3a, 0x98,
+	0x09, 0xfa, 0xcb, 0x3d, 0xac, 0xae, 0xd6, 0x16, 0xc8, 0x09, 0xce, 0xe0, 0x49, 0xc4, 0x23, 0x42,
+	0xa5, 0xef, 0xaa, 0xa3, 0x0e, 0xc6, 0x29, 0x2c, 0x33, 0x5f, 0x97, 0x2f, 0x33, 0xbf, 0xf7, 0x13,
+	0xc0, 0x56, 0xb1, 0xec, 0xa5, 0x5a, 0x01, 0x63, 0x04, 0xef, 0xef, 0x0d, 0x42, 0x1b, 0x30, 0x77,
+	0x06, 0xe4, 0xbc, 0xb2, 0xfa, 0xc5, 0x68, 0xe9, 0xa0, 0xe4, 0x34, 0x48, 0xe1, 0xce, 0xb8, 0x82,
+	0xad, 0xbd, 0x54, 0xae, 0x22, 0x2a, 0xff, 0x3b, 0x51, 0xb3, 0x98, 0x6f, 0xbc, 0x4f, 0x57, 0x39,
+	0xa4, 0xab, 0xee, 0xe8, 0xbe, 0x02, 0xd8, 0xd0, 0x44, 0xaa, 0x9f, 0x1f, 0xe1, 0xf9, 0xbe, 0x17,
+	0xbd, 0xf2, 0x6d, 0xd0, 0xad, 0xf4, 0xeb, 0xf6, 0xd3, 0x23, 0x6e, 0x8e, 0x74, 0x47, 0x63, 0xb6,
+	0xc8, 0x91, 0xc6, 0x3d, 0x84, 0xff, 0x45, 0xf4, 0x5a, 0xb8, 0xcc, 0xd7, 0x8d, 0xaf, 0x65, 0xc7,
+	0x91, 0x6f, 0xbc, 0x80, 0x35, 0xb5, 0xb3, 0xd2, 0x72, 0xdd, 0xbe, 0x38, 0x52, 0x6b, 0x2c, 0x05,
+	0x3a, 0xbd, 0x96, 0x0f, 0x2f, 0x57, 0x1b, 0x13, 0xdc, 0x6d, 0x4c, 0xf0, 0x7b, 0x63, 0x82, 0xdb,
+	0xad, 0x59, 0xba, 0xdb, 0x9a, 0xa5, 0xef, 0x5b, 0xb3, 0xf4, 0x61, 0x10, 0x30, 0x31, 0x5d, 0x4c,
+	0x10, 0xe1, 0x21, 0x4e, 0x67, 0x2c, 0x1e, 0x84, 0x74, 0x89, 0xf3, 0x5f, 0x65, 0x69, 0xe3, 0xeb,
+	0xfc, 0x7f, 0x91, 0xc3, 0x9a, 0xd4, 0xe4, 0xca, 0x3e, 0xff, 0x33, 0x00, 0x04, 0x41, 0x77, 0x3b,
+	0xfa, 0x03, 0x00, 0x00,
 }
 
 func (m *QuotePrice) Marshal() (dAtA []byte, err error) {
@@ -500,6 +511,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.NextId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
 		i--
@@ -604,6 +625,8 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextId))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1112,6 +1135,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -33,6 +33,17 @@ var (
 	// NumCPsKeyPrefix is the key-prefix under which the number CPs is stored.
 	NumCPsKeyPrefix = collections.NewPrefix(5)
 
+	// ParamsKeyPrefix is the key-prefix under which the module Params are stored.
+	ParamsKeyPrefix = collections.NewPrefix(6)
+
+	// ValidatorPerformanceKeyPrefix is the key-prefix under which the oracle performance of
+	// each validator is stored.
+	ValidatorPerformanceKeyPrefix = collections.NewPrefix(7)
+
+	// ValidatorReportKeyPrefix is the key-prefix under which the reports in the sliding window
+	// of each validator are stored.
+	ValidatorReportKeyPrefix = collections.NewPrefix(8)
+
 	// CounterCodec is the collections.KeyCodec value used for the counter values.
 	CounterCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
 )
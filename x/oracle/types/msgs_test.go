@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -105,3 +106,38 @@ func TestValidateBasicMsgRemoveCurrencyPairs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgUpdateParams(t *testing.T) {
+	tcs := []struct {
+		name       string
+		msg        types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.NewMsgUpdateParams("abc", types.DefaultParams()),
+			false,
+		},
+		{
+			"if the params are invalid - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.NewParams(true, -time.Second)),
+			false,
+		},
+		{
+			"if the params are valid + authority is valid - pass",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.NewParams(true, time.Minute)),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
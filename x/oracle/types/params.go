@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+const (
+	// DefaultMaxPriceAge is the default maximum age of a price, relative to the block time, for it
+	// to be aggregated.
+	DefaultMaxPriceAge = 30 * time.Second
+
+	// DefaultJailDuration is the default duration for which validators that persistently miss their
+	// vote extensions are jailed.
+	DefaultJailDuration = 10 * time.Minute
+)
+
+// DefaultParams returns default oracle parameters. Extended vote extensions are disabled, and the
+// performance of validators is neither tracked nor penalised by default.
+func DefaultParams() Params {
+	return NewParams(false, DefaultMaxPriceAge)
+}
+
+// NewParams returns a new Params instance with the default slashing parameters.
+func NewParams(extendedVoteExtensionsEnabled bool, maxPriceAge time.Duration) Params {
+	return Params{
+		ExtendedVoteExtensionsEnabled: extendedVoteExtensionsEnabled,
+		MaxPriceAge:                   maxPriceAge,
+		SlashingParams:                DefaultSlashingParams(),
+	}
+}
+
+// DefaultSlashingParams returns the default slashing parameters. Validators are never penalised by
+// default.
+func DefaultSlashingParams() SlashingParams {
+	return NewSlashingParams(math.LegacyZeroDec(), DefaultJailDuration, math.LegacyZeroDec())
+}
+
+// NewSlashingParams returns a new SlashingParams instance.
+func NewSlashingParams(minValidPerWindow math.LegacyDec, jailDuration time.Duration, slashFraction math.LegacyDec) SlashingParams {
+	return SlashingParams{
+		MinValidPerWindow: minValidPerWindow,
+		JailDuration:      jailDuration,
+		SlashFraction:     slashFraction,
+	}
+}
+
+// ValidateBasic performs stateless validation of the Params.
+func (p *Params) ValidateBasic() error {
+	if p.MaxPriceAge < 0 {
+		return fmt.Errorf("max price age must be non-negative; got %s", p.MaxPriceAge)
+	}
+
+	seen := make(map[connecttypes.CurrencyPair]struct{}, len(p.CriticalCurrencyPairs))
+	for _, cp := range p.CriticalCurrencyPairs {
+		if err := cp.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid critical currency pair %s: %w", cp.String(), err)
+		}
+
+		if _, ok := seen[cp]; ok {
+			return fmt.Errorf("duplicate critical currency pair %s", cp.String())
+		}
+		seen[cp] = struct{}{}
+	}
+
+	if err := p.SlashingParams.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid slashing params: %w", err)
+	}
+
+	return nil
+}
+
+// Enabled returns true if validators that persistently miss their vote extensions are penalised.
+// Params that were stored before the slashing params were introduced have nil fractions, which
+// disable penalties.
+func (p *SlashingParams) Enabled() bool {
+	return !p.MinValidPerWindow.IsNil() && p.MinValidPerWindow.IsPositive()
+}
+
+// ValidateBasic performs stateless validation of the SlashingParams.
+func (p *SlashingParams) ValidateBasic() error {
+	if err := validateFraction(p.MinValidPerWindow); err != nil {
+		return fmt.Errorf("invalid min valid per window: %w", err)
+	}
+
+	if p.JailDuration < 0 {
+		return fmt.Errorf("jail duration must be non-negative; got %s", p.JailDuration)
+	}
+
+	if err := validateFraction(p.SlashFraction); err != nil {
+		return fmt.Errorf("invalid slash fraction: %w", err)
+	}
+
+	return nil
+}
+
+// validateFraction checks that a fraction is unset or within [0, 1].
+func validateFraction(fraction math.LegacyDec) error {
+	if fraction.IsNil() {
+		return nil
+	}
+
+	if fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fraction must be between 0 and 1; got %s", fraction)
+	}
+
+	return nil
+}
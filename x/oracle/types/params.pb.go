@@ -0,0 +1,828 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/skip-mev/connect/v2/pkg/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/oracle module.
+type Params struct {
+	// ExtendedVoteExtensionsEnabled determines whether validators include the
+	// metadata of each price, i.e. its observation time, provider count and
+	// dispersion, in their vote extensions, and whether this metadata is used
+	// when aggregating prices.
+	ExtendedVoteExtensionsEnabled bool `protobuf:"varint,1,opt,name=extended_vote_extensions_enabled,json=extendedVoteExtensionsEnabled,proto3" json:"extended_vote_extensions_enabled,omitempty"`
+	// MaxPriceAge defines the maximum age of a price, relative to the block
+	// time, for it to be aggregated. It is only enforced for prices with
+	// metadata. If this is zero, the age of prices is not checked.
+	MaxPriceAge time.Duration `protobuf:"bytes,2,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age"`
+	// CriticalCurrencyPairs defines the currency pairs that validators always
+	// include in their vote extensions before any other currency pair, if their
+	// vote extensions exceed their configured maximum size.
+	CriticalCurrencyPairs []types.CurrencyPair `protobuf:"bytes,3,rep,name=critical_currency_pairs,json=criticalCurrencyPairs,proto3" json:"critical_currency_pairs"`
+	// PerformanceWindow defines the number of blocks over which the oracle
+	// performance of each validator, i.e. its missed vote extensions, missing
+	// prices and deviation from the aggregate prices, is tracked. If this is
+	// zero, the performance of validators is not tracked.
+	PerformanceWindow uint64 `protobuf:"varint,4,opt,name=performance_window,json=performanceWindow,proto3" json:"performance_window,omitempty"`
+	// SlashingParams defines the parameters for jailing and slashing validators
+	// that persistently miss their vote extensions.
+	SlashingParams SlashingParams `protobuf:"bytes,5,opt,name=slashing_params,json=slashingParams,proto3" json:"slashing_params"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3529c71237e76268, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetExtendedVoteExtensionsEnabled() bool {
+	if m != nil {
+		return m.ExtendedVoteExtensionsEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetCriticalCurrencyPairs() []types.CurrencyPair {
+	if m != nil {
+		return m.CriticalCurrencyPairs
+	}
+	return nil
+}
+
+func (m *Params) GetPerformanceWindow() uint64 {
+	if m != nil {
+		return m.PerformanceWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSlashingParams() SlashingParams {
+	if m != nil {
+		return m.SlashingParams
+	}
+	return SlashingParams{}
+}
+
+// SlashingParams defines the parameters for jailing and slashing validators
+// that persistently miss their vote extensions. Validators are penalised based
+// on their performance over the window defined by the performance_window param.
+type SlashingParams struct {
+	// MinValidPerWindow defines the minimum fraction of blocks in the
+	// performance window in which a validator must not miss its vote extension.
+	// Validators below this fraction are jailed, and slashed if SlashFraction is
+	// positive. If this is zero, validators are never penalised.
+	MinValidPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_valid_per_window"`
+	// JailDuration defines how long a penalised validator is jailed for.
+	JailDuration time.Duration `protobuf:"bytes,2,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+	// SlashFraction defines the fraction of a penalised validator's stake that
+	// is slashed.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+}
+
+func (m *SlashingParams) Reset()         { *m = SlashingParams{} }
+func (m *SlashingParams) String() string { return proto.CompactTextString(m) }
+func (*SlashingParams) ProtoMessage()    {}
+func (*SlashingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3529c71237e76268, []int{1}
+}
+func (m *SlashingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingParams.Merge(m, src)
+}
+func (m *SlashingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingParams proto.InternalMessageInfo
+
+func (m *SlashingParams) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "connect.oracle.v2.Params")
+	proto.RegisterType((*SlashingParams)(nil), "connect.oracle.v2.SlashingParams")
+}
+
+func init() { proto.RegisterFile("connect/oracle/v2/params.proto", fileDescriptor_3529c71237e76268) }
+
+var fileDescriptor_3529c71237e76268 = []byte{
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0xdb, 0x50, 0x95, 0x0d, 0x29, 0x8a, 0x55, 0x44, 0x5a, 0x84, 0x13, 0x2a, 0x0e, 0xb9,
+	0x64, 0x2d, 0xcc, 0x17, 0x10, 0x52, 0xc2, 0x81, 0x43, 0x14, 0xa4, 0x82, 0x10, 0xd2, 0x6a, 0xb3,
+	0x9e, 0x38, 0x4b, 0xed, 0x5d, 0x6b, 0xd7, 0x71, 0x93, 0xbf, 0xe0, 0xd8, 0x0f, 0xe1, 0x23, 0x7a,
+	0xac, 0x38, 0x21, 0x0e, 0x05, 0x25, 0x5f, 0xc1, 0x0d, 0x79, 0xd7, 0x46, 0x8d, 0x38, 0xc1, 0xcd,
+	0xb3, 0xef, 0xcd, 0xd3, 0x7b, 0x33, 0x63, 0xe4, 0x31, 0x29, 0x04, 0xb0, 0xcc, 0x97, 0x8a, 0xb2,
+	0x18, 0xfc, 0x3c, 0xf0, 0x53, 0xaa, 0x68, 0xa2, 0x71, 0xaa, 0x64, 0x26, 0xdd, 0x56, 0x89, 0x63,
+	0x8b, 0xe3, 0x3c, 0x38, 0x3e, 0x8c, 0x64, 0x24, 0x0d, 0xea, 0x17, 0x5f, 0x96, 0x78, 0xec, 0x45,
+	0x52, 0x46, 0x31, 0xf8, 0xa6, 0x9a, 0x2e, 0x66, 0x7e, 0xb8, 0x50, 0x34, 0xe3, 0x52, 0x94, 0xf8,
+	0x11, 0x93, 0x3a, 0x91, 0x9a, 0xd8, 0x46, 0x5b, 0x94, 0xd0, 0xd3, 0xca, 0x43, 0xb6, 0x4a, 0x41,
+	0x17, 0x16, 0xd8, 0x42, 0x29, 0x10, 0x6c, 0x45, 0x52, 0xca, 0x95, 0x65, 0x9d, 0xfc, 0xda, 0x41,
+	0x7b, 0x63, 0x63, 0xcd, 0x1d, 0xa1, 0x2e, 0x2c, 0x33, 0x10, 0x21, 0x84, 0x24, 0x97, 0x19, 0x10,
+	0x53, 0x69, 0x2e, 0x85, 0x26, 0x20, 0xe8, 0x34, 0x86, 0xb0, 0xed, 0x74, 0x9d, 0xde, 0xfe, 0xe4,
+	0x71, 0xc5, 0x3b, 0x93, 0x19, 0x9c, 0xfe, 0x61, 0x9d, 0x5a, 0x92, 0x3b, 0x42, 0xcd, 0x84, 0x2e,
+	0x49, 0xaa, 0x38, 0x03, 0x42, 0x23, 0x68, 0xef, 0x74, 0x9d, 0x5e, 0x23, 0x38, 0xc2, 0x36, 0x0c,
+	0xae, 0xc2, 0xe0, 0x61, 0x19, 0x66, 0xb0, 0x7f, 0x75, 0xd3, 0xa9, 0x5d, 0xfe, 0xe8, 0x38, 0x93,
+	0x46, 0x42, 0x97, 0xe3, 0xa2, 0xf1, 0x45, 0x04, 0xee, 0x47, 0xf4, 0x90, 0x29, 0x9e, 0x71, 0x46,
+	0x63, 0xb2, 0x65, 0x5e, 0xb7, 0x77, 0xbb, 0xbb, 0xbd, 0x46, 0xe0, 0xe1, 0x6a, 0x90, 0x26, 0x24,
+	0xce, 0x03, 0xfc, 0xb2, 0xe4, 0x8d, 0x29, 0x57, 0x83, 0x7a, 0xa1, 0x3b, 0x79, 0x50, 0x89, 0xdc,
+	0xc6, 0xb4, 0xdb, 0x47, 0x6e, 0x0a, 0x6a, 0x26, 0x55, 0x42, 0x05, 0x03, 0x72, 0xc1, 0x45, 0x28,
+	0x2f, 0xda, 0xf5, 0xae, 0xd3, 0xab, 0x4f, 0x5a, 0xb7, 0x90, 0x77, 0x06, 0x70, 0xc7, 0xe8, 0xbe,
+	0x8e, 0xa9, 0x9e, 0x73, 0x11, 0x11, 0xbb, 0xcc, 0xf6, 0x1d, 0x93, 0xeb, 0x09, 0xfe, 0x6b, 0x9b,
+	0xf8, 0x6d, 0xc9, 0xb4, 0xa3, 0x2d, 0x7d, 0x1c, 0xe8, 0xad, 0xd7, 0x93, 0xcb, 0x1d, 0x74, 0xb0,
+	0x4d, 0x74, 0xa7, 0xe8, 0x30, 0xe1, 0x82, 0xe4, 0x34, 0xe6, 0x21, 0x49, 0x41, 0x55, 0xae, 0x8a,
+	0xb9, 0xdf, 0x1d, 0x3c, 0x2b, 0x64, 0xbe, 0xdf, 0x74, 0x1e, 0xd9, 0x45, 0xeb, 0xf0, 0x1c, 0x73,
+	0xe9, 0x27, 0x34, 0x9b, 0xe3, 0x37, 0x10, 0x51, 0xb6, 0x1a, 0x02, 0xfb, 0xfa, 0xa5, 0x8f, 0x2c,
+	0x8c, 0x87, 0xc0, 0x26, 0xad, 0x84, 0x8b, 0xb3, 0x42, 0x6d, 0x0c, 0xaa, 0x0c, 0xf2, 0x1a, 0x35,
+	0x3f, 0x51, 0x1e, 0x93, 0xea, 0x94, 0xfe, 0x65, 0x3d, 0xf7, 0x8a, 0xce, 0xea, 0xdd, 0x7d, 0x8f,
+	0x6c, 0x24, 0x32, 0x53, 0x94, 0x19, 0xa9, 0xdd, 0xff, 0xf5, 0xd9, 0x34, 0x42, 0xaf, 0x4a, 0x9d,
+	0xc1, 0xe8, 0x6a, 0xed, 0x39, 0xd7, 0x6b, 0xcf, 0xf9, 0xb9, 0xf6, 0x9c, 0xcf, 0x1b, 0xaf, 0x76,
+	0xbd, 0xf1, 0x6a, 0xdf, 0x36, 0x5e, 0xed, 0x43, 0x3f, 0xe2, 0xd9, 0x7c, 0x31, 0xc5, 0x4c, 0x26,
+	0xbe, 0x3e, 0xe7, 0x69, 0x3f, 0x81, 0xdc, 0xaf, 0x4e, 0x3d, 0x0f, 0xfc, 0x65, 0xf5, 0xcf, 0x99,
+	0x8b, 0x98, 0xee, 0x99, 0x34, 0xcf, 0x7f, 0x0f, 0x00, 0xe7, 0xc5, 0xa7, 0xb4, 0x92, 0x03, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SlashingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PerformanceWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PerformanceWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CriticalCurrencyPairs) > 0 {
+		for iNdEx := len(m.CriticalCurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CriticalCurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.ExtendedVoteExtensionsEnabled {
+		i--
+		if m.ExtendedVoteExtensionsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtendedVoteExtensionsEnabled {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.CriticalCurrencyPairs) > 0 {
+		for _, e := range m.CriticalCurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.PerformanceWindow != 0 {
+		n += 1 + sovParams(uint64(m.PerformanceWindow))
+	}
+	l = m.SlashingParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *SlashingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedVoteExtensionsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExtendedVoteExtensionsEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalCurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CriticalCurrencyPairs = append(m.CriticalCurrencyPairs, types.CurrencyPair{})
+			if err := m.CriticalCurrencyPairs[len(m.CriticalCurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceWindow", wireType)
+			}
+			m.PerformanceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestParamsValidateBasic(t *testing.T) {
+	tcs := []struct {
+		name       string
+		params     types.Params
+		expectPass bool
+	}{
+		{
+			"default params - pass",
+			types.DefaultParams(),
+			true,
+		},
+		{
+			"extended vote extensions without a max price age - pass",
+			types.NewParams(true, 0),
+			true,
+		},
+		{
+			"extended vote extensions with a max price age - pass",
+			types.NewParams(true, time.Minute),
+			true,
+		},
+		{
+			"negative max price age - fail",
+			types.NewParams(true, -time.Second),
+			false,
+		},
+		{
+			"critical currency pairs - pass",
+			types.Params{
+				CriticalCurrencyPairs: []connecttypes.CurrencyPair{
+					connecttypes.NewCurrencyPair("BTC", "USD"),
+					connecttypes.NewCurrencyPair("ETH", "USD"),
+				},
+			},
+			true,
+		},
+		{
+			"invalid critical currency pair - fail",
+			types.Params{
+				CriticalCurrencyPairs: []connecttypes.CurrencyPair{
+					{Base: "BTC"},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate critical currency pairs - fail",
+			types.Params{
+				CriticalCurrencyPairs: []connecttypes.CurrencyPair{
+					connecttypes.NewCurrencyPair("BTC", "USD"),
+					connecttypes.NewCurrencyPair("BTC", "USD"),
+				},
+			},
+			false,
+		},
+		{
+			"slashing params - pass",
+			types.Params{
+				SlashingParams: types.NewSlashingParams(math.LegacyNewDecWithPrec(5, 1), time.Hour, math.LegacyNewDecWithPrec(1, 4)),
+			},
+			true,
+		},
+		{
+			"unset slashing params - pass",
+			types.Params{},
+			true,
+		},
+		{
+			"min valid per window above one - fail",
+			types.Params{
+				SlashingParams: types.NewSlashingParams(math.LegacyNewDec(2), time.Hour, math.LegacyZeroDec()),
+			},
+			false,
+		},
+		{
+			"negative jail duration - fail",
+			types.Params{
+				SlashingParams: types.NewSlashingParams(math.LegacyNewDecWithPrec(5, 1), -time.Hour, math.LegacyZeroDec()),
+			},
+			false,
+		},
+		{
+			"negative slash fraction - fail",
+			types.Params{
+				SlashingParams: types.NewSlashingParams(math.LegacyNewDecWithPrec(5, 1), time.Hour, math.LegacyNewDec(-1)),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
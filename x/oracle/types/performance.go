@@ -0,0 +1,78 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPriceDeviation is the maximum deviation of a price from an aggregate price, in basis points,
+// that is recorded in a ValidatorReport. Larger deviations are recorded as MaxPriceDeviation, so
+// that a single outlier cannot overflow the deviation of a validator's window.
+const MaxPriceDeviation = 10_000
+
+// NewValidatorPerformance returns a new ValidatorPerformance instance for a validator with an
+// empty window of the given size.
+func NewValidatorPerformance(validator sdk.ConsAddress, window uint64) ValidatorPerformance {
+	return ValidatorPerformance{
+		Validator: validator.String(),
+		Window:    window,
+	}
+}
+
+// AddReport adds the given report to the performance.
+func (p *ValidatorPerformance) AddReport(report ValidatorReport) {
+	p.Blocks++
+	if report.MissedVoteExtension {
+		p.MissedVoteExtensions++
+	}
+	p.MissingPrices += report.MissingPrices
+	p.ReportedPrices += report.ReportedPrices
+	p.Deviation += report.Deviation
+	p.DroppedPrices += report.DroppedPrices
+}
+
+// RemoveReport removes the given report, which must have been added before, from the
+// performance.
+func (p *ValidatorPerformance) RemoveReport(report ValidatorReport) {
+	p.Blocks--
+	if report.MissedVoteExtension {
+		p.MissedVoteExtensions--
+	}
+	p.MissingPrices -= report.MissingPrices
+	p.ReportedPrices -= report.ReportedPrices
+	p.Deviation -= report.Deviation
+	p.DroppedPrices -= report.DroppedPrices
+}
+
+// MeanDeviation returns the mean deviation of the validator's reported prices in the window from
+// the aggregate prices, in basis points.
+func (p *ValidatorPerformance) MeanDeviation() uint64 {
+	if p.ReportedPrices == 0 {
+		return 0
+	}
+
+	return p.Deviation / p.ReportedPrices
+}
+
+// PriceDeviation returns the deviation of a price from an aggregate price in basis points, capped
+// at MaxPriceDeviation. A non-positive aggregate price yields the maximum deviation unless both
+// prices are equal.
+func PriceDeviation(price, aggregate *big.Int) uint64 {
+	diff := new(big.Int).Sub(price, aggregate)
+	if diff.Sign() == 0 {
+		return 0
+	}
+	if aggregate.Sign() <= 0 {
+		return MaxPriceDeviation
+	}
+
+	diff.Abs(diff)
+	diff.Mul(diff, big.NewInt(10_000))
+	diff.Quo(diff, aggregate)
+	if !diff.IsUint64() || diff.Uint64() > MaxPriceDeviation {
+		return MaxPriceDeviation
+	}
+
+	return diff.Uint64()
+}
@@ -0,0 +1,986 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/performance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorReport is the oracle report of a validator for a single block, as
+// derived from the validator's vote extension.
+type ValidatorReport struct {
+	// MissedVoteExtension is true if the validator signed the block but did not
+	// report any prices, i.e. its vote extension was empty or invalid. Absent
+	// validators are left to the x/slashing module's downtime handling.
+	MissedVoteExtension bool `protobuf:"varint,1,opt,name=missed_vote_extension,json=missedVoteExtension,proto3" json:"missed_vote_extension,omitempty"`
+	// MissingPrices is the number of currency pairs of enabled markets the
+	// validator did not report a price for, including the prices it dropped to
+	// fit its vote extension size budget. It is zero if the validator missed its
+	// vote extension.
+	MissingPrices uint64 `protobuf:"varint,2,opt,name=missing_prices,json=missingPrices,proto3" json:"missing_prices,omitempty"`
+	// ReportedPrices is the number of prices reported by the validator that were
+	// compared against the aggregate price of their currency pair.
+	ReportedPrices uint64 `protobuf:"varint,3,opt,name=reported_prices,json=reportedPrices,proto3" json:"reported_prices,omitempty"`
+	// Deviation is the sum of the deviations of the validator's reported prices
+	// from the aggregate prices, in basis points.
+	Deviation uint64 `protobuf:"varint,4,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	// DroppedPrices is the number of missing prices the validator did not report
+	// because they did not fit its vote extension size budget, as reported in its
+	// vote extension. The count is self-reported and cannot be verified, so these
+	// prices are still counted in MissingPrices.
+	DroppedPrices uint64 `protobuf:"varint,5,opt,name=dropped_prices,json=droppedPrices,proto3" json:"dropped_prices,omitempty"`
+}
+
+func (m *ValidatorReport) Reset()         { *m = ValidatorReport{} }
+func (m *ValidatorReport) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReport) ProtoMessage()    {}
+func (*ValidatorReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17fb5b53b8239dc1, []int{0}
+}
+func (m *ValidatorReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReport.Merge(m, src)
+}
+func (m *ValidatorReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReport proto.InternalMessageInfo
+
+func (m *ValidatorReport) GetMissedVoteExtension() bool {
+	if m != nil {
+		return m.MissedVoteExtension
+	}
+	return false
+}
+
+func (m *ValidatorReport) GetMissingPrices() uint64 {
+	if m != nil {
+		return m.MissingPrices
+	}
+	return 0
+}
+
+func (m *ValidatorReport) GetReportedPrices() uint64 {
+	if m != nil {
+		return m.ReportedPrices
+	}
+	return 0
+}
+
+func (m *ValidatorReport) GetDeviation() uint64 {
+	if m != nil {
+		return m.Deviation
+	}
+	return 0
+}
+
+func (m *ValidatorReport) GetDroppedPrices() uint64 {
+	if m != nil {
+		return m.DroppedPrices
+	}
+	return 0
+}
+
+// ValidatorPerformance is the oracle performance of a validator over a sliding
+// window of blocks.
+type ValidatorPerformance struct {
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Window is the size of the sliding window in blocks, i.e. the
+	// performance_window param when the window was started.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// IndexOffset is the number of reports recorded since the window was
+	// started. The next report is recorded at IndexOffset modulo Window.
+	IndexOffset uint64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// Blocks is the number of blocks in the window that reports were recorded
+	// for.
+	Blocks uint64 `protobuf:"varint,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// MissedVoteExtensions is the number of blocks in the window in which the
+	// validator missed its vote extension.
+	MissedVoteExtensions uint64 `protobuf:"varint,5,opt,name=missed_vote_extensions,json=missedVoteExtensions,proto3" json:"missed_vote_extensions,omitempty"`
+	// MissingPrices is the number of prices the validator did not report in the
+	// window.
+	MissingPrices uint64 `protobuf:"varint,6,opt,name=missing_prices,json=missingPrices,proto3" json:"missing_prices,omitempty"`
+	// ReportedPrices is the number of prices reported by the validator in the
+	// window that were compared against an aggregate price.
+	ReportedPrices uint64 `protobuf:"varint,7,opt,name=reported_prices,json=reportedPrices,proto3" json:"reported_prices,omitempty"`
+	// Deviation is the sum of the deviations of the validator's reported prices
+	// in the window from the aggregate prices, in basis points.
+	Deviation uint64 `protobuf:"varint,8,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	// LastHeight is the height of the block the latest report was recorded in.
+	// If a validator is absent from the commit for a block, e.g. because it was
+	// jailed or left the active set, its window restarts with its next report.
+	LastHeight uint64 `protobuf:"varint,9,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// DroppedPrices is the number of prices the validator dropped from its vote
+	// extensions in the window to fit its vote extension size budget. These
+	// prices are also counted in MissingPrices.
+	DroppedPrices uint64 `protobuf:"varint,10,opt,name=dropped_prices,json=droppedPrices,proto3" json:"dropped_prices,omitempty"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17fb5b53b8239dc1, []int{1}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+func (m *ValidatorPerformance) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorPerformance) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetMissedVoteExtensions() uint64 {
+	if m != nil {
+		return m.MissedVoteExtensions
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetMissingPrices() uint64 {
+	if m != nil {
+		return m.MissingPrices
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetReportedPrices() uint64 {
+	if m != nil {
+		return m.ReportedPrices
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetDeviation() uint64 {
+	if m != nil {
+		return m.Deviation
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetLastHeight() uint64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetDroppedPrices() uint64 {
+	if m != nil {
+		return m.DroppedPrices
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorReport)(nil), "connect.oracle.v2.ValidatorReport")
+	proto.RegisterType((*ValidatorPerformance)(nil), "connect.oracle.v2.ValidatorPerformance")
+}
+
+func init() {
+	proto.RegisterFile("connect/oracle/v2/performance.proto", fileDescriptor_17fb5b53b8239dc1)
+}
+
+var fileDescriptor_17fb5b53b8239dc1 = []byte{
+	// 391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6e, 0xda, 0x40,
+	0x10, 0x86, 0x31, 0xa5, 0x14, 0x96, 0x16, 0x54, 0x97, 0x22, 0x0e, 0x95, 0x4b, 0xa9, 0xaa, 0x72,
+	0xc1, 0x96, 0x9c, 0x3c, 0x41, 0xa4, 0x28, 0xb9, 0x05, 0xf9, 0xc0, 0x21, 0x17, 0xcb, 0xd8, 0x03,
+	0xac, 0xb0, 0x77, 0x57, 0xbb, 0x1b, 0x43, 0xde, 0x22, 0x8f, 0x95, 0x23, 0xc7, 0x28, 0xa7, 0x08,
+	0x1e, 0x21, 0x2f, 0x10, 0x79, 0xbd, 0x8e, 0xa5, 0xc8, 0x52, 0x72, 0xdc, 0x6f, 0xfe, 0x9d, 0x7f,
+	0xe6, 0xd7, 0xa0, 0xbf, 0x21, 0x25, 0x04, 0x42, 0xe9, 0x50, 0x1e, 0x84, 0x31, 0x38, 0xa9, 0xeb,
+	0x30, 0xe0, 0x4b, 0xca, 0x93, 0x80, 0x84, 0x60, 0x33, 0x4e, 0x25, 0x35, 0xbf, 0x6b, 0x91, 0x9d,
+	0x8b, 0xec, 0xd4, 0x1d, 0x3f, 0x1a, 0xa8, 0x37, 0x0f, 0x62, 0x1c, 0x05, 0x92, 0x72, 0x0f, 0x18,
+	0xe5, 0xd2, 0x74, 0xd1, 0xcf, 0x04, 0x0b, 0x01, 0x91, 0x9f, 0x52, 0x09, 0x3e, 0xec, 0x24, 0x10,
+	0x81, 0x29, 0x19, 0x1a, 0x23, 0x63, 0xd2, 0xf2, 0x7e, 0xe4, 0xc5, 0x39, 0x95, 0x70, 0x5e, 0x94,
+	0xcc, 0x7f, 0xa8, 0x9b, 0x61, 0x4c, 0x56, 0x3e, 0xe3, 0x38, 0x04, 0x31, 0xac, 0x8f, 0x8c, 0x49,
+	0xc3, 0xfb, 0xa6, 0xe9, 0x4c, 0x41, 0xf3, 0x3f, 0xea, 0x71, 0x65, 0x02, 0x51, 0xa1, 0xfb, 0xa4,
+	0x74, 0xdd, 0x02, 0x6b, 0xe1, 0x2f, 0xd4, 0x8e, 0x20, 0xc5, 0x81, 0xcc, 0x7c, 0x1b, 0x4a, 0x52,
+	0x82, 0xcc, 0x2d, 0xe2, 0x94, 0xb1, 0xb2, 0xcb, 0xe7, 0xdc, 0x4d, 0xd3, 0xbc, 0xc9, 0xf8, 0xb9,
+	0x8e, 0xfa, 0xaf, 0xcb, 0xcd, 0xca, 0x38, 0xb2, 0xee, 0x69, 0xc1, 0xd5, 0x56, 0x6d, 0xaf, 0x04,
+	0xe6, 0x00, 0x35, 0xb7, 0x98, 0x44, 0x74, 0xab, 0x77, 0xd0, 0x2f, 0xf3, 0x0f, 0xfa, 0x8a, 0x49,
+	0x04, 0x3b, 0x9f, 0x2e, 0x97, 0x02, 0xa4, 0x9e, 0xbc, 0xa3, 0xd8, 0x95, 0x42, 0xd9, 0xd7, 0x45,
+	0x4c, 0xc3, 0x8d, 0xd0, 0x33, 0xeb, 0x97, 0x79, 0x8a, 0x06, 0x95, 0x91, 0x16, 0x83, 0xf7, 0x2b,
+	0x32, 0x15, 0x15, 0xa1, 0x36, 0x3f, 0x18, 0xea, 0x97, 0xf7, 0x43, 0x6d, 0xbd, 0x0d, 0xf5, 0x37,
+	0xea, 0xc4, 0x81, 0x90, 0xfe, 0x1a, 0xf0, 0x6a, 0x2d, 0x87, 0x6d, 0x55, 0x47, 0x19, 0xba, 0x54,
+	0xa4, 0x22, 0x75, 0x54, 0x91, 0xfa, 0xd9, 0xc5, 0xfd, 0xc1, 0x32, 0xf6, 0x07, 0xcb, 0x78, 0x3a,
+	0x58, 0xc6, 0xdd, 0xd1, 0xaa, 0xed, 0x8f, 0x56, 0xed, 0xe1, 0x68, 0xd5, 0xae, 0xa7, 0x2b, 0x2c,
+	0xd7, 0x37, 0x0b, 0x3b, 0xa4, 0x89, 0x23, 0x36, 0x98, 0x4d, 0x13, 0x48, 0x9d, 0xe2, 0x70, 0x53,
+	0xd7, 0xd9, 0x15, 0xd7, 0x2b, 0x6f, 0x19, 0x88, 0x45, 0x53, 0x5d, 0xed, 0xc9, 0xcb, 0x00, 0x91,
+	0x3f, 0xf0, 0x6f, 0xdc, 0x02, 0x00, 0x00,
+}
+
+func (m *ValidatorReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DroppedPrices != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.DroppedPrices))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Deviation != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Deviation))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReportedPrices != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.ReportedPrices))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissingPrices != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.MissingPrices))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MissedVoteExtension {
+		i--
+		if m.MissedVoteExtension {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DroppedPrices != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.DroppedPrices))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Deviation != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Deviation))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReportedPrices != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.ReportedPrices))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MissingPrices != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.MissingPrices))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MissedVoteExtensions != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.MissedVoteExtensions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintPerformance(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPerformance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPerformance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissedVoteExtension {
+		n += 2
+	}
+	if m.MissingPrices != 0 {
+		n += 1 + sovPerformance(uint64(m.MissingPrices))
+	}
+	if m.ReportedPrices != 0 {
+		n += 1 + sovPerformance(uint64(m.ReportedPrices))
+	}
+	if m.Deviation != 0 {
+		n += 1 + sovPerformance(uint64(m.Deviation))
+	}
+	if m.DroppedPrices != 0 {
+		n += 1 + sovPerformance(uint64(m.DroppedPrices))
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovPerformance(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovPerformance(uint64(m.Window))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovPerformance(uint64(m.IndexOffset))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovPerformance(uint64(m.Blocks))
+	}
+	if m.MissedVoteExtensions != 0 {
+		n += 1 + sovPerformance(uint64(m.MissedVoteExtensions))
+	}
+	if m.MissingPrices != 0 {
+		n += 1 + sovPerformance(uint64(m.MissingPrices))
+	}
+	if m.ReportedPrices != 0 {
+		n += 1 + sovPerformance(uint64(m.ReportedPrices))
+	}
+	if m.Deviation != 0 {
+		n += 1 + sovPerformance(uint64(m.Deviation))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovPerformance(uint64(m.LastHeight))
+	}
+	if m.DroppedPrices != 0 {
+		n += 1 + sovPerformance(uint64(m.DroppedPrices))
+	}
+	return n
+}
+
+func sovPerformance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPerformance(x uint64) (n int) {
+	return sovPerformance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVoteExtension", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MissedVoteExtension = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingPrices", wireType)
+			}
+			m.MissingPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedPrices", wireType)
+			}
+			m.ReportedPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+			}
+			m.Deviation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deviation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedPrices", wireType)
+			}
+			m.DroppedPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVoteExtensions", wireType)
+			}
+			m.MissedVoteExtensions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVoteExtensions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingPrices", wireType)
+			}
+			m.MissingPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedPrices", wireType)
+			}
+			m.ReportedPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+			}
+			m.Deviation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deviation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedPrices", wireType)
+			}
+			m.DroppedPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPerformance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPerformance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPerformance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPerformance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPerformance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPerformance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPerformance = fmt.Errorf("proto: unexpected end of group")
+)
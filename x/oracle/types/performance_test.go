@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestPriceDeviation(t *testing.T) {
+	tcs := []struct {
+		name      string
+		price     *big.Int
+		aggregate *big.Int
+		expected  uint64
+	}{
+		{
+			"equal prices",
+			big.NewInt(100),
+			big.NewInt(100),
+			0,
+		},
+		{
+			"price above the aggregate price",
+			big.NewInt(101),
+			big.NewInt(100),
+			100,
+		},
+		{
+			"price below the aggregate price",
+			big.NewInt(95),
+			big.NewInt(100),
+			500,
+		},
+		{
+			"deviation is rounded down",
+			big.NewInt(1_000_001),
+			big.NewInt(1_000_000),
+			0,
+		},
+		{
+			"deviation is capped",
+			big.NewInt(1_000),
+			big.NewInt(100),
+			types.MaxPriceDeviation,
+		},
+		{
+			"zero aggregate price",
+			big.NewInt(1),
+			big.NewInt(0),
+			types.MaxPriceDeviation,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.PriceDeviation(tc.price, tc.aggregate))
+		})
+	}
+}
+
+func TestValidatorPerformance(t *testing.T) {
+	performance := types.NewValidatorPerformance(sdk.ConsAddress("validator"), 10)
+	require.Equal(t, sdk.ConsAddress("validator").String(), performance.Validator)
+	require.Equal(t, uint64(10), performance.Window)
+	require.Equal(t, uint64(0), performance.MeanDeviation())
+
+	missed := types.ValidatorReport{MissedVoteExtension: true}
+	reported := types.ValidatorReport{MissingPrices: 1, ReportedPrices: 2, Deviation: 300, DroppedPrices: 4}
+
+	performance.AddReport(missed)
+	performance.AddReport(reported)
+	require.Equal(t, types.ValidatorPerformance{
+		Validator:            performance.Validator,
+		Window:               10,
+		Blocks:               2,
+		MissedVoteExtensions: 1,
+		MissingPrices:        1,
+		ReportedPrices:       2,
+		Deviation:            300,
+		DroppedPrices:        4,
+	}, performance)
+	require.Equal(t, uint64(150), performance.MeanDeviation())
+
+	performance.RemoveReport(missed)
+	require.Equal(t, uint64(1), performance.Blocks)
+	require.Equal(t, uint64(0), performance.MissedVoteExtensions)
+	require.Equal(t, uint64(2), performance.ReportedPrices)
+
+	performance.RemoveReport(reported)
+	require.Equal(t, uint64(0), performance.DroppedPrices)
+}
@@ -549,6 +549,267 @@ func (m *GetCurrencyPairMappingListResponse) GetMappings() []CurrencyPairMapping
 	return nil
 }
 
+// GetParamsRequest is the GetParams request type.
+type GetParamsRequest struct {
+}
+
+func (m *GetParamsRequest) Reset()         { *m = GetParamsRequest{} }
+func (m *GetParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetParamsRequest) ProtoMessage()    {}
+func (*GetParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{11}
+}
+func (m *GetParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetParamsRequest.Merge(m, src)
+}
+func (m *GetParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetParamsRequest proto.InternalMessageInfo
+
+// GetParamsResponse is the GetParams response type.
+type GetParamsResponse struct {
+	// params are the parameters of the x/oracle module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *GetParamsResponse) Reset()         { *m = GetParamsResponse{} }
+func (m *GetParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetParamsResponse) ProtoMessage()    {}
+func (*GetParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{12}
+}
+func (m *GetParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetParamsResponse.Merge(m, src)
+}
+func (m *GetParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetParamsResponse proto.InternalMessageInfo
+
+func (m *GetParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// GetValidatorPerformanceRequest is the GetValidatorPerformance request type.
+type GetValidatorPerformanceRequest struct {
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *GetValidatorPerformanceRequest) Reset()         { *m = GetValidatorPerformanceRequest{} }
+func (m *GetValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorPerformanceRequest) ProtoMessage()    {}
+func (*GetValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{13}
+}
+func (m *GetValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidatorPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorPerformanceRequest.Merge(m, src)
+}
+func (m *GetValidatorPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorPerformanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorPerformanceRequest proto.InternalMessageInfo
+
+func (m *GetValidatorPerformanceRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// GetValidatorPerformanceResponse is the GetValidatorPerformance response
+// type.
+type GetValidatorPerformanceResponse struct {
+	// performance is the oracle performance of the validator.
+	Performance ValidatorPerformance `protobuf:"bytes,1,opt,name=performance,proto3" json:"performance"`
+}
+
+func (m *GetValidatorPerformanceResponse) Reset()         { *m = GetValidatorPerformanceResponse{} }
+func (m *GetValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorPerformanceResponse) ProtoMessage()    {}
+func (*GetValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{14}
+}
+func (m *GetValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidatorPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorPerformanceResponse.Merge(m, src)
+}
+func (m *GetValidatorPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorPerformanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorPerformanceResponse proto.InternalMessageInfo
+
+func (m *GetValidatorPerformanceResponse) GetPerformance() ValidatorPerformance {
+	if m != nil {
+		return m.Performance
+	}
+	return ValidatorPerformance{}
+}
+
+// GetAllValidatorPerformanceRequest is the GetAllValidatorPerformance request
+// type.
+type GetAllValidatorPerformanceRequest struct {
+}
+
+func (m *GetAllValidatorPerformanceRequest) Reset()         { *m = GetAllValidatorPerformanceRequest{} }
+func (m *GetAllValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAllValidatorPerformanceRequest) ProtoMessage()    {}
+func (*GetAllValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{15}
+}
+func (m *GetAllValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllValidatorPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllValidatorPerformanceRequest.Merge(m, src)
+}
+func (m *GetAllValidatorPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllValidatorPerformanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllValidatorPerformanceRequest proto.InternalMessageInfo
+
+// GetAllValidatorPerformanceResponse is the GetAllValidatorPerformance
+// response type.
+type GetAllValidatorPerformanceResponse struct {
+	// performances are the oracle performances of all validators.
+	Performances []ValidatorPerformance `protobuf:"bytes,1,rep,name=performances,proto3" json:"performances"`
+}
+
+func (m *GetAllValidatorPerformanceResponse) Reset()         { *m = GetAllValidatorPerformanceResponse{} }
+func (m *GetAllValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAllValidatorPerformanceResponse) ProtoMessage()    {}
+func (*GetAllValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{16}
+}
+func (m *GetAllValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllValidatorPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllValidatorPerformanceResponse.Merge(m, src)
+}
+func (m *GetAllValidatorPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllValidatorPerformanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllValidatorPerformanceResponse proto.InternalMessageInfo
+
+func (m *GetAllValidatorPerformanceResponse) GetPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.Performances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetAllCurrencyPairsRequest)(nil), "connect.oracle.v2.GetAllCurrencyPairsRequest")
 	proto.RegisterType((*GetAllCurrencyPairsResponse)(nil), "connect.oracle.v2.GetAllCurrencyPairsResponse")
@@ -562,59 +823,79 @@ func init() {
 	proto.RegisterType((*GetCurrencyPairMappingListRequest)(nil), "connect.oracle.v2.GetCurrencyPairMappingListRequest")
 	proto.RegisterType((*CurrencyPairMapping)(nil), "connect.oracle.v2.CurrencyPairMapping")
 	proto.RegisterType((*GetCurrencyPairMappingListResponse)(nil), "connect.oracle.v2.GetCurrencyPairMappingListResponse")
+	proto.RegisterType((*GetParamsRequest)(nil), "connect.oracle.v2.GetParamsRequest")
+	proto.RegisterType((*GetParamsResponse)(nil), "connect.oracle.v2.GetParamsResponse")
+	proto.RegisterType((*GetValidatorPerformanceRequest)(nil), "connect.oracle.v2.GetValidatorPerformanceRequest")
+	proto.RegisterType((*GetValidatorPerformanceResponse)(nil), "connect.oracle.v2.GetValidatorPerformanceResponse")
+	proto.RegisterType((*GetAllValidatorPerformanceRequest)(nil), "connect.oracle.v2.GetAllValidatorPerformanceRequest")
+	proto.RegisterType((*GetAllValidatorPerformanceResponse)(nil), "connect.oracle.v2.GetAllValidatorPerformanceResponse")
 }
 
 func init() { proto.RegisterFile("connect/oracle/v2/query.proto", fileDescriptor_85b187574238e3d2) }
 
 var fileDescriptor_85b187574238e3d2 = []byte{
-	// 751 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xce, 0xa4, 0x49, 0xd5, 0x9e, 0xde, 0xfe, 0x4d, 0x7b, 0xaf, 0xa2, 0xdc, 0xc6, 0xe9, 0x75,
-	0x73, 0x51, 0x55, 0x51, 0x9b, 0xa6, 0x05, 0x51, 0x16, 0x48, 0x2d, 0x42, 0xa1, 0xfc, 0x48, 0xad,
-	0x17, 0x2c, 0xd8, 0x44, 0xae, 0x33, 0x84, 0xa1, 0x8e, 0xc7, 0xf5, 0x4c, 0x22, 0xb2, 0x60, 0x83,
-	0xd8, 0x17, 0x89, 0x17, 0x80, 0x67, 0xe0, 0x15, 0x58, 0x74, 0x59, 0x89, 0x0d, 0x2b, 0x84, 0x5a,
-	0x1e, 0x04, 0x65, 0x3c, 0x4e, 0x9d, 0xd6, 0x09, 0xc9, 0xce, 0xf6, 0x39, 0xdf, 0x39, 0xdf, 0x77,
-	0xfe, 0x0c, 0x05, 0x87, 0x79, 0x1e, 0x71, 0x84, 0xc9, 0x02, 0xdb, 0x71, 0x89, 0xd9, 0x2a, 0x9b,
-	0xc7, 0x4d, 0x12, 0xb4, 0x0d, 0x3f, 0x60, 0x82, 0xe1, 0x79, 0x65, 0x36, 0x42, 0xb3, 0xd1, 0x2a,
-	0xe7, 0x17, 0xeb, 0xac, 0xce, 0xa4, 0xd5, 0xec, 0x3c, 0x85, 0x8e, 0xf9, 0xa5, 0x3a, 0x63, 0x75,
-	0x97, 0x98, 0xb6, 0x4f, 0x4d, 0xdb, 0xf3, 0x98, 0xb0, 0x05, 0x65, 0x1e, 0x57, 0xd6, 0xe2, 0xf5,
-	0x2c, 0x75, 0xe2, 0x11, 0x4e, 0x23, 0x87, 0x52, 0xe4, 0x20, 0xda, 0x3e, 0xe1, 0x1d, 0xbb, 0xd3,
-	0x0c, 0x02, 0xe2, 0x39, 0xed, 0xaa, 0x6f, 0xd3, 0x20, 0xf4, 0xd2, 0x97, 0x20, 0x5f, 0x21, 0x62,
-	0xc7, 0x75, 0x1f, 0x28, 0xe3, 0xbe, 0x4d, 0x03, 0x6e, 0x91, 0xe3, 0x26, 0xe1, 0x42, 0x7f, 0x0d,
-	0xff, 0x26, 0x5a, 0xb9, 0xcf, 0x3c, 0x4e, 0xf0, 0x13, 0x98, 0xe9, 0x89, 0xc9, 0x73, 0x68, 0x79,
-	0x6c, 0x75, 0xaa, 0xac, 0x19, 0x91, 0x46, 0x99, 0xdb, 0x68, 0x95, 0x8d, 0x78, 0x80, 0xdd, 0xcc,
-	0xe9, 0x8f, 0x62, 0xca, 0x9a, 0x76, 0xe2, 0x41, 0xf5, 0x3b, 0x30, 0x5b, 0x21, 0x62, 0x3f, 0xa0,
-	0x0e, 0x51, 0xe9, 0xf1, 0x0a, 0x4c, 0xf7, 0xc4, 0xcf, 0xa1, 0x65, 0xb4, 0x3a, 0x69, 0xfd, 0x15,
-	0x07, 0xea, 0x27, 0x08, 0xe6, 0x2e, 0x81, 0x8a, 0xd9, 0x36, 0x64, 0xfd, 0xce, 0x07, 0x89, 0x98,
-	0x2a, 0x17, 0x8c, 0x6b, 0x45, 0x37, 0x0e, 0x9a, 0x4c, 0x10, 0x89, 0x92, 0x7c, 0x90, 0x15, 0x22,
-	0xf0, 0x22, 0x64, 0x3d, 0xe6, 0x39, 0x24, 0x97, 0x5e, 0x46, 0xab, 0x19, 0x2b, 0x7c, 0xc1, 0x79,
-	0x98, 0xa8, 0x11, 0x87, 0x36, 0x6c, 0x97, 0xe7, 0xc6, 0xa4, 0xa1, 0xfb, 0x8e, 0x67, 0x20, 0x4d,
-	0x6b, 0xb9, 0x8c, 0xfc, 0x9a, 0xa6, 0x35, 0xfd, 0xfe, 0x25, 0xa1, 0xa8, 0x92, 0x78, 0x0d, 0xe6,
-	0x7b, 0xa4, 0x54, 0x69, 0x2d, 0xac, 0xd6, 0xa4, 0x35, 0x1b, 0x97, 0xb3, 0x57, 0xe3, 0xfa, 0x73,
-	0x98, 0x8f, 0xe1, 0x95, 0xa2, 0x1d, 0x18, 0x97, 0xfc, 0xa2, 0x1a, 0xaf, 0x24, 0x48, 0xba, 0x5a,
-	0x06, 0x55, 0x68, 0x05, 0xd4, 0x8b, 0x50, 0xa8, 0x10, 0x11, 0xef, 0xc4, 0x33, 0xdb, 0xf7, 0xa9,
-	0x57, 0x8f, 0xda, 0x7d, 0x92, 0x06, 0xad, 0x9f, 0x87, 0xa2, 0xf1, 0x1e, 0xc1, 0xdf, 0xbd, 0x42,
-	0x1a, 0xa1, 0x87, 0xa2, 0xf5, 0x38, 0x99, 0xd6, 0x80, 0x90, 0x46, 0x82, 0xed, 0xa1, 0x27, 0x82,
-	0xb6, 0x62, 0xbf, 0xe0, 0x5c, 0xb7, 0xe7, 0x5f, 0x42, 0xae, 0x1f, 0x0c, 0xcf, 0xc1, 0xd8, 0x11,
-	0x69, 0xcb, 0xce, 0x67, 0xac, 0xce, 0x23, 0xde, 0x82, 0x6c, 0xcb, 0x76, 0x9b, 0x61, 0x4b, 0xff,
-	0x38, 0x9e, 0x56, 0xe8, 0x7c, 0x2f, 0x7d, 0x17, 0xe9, 0x2b, 0xf0, 0x5f, 0x32, 0xfb, 0xa7, 0x94,
-	0x8b, 0xa8, 0x6c, 0x3e, 0x2c, 0x24, 0x78, 0xa8, 0xb1, 0x40, 0xd1, 0x58, 0xe0, 0xbd, 0xab, 0xd3,
-	0x3c, 0x14, 0x1b, 0x55, 0x85, 0xde, 0x99, 0xf7, 0x40, 0x1f, 0x44, 0x4b, 0xf5, 0xea, 0x11, 0x4c,
-	0xa8, 0xe6, 0x44, 0x43, 0x73, 0x23, 0xa1, 0x3b, 0x09, 0x51, 0x54, 0xce, 0x2e, 0xba, 0xfc, 0x79,
-	0x1c, 0xb2, 0x07, 0x9d, 0x1b, 0x86, 0x3f, 0x21, 0x58, 0x48, 0x38, 0x09, 0x78, 0x3d, 0xb9, 0xef,
-	0x7d, 0x0e, 0x4b, 0xde, 0x18, 0xd6, 0x3d, 0x94, 0xa2, 0xaf, 0xbd, 0xfb, 0xf6, 0xeb, 0x63, 0xba,
-	0x84, 0x75, 0x33, 0xe9, 0xec, 0x89, 0xaa, 0xed, 0xba, 0x55, 0x41, 0x9d, 0x23, 0x12, 0x70, 0xdc,
-	0x86, 0x89, 0x68, 0x11, 0xb0, 0x3e, 0x70, 0x4b, 0x42, 0x2e, 0xc3, 0x6c, 0x92, 0x5e, 0x92, 0x04,
-	0x34, 0xbc, 0xd4, 0x87, 0x40, 0x78, 0x3b, 0xde, 0xc2, 0x64, 0x77, 0x73, 0xf1, 0xa0, 0xb8, 0xdd,
-	0x42, 0x94, 0x06, 0x3b, 0xa9, 0xec, 0xff, 0xcb, 0xec, 0x45, 0x5c, 0x18, 0x94, 0x9d, 0xe3, 0x2f,
-	0x08, 0xfe, 0x49, 0x9e, 0x0b, 0x7c, 0x6b, 0x84, 0xbd, 0x0c, 0x99, 0x6d, 0x8c, 0xbc, 0xc9, 0xfa,
-	0x96, 0xa4, 0x69, 0xe0, 0x9b, 0x7d, 0x68, 0x26, 0x1e, 0x0e, 0xfc, 0x15, 0xc9, 0x7f, 0x50, 0x9f,
-	0x69, 0xc6, 0x5b, 0x43, 0xf3, 0x88, 0xed, 0x64, 0xfe, 0xf6, 0x88, 0x28, 0xa5, 0x60, 0x5b, 0x2a,
-	0xd8, 0xc4, 0x1b, 0xa3, 0x28, 0xa8, 0xba, 0x94, 0x8b, 0xdd, 0xca, 0xe9, 0xb9, 0x86, 0xce, 0xce,
-	0x35, 0xf4, 0xf3, 0x5c, 0x43, 0x1f, 0x2e, 0xb4, 0xd4, 0xd9, 0x85, 0x96, 0xfa, 0x7e, 0xa1, 0xa5,
-	0x5e, 0xac, 0xd7, 0xa9, 0x78, 0xd5, 0x3c, 0x34, 0x1c, 0xd6, 0x30, 0xf9, 0x11, 0xf5, 0xd7, 0x1b,
-	0xa4, 0xd5, 0x8d, 0xdf, 0x2a, 0x9b, 0x6f, 0xa2, 0x24, 0xf2, 0x00, 0x1c, 0x8e, 0xcb, 0x3f, 0xf3,
-	0xe6, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xf8, 0xcc, 0xe9, 0x48, 0x08, 0x00, 0x00,
+	// 966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xcf, 0x6c, 0x3e, 0x94, 0xbc, 0xf4, 0x2b, 0x93, 0x02, 0x5b, 0x37, 0xf1, 0xa6, 0x93, 0x05,
+	0xa2, 0x88, 0xd8, 0xed, 0x76, 0x5b, 0x28, 0x42, 0x95, 0x52, 0x84, 0x42, 0xa1, 0x88, 0xc4, 0x87,
+	0x1e, 0xb8, 0xac, 0x5c, 0xef, 0x74, 0x31, 0xf1, 0x7a, 0x5c, 0x7b, 0x76, 0x61, 0x85, 0x7a, 0x41,
+	0xdc, 0x8b, 0xc4, 0x91, 0x0b, 0xff, 0x03, 0x47, 0x24, 0x4e, 0x1c, 0x7a, 0xac, 0xc4, 0x85, 0x13,
+	0x42, 0x09, 0x7f, 0x08, 0xda, 0xf9, 0xd8, 0x78, 0xb3, 0x63, 0x77, 0xf7, 0x66, 0xcf, 0xfb, 0xfa,
+	0xfd, 0xde, 0x7b, 0xfe, 0x8d, 0x61, 0x33, 0x60, 0x71, 0x4c, 0x03, 0xee, 0xb2, 0xd4, 0x0f, 0x22,
+	0xea, 0xf6, 0x1b, 0xee, 0xb3, 0x1e, 0x4d, 0x07, 0x4e, 0x92, 0x32, 0xce, 0xf0, 0x9a, 0x32, 0x3b,
+	0xd2, 0xec, 0xf4, 0x1b, 0xd6, 0xd5, 0x0e, 0xeb, 0x30, 0x61, 0x75, 0x87, 0x4f, 0xd2, 0xd1, 0xda,
+	0xe8, 0x30, 0xd6, 0x89, 0xa8, 0xeb, 0x27, 0xa1, 0xeb, 0xc7, 0x31, 0xe3, 0x3e, 0x0f, 0x59, 0x9c,
+	0x29, 0x6b, 0x6d, 0xb2, 0x4a, 0x87, 0xc6, 0x34, 0x0b, 0xb5, 0x83, 0x3d, 0xe9, 0x90, 0xf8, 0xa9,
+	0xdf, 0xd5, 0xf6, 0x6d, 0x83, 0x9d, 0xa6, 0x4f, 0x59, 0xda, 0xf5, 0xe3, 0x80, 0x2a, 0xa7, 0xba,
+	0x76, 0xe2, 0x83, 0x84, 0x66, 0x43, 0x9f, 0xa0, 0x97, 0xa6, 0x34, 0x0e, 0x06, 0xad, 0xc4, 0x0f,
+	0x53, 0xe9, 0x45, 0x36, 0xc0, 0x3a, 0xa0, 0x7c, 0x3f, 0x8a, 0x3e, 0x56, 0xc6, 0x43, 0x3f, 0x4c,
+	0x33, 0x8f, 0x3e, 0xeb, 0xd1, 0x8c, 0x93, 0x6f, 0xe0, 0xba, 0xd1, 0x9a, 0x25, 0x2c, 0xce, 0x28,
+	0xfe, 0x1c, 0x2e, 0x8d, 0xe5, 0xcc, 0xaa, 0x68, 0x6b, 0x7e, 0x67, 0xb5, 0x61, 0x3b, 0xba, 0x51,
+	0xa2, 0xb6, 0xd3, 0x6f, 0x38, 0xf9, 0x04, 0x0f, 0x16, 0x5e, 0xfe, 0x53, 0x9b, 0xf3, 0x2e, 0x06,
+	0xf9, 0xa4, 0xe4, 0x2e, 0x5c, 0x3e, 0xa0, 0xfc, 0x30, 0x0d, 0x03, 0xaa, 0xca, 0xe3, 0x6d, 0xb8,
+	0x38, 0x96, 0xbf, 0x8a, 0xb6, 0xd0, 0xce, 0x8a, 0x77, 0x21, 0x1f, 0x48, 0x5e, 0x20, 0xb8, 0x72,
+	0x16, 0xa8, 0x90, 0xdd, 0x83, 0xc5, 0x64, 0x78, 0x20, 0x22, 0x56, 0x1b, 0x9b, 0xce, 0xc4, 0xe4,
+	0x9c, 0xa3, 0x1e, 0xe3, 0x54, 0x44, 0x09, 0x3c, 0xc8, 0x93, 0x11, 0xf8, 0x2a, 0x2c, 0xc6, 0x2c,
+	0x0e, 0x68, 0xb5, 0xb2, 0x85, 0x76, 0x16, 0x3c, 0xf9, 0x82, 0x2d, 0x58, 0x6e, 0xd3, 0x20, 0xec,
+	0xfa, 0x51, 0x56, 0x9d, 0x17, 0x86, 0xd1, 0x3b, 0xbe, 0x04, 0x95, 0xb0, 0x5d, 0x5d, 0x10, 0xa7,
+	0x95, 0xb0, 0x4d, 0xee, 0x9f, 0x01, 0xd2, 0x9d, 0xc4, 0xbb, 0xb0, 0x36, 0x46, 0xa5, 0x15, 0xb6,
+	0x65, 0xb7, 0x56, 0xbc, 0xcb, 0x79, 0x3a, 0x0f, 0xdb, 0x19, 0x79, 0x0c, 0x6b, 0xb9, 0x78, 0xc5,
+	0x68, 0x1f, 0x96, 0x04, 0x3e, 0xdd, 0xe3, 0x6d, 0x03, 0xa5, 0xf3, 0x6d, 0x50, 0x8d, 0x56, 0x81,
+	0xa4, 0x06, 0x9b, 0x07, 0x94, 0xe7, 0x27, 0xf1, 0x85, 0x9f, 0x24, 0x61, 0xdc, 0xd1, 0xe3, 0x7e,
+	0x51, 0x01, 0xbb, 0xc8, 0x43, 0xc1, 0xf8, 0x11, 0xc1, 0x1b, 0xe3, 0x44, 0xba, 0xd2, 0x43, 0xc1,
+	0xfa, 0xcc, 0x0c, 0xab, 0x24, 0xa5, 0x63, 0xb0, 0x7d, 0x12, 0xf3, 0x74, 0xa0, 0xd0, 0xaf, 0x07,
+	0x93, 0x76, 0xeb, 0x29, 0x54, 0x8b, 0xc2, 0xf0, 0x15, 0x98, 0x3f, 0xa6, 0x03, 0x31, 0xf9, 0x05,
+	0x6f, 0xf8, 0x88, 0x9b, 0xb0, 0xd8, 0xf7, 0xa3, 0x9e, 0x1c, 0xe9, 0x6b, 0xd7, 0xd3, 0x93, 0xce,
+	0x1f, 0x56, 0x3e, 0x40, 0x64, 0x1b, 0x6e, 0x98, 0xd1, 0x3f, 0x0a, 0x33, 0xae, 0xdb, 0x96, 0xc0,
+	0xba, 0xc1, 0x43, 0xad, 0x05, 0xd2, 0x6b, 0x81, 0x1f, 0x9e, 0xdf, 0xe6, 0xa9, 0xd0, 0xa8, 0x2e,
+	0x8c, 0xef, 0x7c, 0x0c, 0xa4, 0x0c, 0x96, 0x9a, 0xd5, 0xa7, 0xb0, 0xac, 0x86, 0xa3, 0x97, 0xe6,
+	0x1d, 0xc3, 0x74, 0x0c, 0x59, 0x54, 0xcd, 0x51, 0x34, 0xc1, 0x72, 0xa3, 0x85, 0x06, 0x69, 0xd6,
+	0x8f, 0x60, 0x2d, 0x77, 0xa6, 0x4a, 0xbe, 0x0f, 0x4b, 0x52, 0xa9, 0xd4, 0x87, 0x77, 0xcd, 0x50,
+	0x50, 0x86, 0x8c, 0x76, 0x53, 0xbc, 0x91, 0xfb, 0x62, 0xf3, 0x1e, 0xfb, 0x51, 0xd8, 0xf6, 0x39,
+	0x4b, 0x0f, 0xcf, 0xe4, 0x4c, 0x7f, 0x41, 0x1b, 0xb0, 0xd2, 0xd7, 0x66, 0x25, 0x04, 0x67, 0x07,
+	0x24, 0x85, 0x5a, 0x61, 0xbc, 0xc2, 0xf6, 0x25, 0xac, 0xe6, 0x54, 0x52, 0x01, 0x7c, 0xd7, 0x00,
+	0xd0, 0x94, 0x45, 0xc1, 0xcd, 0x67, 0x50, 0xcb, 0xb1, 0x1f, 0x45, 0x25, 0xb0, 0xc9, 0xb7, 0x40,
+	0xca, 0x9c, 0x14, 0xb6, 0x23, 0xb8, 0x90, 0xcb, 0xac, 0xc7, 0x35, 0x23, 0xb8, 0xb1, 0x14, 0x8d,
+	0x5f, 0x00, 0x16, 0x8f, 0x86, 0x97, 0x17, 0xfe, 0x15, 0xc1, 0xba, 0x41, 0xc6, 0xf1, 0x9e, 0xf9,
+	0x5b, 0x2d, 0xb8, 0x0c, 0x2c, 0x67, 0x5a, 0x77, 0xc9, 0x89, 0xec, 0xfe, 0xf0, 0xd7, 0x7f, 0x3f,
+	0x57, 0xea, 0x98, 0xb8, 0xa6, 0xfb, 0x8e, 0xb7, 0xfc, 0x28, 0x6a, 0xf1, 0x30, 0x38, 0xa6, 0x69,
+	0x86, 0x07, 0xb0, 0xac, 0xc5, 0x0b, 0x93, 0x52, 0x65, 0x93, 0x58, 0xa6, 0x51, 0x3f, 0x52, 0x17,
+	0x00, 0x6c, 0xbc, 0x51, 0x00, 0x40, 0xea, 0xfd, 0x73, 0x58, 0xd1, 0x91, 0x19, 0x2e, 0xcb, 0x3b,
+	0x6a, 0x44, 0xbd, 0xdc, 0x49, 0x55, 0x7f, 0x5b, 0x54, 0xaf, 0xe1, 0xcd, 0xb2, 0xea, 0x19, 0xfe,
+	0x0d, 0xc1, 0x9b, 0xe6, 0x6f, 0x19, 0xdf, 0x9c, 0x41, 0x4b, 0x25, 0xb2, 0x5b, 0x33, 0xab, 0x2f,
+	0x69, 0x0a, 0x98, 0x0e, 0x7e, 0xaf, 0x00, 0xa6, 0x51, 0xec, 0xf1, 0x9f, 0x08, 0x2c, 0x73, 0xe2,
+	0xa1, 0x02, 0xe1, 0xe6, 0xd4, 0x38, 0x72, 0x3a, 0x6a, 0xdd, 0x99, 0x31, 0x4a, 0x31, 0xb8, 0x27,
+	0x18, 0xdc, 0xc6, 0xb7, 0x66, 0x61, 0xd0, 0x8a, 0x86, 0x38, 0x07, 0x72, 0xf6, 0x42, 0x82, 0x0a,
+	0x67, 0x9f, 0x57, 0x3d, 0xab, 0x5e, 0xee, 0xa4, 0x20, 0xdd, 0x10, 0x90, 0xae, 0xe3, 0x6b, 0x6e,
+	0xd1, 0x9f, 0x1c, 0xfe, 0x03, 0xc1, 0x5b, 0x05, 0x8a, 0x85, 0x0b, 0xc6, 0x58, 0x22, 0x33, 0x56,
+	0x63, 0x96, 0x10, 0x85, 0xf2, 0x23, 0x81, 0xf2, 0x2e, 0x6e, 0x1a, 0x50, 0x8e, 0x94, 0xb5, 0x95,
+	0x13, 0x15, 0xf7, 0xfb, 0xd1, 0xf1, 0x73, 0xfc, 0x3b, 0xd2, 0xbf, 0x8e, 0x46, 0x0e, 0xcd, 0x42,
+	0xb5, 0x28, 0xa3, 0x71, 0x67, 0xc6, 0x28, 0xc5, 0xe4, 0xa6, 0x60, 0xb2, 0x8b, 0x77, 0xa6, 0x65,
+	0xf2, 0xe0, 0xe0, 0xe5, 0x89, 0x8d, 0x5e, 0x9d, 0xd8, 0xe8, 0xdf, 0x13, 0x1b, 0xfd, 0x74, 0x6a,
+	0xcf, 0xbd, 0x3a, 0xb5, 0xe7, 0xfe, 0x3e, 0xb5, 0xe7, 0xbe, 0xda, 0xeb, 0x84, 0xfc, 0xeb, 0xde,
+	0x13, 0x27, 0x60, 0x5d, 0x37, 0x3b, 0x0e, 0x93, 0xbd, 0x2e, 0xed, 0x8f, 0xd2, 0xf6, 0x1b, 0xee,
+	0x77, 0x3a, 0xb7, 0xb8, 0xae, 0x9f, 0x2c, 0x89, 0xff, 0xe8, 0xdb, 0xff, 0x0f, 0x00, 0x9f, 0x3f,
+	0xb4, 0x2b, 0x3b, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -643,6 +924,14 @@ type QueryClient interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error)
+	// Get the parameters of the x/oracle module.
+	GetParams(ctx context.Context, in *GetParamsRequest, opts ...grpc.CallOption) (*GetParamsResponse, error)
+	// Get the oracle performance of a validator over the sliding window of
+	// blocks defined by the performance_window param.
+	GetValidatorPerformance(ctx context.Context, in *GetValidatorPerformanceRequest, opts ...grpc.CallOption) (*GetValidatorPerformanceResponse, error)
+	// Get the oracle performance of all validators over the sliding window of
+	// blocks defined by the performance_window param.
+	GetAllValidatorPerformance(ctx context.Context, in *GetAllValidatorPerformanceRequest, opts ...grpc.CallOption) (*GetAllValidatorPerformanceResponse, error)
 }
 
 type queryClient struct {
@@ -698,6 +987,33 @@ func (c *queryClient) GetCurrencyPairMappingList(ctx context.Context, in *GetCur
 	return out, nil
 }
 
+func (c *queryClient) GetParams(ctx context.Context, in *GetParamsRequest, opts ...grpc.CallOption) (*GetParamsResponse, error) {
+	out := new(GetParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetValidatorPerformance(ctx context.Context, in *GetValidatorPerformanceRequest, opts ...grpc.CallOption) (*GetValidatorPerformanceResponse, error) {
+	out := new(GetValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAllValidatorPerformance(ctx context.Context, in *GetAllValidatorPerformanceRequest, opts ...grpc.CallOption) (*GetAllValidatorPerformanceResponse, error) {
+	out := new(GetAllValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetAllValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Get all the currency pairs the x/oracle module is tracking price-data for.
@@ -714,6 +1030,14 @@ type QueryServer interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error)
+	// Get the parameters of the x/oracle module.
+	GetParams(context.Context, *GetParamsRequest) (*GetParamsResponse, error)
+	// Get the oracle performance of a validator over the sliding window of
+	// blocks defined by the performance_window param.
+	GetValidatorPerformance(context.Context, *GetValidatorPerformanceRequest) (*GetValidatorPerformanceResponse, error)
+	// Get the oracle performance of all validators over the sliding window of
+	// blocks defined by the performance_window param.
+	GetAllValidatorPerformance(context.Context, *GetAllValidatorPerformanceRequest) (*GetAllValidatorPerformanceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -735,6 +1059,15 @@ func (*UnimplementedQueryServer) GetCurrencyPairMapping(ctx context.Context, req
 func (*UnimplementedQueryServer) GetCurrencyPairMappingList(ctx context.Context, req *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMappingList not implemented")
 }
+func (*UnimplementedQueryServer) GetParams(ctx context.Context, req *GetParamsRequest) (*GetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParams not implemented")
+}
+func (*UnimplementedQueryServer) GetValidatorPerformance(ctx context.Context, req *GetValidatorPerformanceRequest) (*GetValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformance not implemented")
+}
+func (*UnimplementedQueryServer) GetAllValidatorPerformance(ctx context.Context, req *GetAllValidatorPerformanceRequest) (*GetAllValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllValidatorPerformance not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -830,6 +1163,60 @@ func _Query_GetCurrencyPairMappingList_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParams(ctx, req.(*GetParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorPerformance(ctx, req.(*GetValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetAllValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllValidatorPerformance(ctx, req.(*GetAllValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "connect.oracle.v2.Query",
@@ -855,6 +1242,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyPairMappingList",
 			Handler:    _Query_GetCurrencyPairMappingList_Handler,
 		},
+		{
+			MethodName: "GetParams",
+			Handler:    _Query_GetParams_Handler,
+		},
+		{
+			MethodName: "GetValidatorPerformance",
+			Handler:    _Query_GetValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "GetAllValidatorPerformance",
+			Handler:    _Query_GetAllValidatorPerformance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/oracle/v2/query.proto",
@@ -1235,39 +1634,218 @@ func (m *GetCurrencyPairMappingListResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetAllCurrencyPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *GetParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetAllCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CurrencyPairs) > 0 {
-		for _, e := range m.CurrencyPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *GetParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Performances) > 0 {
+		for iNdEx := len(m.Performances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Performances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetAllCurrencyPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetAllCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *GetPriceRequest) Size() (n int) {
@@ -1400,6 +1978,74 @@ func (m *GetCurrencyPairMappingListResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Performance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetAllValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetAllValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Performances) > 0 {
+		for _, e := range m.Performances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2382,6 +3028,438 @@ func (m *GetCurrencyPairMappingListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorPerformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorPerformanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllValidatorPerformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllValidatorPerformanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Performances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Performances = append(m.Performances, ValidatorPerformance{})
+			if err := m.Performances[len(m.Performances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
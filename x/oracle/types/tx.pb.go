@@ -225,46 +225,147 @@ func (m *MsgRemoveCurrencyPairsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveCurrencyPairsResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines the Msg/UpdateParams request type. It contains the
+// new parameters for the x/oracle module.
+type MsgUpdateParams struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle module's parameters
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the new parameters for the x/oracle module
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddCurrencyPairs)(nil), "connect.oracle.v2.MsgAddCurrencyPairs")
 	proto.RegisterType((*MsgAddCurrencyPairsResponse)(nil), "connect.oracle.v2.MsgAddCurrencyPairsResponse")
 	proto.RegisterType((*MsgRemoveCurrencyPairs)(nil), "connect.oracle.v2.MsgRemoveCurrencyPairs")
 	proto.RegisterType((*MsgRemoveCurrencyPairsResponse)(nil), "connect.oracle.v2.MsgRemoveCurrencyPairsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "connect.oracle.v2.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "connect.oracle.v2.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("connect/oracle/v2/tx.proto", fileDescriptor_83be677051d99dbe) }
 
 var fileDescriptor_83be677051d99dbe = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0xcf, 0xcb,
-	0x4b, 0x4d, 0x2e, 0xd1, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd2, 0x2f, 0xa9,
-	0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xe9, 0x41, 0xe4, 0xf4, 0xca, 0x8c,
-	0xa4, 0xe4, 0x31, 0x95, 0xa7, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0xf4, 0x48, 0x49, 0x26,
-	0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x83, 0x79, 0xfa, 0x10, 0x0e, 0x54, 0x4a, 0x1c, 0xc2, 0xd3,
-	0xcf, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50, 0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c,
-	0x7d, 0x30, 0x09, 0x15, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x98, 0x01, 0x62, 0x41, 0x45, 0x55,
-	0x60, 0xb6, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x83, 0x2c, 0x4f, 0x2e, 0x2d, 0x2a, 0x4a, 0xcd, 0x4b,
-	0xae, 0x8c, 0x2f, 0x48, 0xcc, 0x2c, 0x82, 0xa8, 0x52, 0xba, 0xce, 0xc8, 0x25, 0xec, 0x5b, 0x9c,
-	0xee, 0x98, 0x92, 0xe2, 0x0c, 0x95, 0x0d, 0x48, 0xcc, 0x2c, 0x2a, 0x16, 0x32, 0xe3, 0xe2, 0x4c,
-	0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92,
-	0xb8, 0xb4, 0x45, 0x57, 0x04, 0xea, 0x48, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92,
-	0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52, 0x21, 0x6f, 0x2e, 0x3e, 0x14, 0x6b, 0x8a, 0x25, 0x98,
-	0x14, 0x98, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0x60, 0xe1, 0x03, 0x76, 0x8e, 0x5e, 0x99, 0x91, 0x1e,
-	0xb2, 0x85, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0xf1, 0x26, 0x23, 0x3b, 0xc2, 0xca, 0xfa,
-	0xc5, 0x02, 0x79, 0x86, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0x16, 0x74, 0x3d, 0xdf, 0xa0, 0x05, 0xf7,
-	0x59, 0x05, 0x2c, 0x64, 0xb1, 0xf8, 0x40, 0x49, 0x96, 0x4b, 0x1a, 0x8b, 0x70, 0x50, 0x6a, 0x71,
-	0x41, 0x7e, 0x5e, 0x71, 0xaa, 0xd2, 0x4e, 0x46, 0x2e, 0x31, 0xdf, 0xe2, 0xf4, 0xa0, 0xd4, 0xdc,
-	0xfc, 0xb2, 0x54, 0xea, 0xf8, 0x5d, 0x8b, 0x4b, 0x10, 0xc5, 0xef, 0xf1, 0x99, 0x29, 0x10, 0xef,
-	0x73, 0x06, 0xf1, 0x23, 0x7b, 0xcc, 0x33, 0x85, 0x34, 0xaf, 0x05, 0xa7, 0x96, 0xa0, 0x7a, 0x4d,
-	0x81, 0x4b, 0x0e, 0xbb, 0xd3, 0x61, 0xbe, 0x33, 0x7a, 0xcb, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e,
-	0x94, 0xc5, 0x25, 0x80, 0x11, 0xb5, 0x6a, 0x7a, 0x18, 0x49, 0x55, 0x0f, 0x4b, 0x48, 0x49, 0xe9,
-	0x11, 0xa7, 0x0e, 0x66, 0xa7, 0x50, 0x31, 0x97, 0x30, 0xb6, 0xd0, 0xd4, 0xc4, 0x6e, 0x0c, 0x16,
-	0xa5, 0x52, 0x86, 0x44, 0x2b, 0x85, 0x59, 0x2a, 0xc5, 0xda, 0xf0, 0x7c, 0x83, 0x16, 0xa3, 0x93,
-	0xfb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x67, 0x67, 0x16, 0xe8, 0xe6, 0xa6, 0x96, 0xe9,
-	0xc3, 0x42, 0xb9, 0xcc, 0x08, 0x11, 0xd0, 0xe0, 0x74, 0x99, 0xc4, 0x06, 0xce, 0x16, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0xe1, 0x78, 0x05, 0xeb, 0x03, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0x5d, 0x5d, 0xe8, 0xac, 0xba, 0xb6, 0xbb, 0xb8, 0x6d, 0xc4, 0x34, 0x04, 0x91,
+	0x1a, 0xe8, 0x84, 0xcd, 0x82, 0xc2, 0x7a, 0xda, 0x7a, 0x10, 0x91, 0xc2, 0x92, 0xc5, 0x8b, 0x07,
+	0x4b, 0x36, 0x19, 0x66, 0xa3, 0x26, 0x13, 0x66, 0xa6, 0x61, 0x7b, 0x13, 0x8f, 0x9e, 0xfc, 0x13,
+	0xfc, 0x13, 0x7a, 0x10, 0xc4, 0xff, 0x60, 0x2f, 0xc2, 0xe2, 0x45, 0x4f, 0x22, 0xed, 0xa1, 0xfe,
+	0x19, 0xd2, 0xfc, 0x68, 0x53, 0x33, 0xe0, 0x2a, 0x5e, 0xda, 0xce, 0x7b, 0xdf, 0xbe, 0xef, 0xfb,
+	0xe4, 0xbd, 0x09, 0x54, 0x5c, 0x1a, 0x86, 0xd8, 0x15, 0x26, 0x65, 0x8e, 0xfb, 0x0a, 0x9b, 0xb1,
+	0x65, 0x8a, 0x53, 0x14, 0x31, 0x2a, 0x68, 0xa3, 0x9e, 0xe5, 0x50, 0x9a, 0x43, 0xb1, 0xa5, 0xb4,
+	0xcb, 0x72, 0x82, 0x43, 0xcc, 0x7d, 0x9e, 0xfe, 0x47, 0x51, 0xcb, 0x82, 0xc8, 0x61, 0x4e, 0x90,
+	0xe7, 0x5b, 0x2e, 0xe5, 0x01, 0xe5, 0x83, 0xe4, 0x64, 0xa6, 0x87, 0x2c, 0xb5, 0x93, 0x9e, 0xcc,
+	0x80, 0x13, 0x33, 0xde, 0x9d, 0x7f, 0x65, 0x89, 0xba, 0x13, 0xf8, 0x21, 0x35, 0x93, 0xcf, 0x2c,
+	0xb4, 0x4d, 0x28, 0xa1, 0x69, 0x8d, 0xf9, 0xaf, 0x2c, 0x7a, 0x3b, 0x37, 0x17, 0xa3, 0x08, 0xf3,
+	0xb9, 0xb7, 0x3b, 0x64, 0x0c, 0x87, 0xee, 0x68, 0x10, 0x39, 0x3e, 0x4b, 0x55, 0xfa, 0x57, 0x00,
+	0xb7, 0xfa, 0x9c, 0x1c, 0x78, 0xde, 0xc3, 0x2c, 0x7b, 0xe8, 0xf8, 0x8c, 0x37, 0xee, 0xc1, 0x9a,
+	0x33, 0x14, 0x27, 0x94, 0xf9, 0x62, 0xd4, 0x04, 0x1a, 0xe8, 0xd4, 0x7a, 0xcd, 0x2f, 0x1f, 0xba,
+	0xdb, 0x59, 0x93, 0x07, 0x9e, 0xc7, 0x30, 0xe7, 0x47, 0x82, 0xf9, 0x21, 0xb1, 0x97, 0xd2, 0xc6,
+	0x13, 0x78, 0x6d, 0xc5, 0x86, 0x37, 0xab, 0xda, 0x5a, 0x67, 0xc3, 0x52, 0x51, 0xfe, 0xfc, 0x92,
+	0x76, 0x50, 0x6c, 0xa1, 0xa2, 0x61, 0xef, 0xd2, 0xd9, 0xf7, 0x76, 0xc5, 0xbe, 0xea, 0x16, 0x9b,
+	0xd8, 0x7f, 0xf0, 0xf3, 0x7d, 0xbb, 0xf2, 0x66, 0x36, 0x36, 0x96, 0x06, 0x6f, 0x67, 0x63, 0x63,
+	0x41, 0x76, 0x9a, 0x3f, 0x58, 0x09, 0x81, 0x7e, 0x0b, 0xde, 0x94, 0x84, 0x6d, 0xcc, 0x23, 0x1a,
+	0x72, 0xac, 0x7f, 0x02, 0xf0, 0x46, 0x9f, 0x13, 0x1b, 0x07, 0x34, 0xc6, 0xff, 0x87, 0xdd, 0x80,
+	0xf5, 0x15, 0xf6, 0x81, 0xef, 0xa5, 0xf8, 0x35, 0x7b, 0xb3, 0x08, 0xf6, 0xd8, 0xfb, 0x3b, 0xb4,
+	0x23, 0x2c, 0x56, 0xd1, 0x34, 0xa8, 0xca, 0x5b, 0x5f, 0xd0, 0x7d, 0x04, 0x70, 0xb3, 0xcf, 0xc9,
+	0xd3, 0xc8, 0x73, 0x04, 0x3e, 0x4c, 0x76, 0xee, 0x9f, 0xb1, 0xee, 0xc3, 0xf5, 0x74, 0x6b, 0x9b,
+	0x55, 0x0d, 0x74, 0x36, 0xac, 0x16, 0x2a, 0x5d, 0x05, 0x94, 0x5a, 0x64, 0x53, 0xcc, 0xe4, 0xfb,
+	0x7b, 0x65, 0x3e, 0x4d, 0xc6, 0x57, 0xec, 0x52, 0x6f, 0xc1, 0x9d, 0xdf, 0x42, 0x39, 0x94, 0xf5,
+	0xb9, 0x0a, 0xd7, 0xfa, 0x9c, 0x34, 0x5e, 0xc0, 0xeb, 0xa5, 0x7d, 0xbd, 0x23, 0x69, 0x4a, 0x32,
+	0x7e, 0x05, 0x5d, 0x4c, 0x97, 0x7b, 0x36, 0x38, 0xdc, 0x92, 0xad, 0xc8, 0x5d, 0x79, 0x19, 0x89,
+	0x54, 0xd9, 0xbd, 0xb0, 0x74, 0x61, 0xfa, 0x1c, 0x5e, 0x59, 0x99, 0x9c, 0x2e, 0x2f, 0x51, 0xd4,
+	0x28, 0xc6, 0x9f, 0x35, 0x79, 0x7d, 0xe5, 0xf2, 0xeb, 0xd9, 0xd8, 0x00, 0xbd, 0x47, 0x67, 0x13,
+	0x15, 0x9c, 0x4f, 0x54, 0xf0, 0x63, 0xa2, 0x82, 0x77, 0x53, 0xb5, 0x72, 0x3e, 0x55, 0x2b, 0xdf,
+	0xa6, 0x6a, 0xe5, 0x59, 0x97, 0xf8, 0xe2, 0x64, 0x78, 0x8c, 0x5c, 0x1a, 0x98, 0xfc, 0xa5, 0x1f,
+	0x75, 0x03, 0x1c, 0x9b, 0xf9, 0xe8, 0x62, 0x6b, 0x39, 0xbd, 0xe4, 0x32, 0x1f, 0xaf, 0x27, 0xef,
+	0x92, 0xbd, 0x5f, 0x03, 0x00, 0xc9, 0x8b, 0x02, 0xb9, 0x40, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -287,6 +388,9 @@ type MsgClient interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams will be used explicitly by governance to update the x/oracle
+	// module's parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -315,6 +419,15 @@ func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurren
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddCurrencyPairs will be used only by governance to update the set of
@@ -325,6 +438,9 @@ type MsgServer interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams will be used explicitly by governance to update the x/oracle
+	// module's parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -337,6 +453,9 @@ func (*UnimplementedMsgServer) AddCurrencyPairs(ctx context.Context, req *MsgAdd
 func (*UnimplementedMsgServer) RemoveCurrencyPairs(ctx context.Context, req *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -378,6 +497,24 @@ func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "connect.oracle.v2.Msg",
@@ -391,6 +528,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCurrencyPairs",
 			Handler:    _Msg_RemoveCurrencyPairs_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/oracle/v2/tx.proto",
@@ -525,6 +666,69 @@ func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -592,6 +796,30 @@ func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -928,6 +1156,171 @@ func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0